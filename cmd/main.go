@@ -1,7 +1,9 @@
 package main
 
 import (
+	"backend/internal/app/admin"
 	"backend/internal/app/api"
+	"context"
 	"log"
 	"log/slog"
 	"os"
@@ -9,6 +11,14 @@ import (
 
 func main() {
 	const op = "cmd.main"
+	if len(os.Args) > 1 && os.Args[1] == "admin" {
+		if err := admin.Run(context.Background(), os.Args[2:], os.Stdout); err != nil {
+			slog.Error("admin command", slog.String("op", op), slog.Any("err", err))
+			os.Exit(1)
+		}
+		return
+	}
+
 	app, err := api.NewApp()
 	if err != nil {
 		log.Fatal(err)
@@ -0,0 +1,67 @@
+package admin
+
+import (
+	"backend/internal/infrastructure/config"
+	"backend/internal/infrastructure/storage/postgres"
+	integrityDomain "backend/internal/integrity/domain"
+	integrityRepository "backend/internal/integrity/repository"
+	integrityTransport "backend/internal/integrity/transport"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+)
+
+const (
+	ModeCheck  = "check"
+	ModeRepair = "repair"
+)
+
+var (
+	ErrUsage       = errors.New("usage: admin positions check|repair [--board UUID]")
+	ErrIssuesFound = errors.New("position issues found")
+)
+
+// Run выполняет служебную команду: admin positions check|repair [--board UUID].
+// check завершается ошибкой ErrIssuesFound, если нашлись нарушения, чтобы её можно было звать из скриптов.
+func Run(ctx context.Context, args []string, out io.Writer) error {
+	const op = "admin.Run"
+	if len(args) < 2 || args[0] != "positions" || (args[1] != ModeCheck && args[1] != ModeRepair) {
+		return ErrUsage
+	}
+	mode := args[1]
+	flags := flag.NewFlagSet("positions", flag.ContinueOnError)
+	flags.SetOutput(out)
+	boardID := flags.String("board", "", "board UUID, all boards when empty")
+	if err := flags.Parse(args[2:]); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	cfg := config.NewConfig()
+	cfg.SetLogger()
+	storage, err := postgres.NewStorage(cfg)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer storage.Close()
+
+	service := integrityDomain.NewIntegrityService(integrityRepository.NewIntegrityRepository(storage))
+	action := service.Check
+	if mode == ModeRepair {
+		action = service.Repair
+	}
+	report, err := action(ctx, *boardID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	mapper := &integrityTransport.IntegrityMapper{}
+	if _, err := io.WriteString(out, mapper.ToReportText(report)); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if mode == ModeCheck && len(report.Issues) > 0 {
+		return ErrIssuesFound
+	}
+	return nil
+}
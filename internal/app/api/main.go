@@ -1,24 +1,53 @@
 package api
 
 import (
+	automationDomain "backend/internal/automation/domain"
+	automationRepository "backend/internal/automation/repository"
+	automationTransport "backend/internal/automation/transport"
 	boardDomain "backend/internal/board/domain"
 	boardRepository "backend/internal/board/repository"
 	boardTransport "backend/internal/board/transport"
+	calendarDomain "backend/internal/calendar/domain"
+	calendarRepository "backend/internal/calendar/repository"
+	calendarTransport "backend/internal/calendar/transport"
 	cardDomain "backend/internal/card/domain"
 	cardRepository "backend/internal/card/repository"
 	cardTransport "backend/internal/card/transport"
 	commentDomain "backend/internal/comment/domain"
 	commentRepository "backend/internal/comment/repository"
 	commentTransport "backend/internal/comment/transport"
+	idempotencyDomain "backend/internal/idempotency/domain"
+	idempotencyRepository "backend/internal/idempotency/repository"
 	"backend/internal/infrastructure/config"
 	"backend/internal/infrastructure/events"
 	"backend/internal/infrastructure/http"
+	"backend/internal/infrastructure/http/middleware"
 	"backend/internal/infrastructure/lang"
 	"backend/internal/infrastructure/storage/postgres"
 	"backend/internal/infrastructure/validation"
+	"backend/internal/infrastructure/webhook"
+	integrityDomain "backend/internal/integrity/domain"
+	integrityRepository "backend/internal/integrity/repository"
+	integrityTransport "backend/internal/integrity/transport"
+	relationDomain "backend/internal/relation/domain"
+	relationRepository "backend/internal/relation/repository"
+	relationTransport "backend/internal/relation/transport"
+	searchDomain "backend/internal/search/domain"
+	searchRepository "backend/internal/search/repository"
+	searchTransport "backend/internal/search/transport"
+	"backend/internal/shared/rank"
+	sprintDomain "backend/internal/sprint/domain"
+	sprintRepository "backend/internal/sprint/repository"
+	sprintTransport "backend/internal/sprint/transport"
+	trashDomain "backend/internal/trash/domain"
+	trashRepository "backend/internal/trash/repository"
+	trashTransport "backend/internal/trash/transport"
 	userDomain "backend/internal/user/domain"
 	userRepository "backend/internal/user/repository"
 	userTransport "backend/internal/user/transport"
+	viewDomain "backend/internal/view/domain"
+	viewRepository "backend/internal/view/repository"
+	viewTransport "backend/internal/view/transport"
 	"database/sql"
 	"sync"
 	"time"
@@ -33,7 +62,13 @@ import (
 )
 
 const (
-	shutdownTimeout = 10 * time.Second
+	shutdownTimeout        = 10 * time.Second
+	sprintSnapshotInterval = time.Hour
+	rankRebalanceInterval  = 10 * time.Minute
+	idempotencyGCInterval  = time.Hour
+	recurrenceInterval     = time.Minute
+	webhookTimeout         = 5 * time.Second
+	automationQueueSize    = 1024
 )
 
 type Storage interface {
@@ -58,6 +93,12 @@ type Config interface {
 	GetRefreshTokenTTL() string
 	GetAccessTokenSecret() string
 	GetRefreshTokenSecret() string
+	GetRecurrenceCatchUp() bool
+	GetAdminUserIDs() []uint64
+}
+
+type Worker interface {
+	Run(ctx context.Context)
 }
 
 type App struct {
@@ -66,6 +107,7 @@ type App struct {
 	app       *fiber.App
 	storage   Storage
 	config    Config
+	workers   []Worker
 }
 
 func NewApp() (*App, error) {
@@ -96,6 +138,15 @@ func (a *App) setupServices() (http.Handlers, error) {
 	boardRepo := boardRepository.NewBoardRepository(a.storage)
 	cardRepo := cardRepository.NewCardRepository(a.storage)
 	commentRepo := commentRepository.NewCommentRepository(a.storage)
+	sprintRepo := sprintRepository.NewSprintRepository(a.storage)
+	searchRepo := searchRepository.NewSearchRepository(a.storage)
+	viewRepo := viewRepository.NewViewRepository(a.storage)
+	calendarRepo := calendarRepository.NewCalendarRepository(a.storage)
+	relationRepo := relationRepository.NewRelationRepository(a.storage)
+	integrityRepo := integrityRepository.NewIntegrityRepository(a.storage)
+	idempotencyRepo := idempotencyRepository.NewIdempotencyRepository(a.storage)
+	automationRepo := automationRepository.NewAutomationRepository(a.storage)
+	trashRepo := trashRepository.NewTrashRepository(a.storage)
 
 	// bus
 	bus := events.NewInMemoryBus()
@@ -105,21 +156,61 @@ func (a *App) setupServices() (http.Handlers, error) {
 
 	commentEventHandler := commentDomain.NewCommentCardEventHandler(commentRepo)
 	bus.Subscribe("CardDeleted", commentEventHandler)
+	bus.Subscribe("CardDeleted", cardBoardEventHandler)
 
 	//services
 	userService := userDomain.NewUserService(userRepo, a.config)
 	authService := userDomain.NewAuthService(authRepo, a.config)
 	cardService := cardDomain.NewCardService(cardRepo, boardRepo, bus)
-	boardService := boardDomain.NewBoardService(boardRepo, cardService, bus)
+	viewService := viewDomain.NewViewService(viewRepo)
+	boardService := boardDomain.NewBoardService(boardRepo, cardService, viewService, a.lang, bus)
 	commentService := commentDomain.NewCommentService(commentRepo)
+	sprintService := sprintDomain.NewSprintService(sprintRepo)
+	searchService := searchDomain.NewSearchService(searchRepo)
+	calendarService := calendarDomain.NewCalendarService(calendarRepo)
+	relationService := relationDomain.NewRelationService(relationRepo)
+	integrityService := integrityDomain.NewIntegrityService(integrityRepo)
+	idempotencyService := idempotencyDomain.NewIdempotencyService(idempotencyRepo)
+	automationService := automationDomain.NewAutomationService(automationRepo, cardService)
+	trashService := trashDomain.NewTrashService(trashRepo)
+
+	// правила автоматизации подписываются после создания сервисов, которыми выполняют действия,
+	// и выполняются в фоне, а не внутри запроса
+	automationWorker := automationDomain.NewAutomationWorker(automationDomain.NewAutomationEventHandler(
+		automationRepo, cardService, commentService, webhook.NewClient(webhookTimeout),
+	), automationQueueSize)
+	bus.Subscribe("CardCreated", automationWorker)
+	bus.Subscribe("CardMoved", automationWorker)
+	bus.Subscribe("CardPropertiesChanged", automationWorker)
+
+	// workers
+	a.workers = append(a.workers, sprintDomain.NewSnapshotWorker(sprintService, sprintSnapshotInterval))
+	a.workers = append(a.workers, rank.NewRebalanceWorker(rankRebalanceInterval, cardService, boardService))
+	a.workers = append(a.workers, idempotencyDomain.NewCleanupWorker(idempotencyService, idempotencyGCInterval))
+	a.workers = append(a.workers, cardDomain.NewRecurrenceWorker(
+		cardService, recurrenceInterval, a.config.GetRecurrenceCatchUp(),
+	))
+	a.workers = append(a.workers, automationWorker)
 
 	// handlers
+	boardHandler := boardTransport.NewBoardHandler(a.validator, a.lang, boardService)
 	return http.Handlers{
-		AuthHandler:    userTransport.NewAuthHandler(a.validator, a.lang, authService),
-		UserHandler:    userTransport.NewUserHandler(a.validator, a.lang, userService),
-		BoardHandler:   boardTransport.NewBoardHandler(a.validator, a.lang, boardService),
-		CardHandler:    cardTransport.NewCardHandler(a.validator, a.lang, cardService),
-		CommentHandler: commentTransport.NewCommentHandler(a.validator, a.lang, commentService),
+		AuthHandler:       userTransport.NewAuthHandler(a.validator, a.lang, authService),
+		UserHandler:       userTransport.NewUserHandler(a.validator, a.lang, userService),
+		BoardHandler:      boardHandler,
+		CardHandler:       cardTransport.NewCardHandler(a.validator, a.lang, cardService),
+		CommentHandler:    commentTransport.NewCommentHandler(a.validator, a.lang, commentService),
+		SprintHandler:     sprintTransport.NewSprintHandler(a.validator, a.lang, sprintService),
+		SearchHandler:     searchTransport.NewSearchHandler(a.validator, searchService),
+		ViewHandler:       viewTransport.NewViewHandler(a.validator, a.lang, viewService),
+		TemplateHandler:   boardHandler,
+		CalendarHandler:   calendarTransport.NewCalendarHandler(a.validator, calendarService),
+		RelationHandler:   relationTransport.NewRelationHandler(a.validator, a.lang, relationService),
+		IntegrityHandler:  integrityTransport.NewIntegrityHandler(a.validator, integrityService),
+		AutomationHandler: automationTransport.NewAutomationHandler(a.validator, automationService),
+		TrashHandler:      trashTransport.NewTrashHandler(a.lang, trashService),
+		Idempotency:       middleware.Idempotency(idempotencyService),
+		AdminRequired:     middleware.AdminRequired(a.config.GetAdminUserIDs()),
 	}, nil
 }
 
@@ -149,6 +240,10 @@ func (a *App) runServer() error {
 	)
 	defer stop()
 
+	for _, worker := range a.workers {
+		go worker.Run(ctx)
+	}
+
 	select {
 	case <-ctx.Done():
 		return a.gracefulShutdown()
@@ -0,0 +1,13 @@
+package domain
+
+import "errors"
+
+var (
+	ErrRuleNotFound     = errors.New("automation rule not found")
+	ErrBoardNotEditable = errors.New("board not found or not editable")
+	ErrCardNotFound     = errors.New("card not found")
+	ErrColumnNotExist   = errors.New("column not exists")
+	ErrInvalidTrigger   = errors.New("invalid automation trigger")
+	ErrInvalidAction    = errors.New("invalid automation action")
+	ErrInvalidPattern   = errors.New("invalid text pattern")
+)
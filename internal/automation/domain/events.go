@@ -0,0 +1,207 @@
+package domain
+
+import (
+	cardDomain "backend/internal/card/domain"
+	commentDomain "backend/internal/comment/domain"
+	"backend/internal/shared/domain/events"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+)
+
+var (
+	errRuleRepeated  = errors.New("rule already ran in this chain of events")
+	errChainTooDeep  = errors.New("rule chain is too deep")
+	errUnknownAction = errors.New("unknown action")
+)
+
+type CommentService interface {
+	Create(ctx context.Context, req *commentDomain.Comment) error
+}
+
+type WebhookSender interface {
+	Send(ctx context.Context, url string, payload any) error
+}
+
+type WebhookPayload struct {
+	RuleID   uint64      `json:"rule_id"`
+	RuleName string      `json:"rule_name"`
+	Event    string      `json:"event"`
+	BoardID  string      `json:"board_id"`
+	Card     WebhookCard `json:"card"`
+}
+
+type WebhookCard struct {
+	ID       uint64 `json:"id"`
+	Number   uint64 `json:"number"`
+	ColumnID uint64 `json:"column_id"`
+	Text     string `json:"text"`
+	Color    string `json:"color,omitempty"`
+	Tag      string `json:"tag,omitempty"`
+}
+
+type ruleChainKey struct{}
+
+// AutomationEventHandler выполняет правила доски на события карточек.
+// Действия правил сами порождают события, поэтому в контексте хранится цепочка
+// сработавших правил: повтор правила или слишком длинная цепочка пропускаются.
+type AutomationEventHandler struct {
+	repo           RuleRepo
+	cardService    CardService
+	commentService CommentService
+	webhook        WebhookSender
+}
+
+func NewAutomationEventHandler(
+	repo RuleRepo, cardService CardService, commentService CommentService, webhook WebhookSender,
+) *AutomationEventHandler {
+	return &AutomationEventHandler{
+		repo:           repo,
+		cardService:    cardService,
+		commentService: commentService,
+		webhook:        webhook,
+	}
+}
+
+// Handle не возвращает ошибки выполнения правил: они попадают в журнал, а изменение карточки уже сохранено
+func (h *AutomationEventHandler) Handle(ctx context.Context, event events.Event) error {
+	const op = "automation.handler.Handle"
+	cardID, boardID := eventCard(event)
+	if cardID == 0 {
+		return nil
+	}
+	rules, err := h.repo.GetEnabledRules(ctx, boardID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	for _, rule := range rules {
+		if !rule.Trigger.Matches(event) {
+			continue
+		}
+		h.apply(ctx, rule, event, cardID)
+	}
+	return nil
+}
+
+func (h *AutomationEventHandler) apply(ctx context.Context, rule *Rule, event events.Event, cardID uint64) {
+	execution := &Execution{
+		RuleID: rule.ID,
+		CardID: cardID,
+		Event:  event.Name(),
+	}
+	card, err := h.cardService.Get(ctx, rule.CreatedBy, &cardDomain.Card{ID: cardID, BoardID: rule.BoardID}, false)
+	if err != nil {
+		h.record(ctx, execution, ExecutionFailed, err)
+		return
+	}
+	if _, matched := rule.Conditions.Evaluate(&card.Card); !matched {
+		return
+	}
+
+	chain, _ := ctx.Value(ruleChainKey{}).([]uint64)
+	switch {
+	case slices.Contains(chain, rule.ID):
+		h.record(ctx, execution, ExecutionSkipped, errRuleRepeated)
+		return
+	case len(chain) >= maxRuleDepth:
+		h.record(ctx, execution, ExecutionSkipped, errChainTooDeep)
+		return
+	}
+	// копия цепочки: соседние правила того же события не должны видеть друг друга
+	ctx = context.WithValue(ctx, ruleChainKey{}, append(slices.Clone(chain), rule.ID))
+	if err := h.execute(ctx, rule, event, &card.Card); err != nil {
+		h.record(ctx, execution, ExecutionFailed, err)
+		return
+	}
+	h.record(ctx, execution, ExecutionSuccess, nil)
+}
+
+func (h *AutomationEventHandler) record(ctx context.Context, execution *Execution, status string, err error) {
+	const op = "automation.handler.record"
+	execution.Status = status
+	if err != nil {
+		execution.Error = err.Error()
+	}
+	if err := h.repo.CreateExecution(ctx, execution); err != nil {
+		slog.Error("save automation execution", slog.String("op", op), slog.Any("err", err))
+	}
+}
+
+// execute выполняет действия по порядку и останавливается на первой ошибке.
+// card обновляется после каждого действия, чтобы следующее не затёрло результат предыдущего.
+func (h *AutomationEventHandler) execute(ctx context.Context, rule *Rule, event events.Event, card *cardDomain.Card) error {
+	for i, action := range rule.Actions {
+		var err error
+		switch action.Type {
+		case ActionMoveCard:
+			if card.ColumnID == action.ColumnID {
+				continue
+			}
+			err = h.cardService.MoveToNewPosition(ctx, &cardDomain.CardMoveCommand{
+				ID:           card.ID,
+				BoardID:      card.BoardID,
+				FromColumnID: card.ColumnID,
+				ToColumnID:   action.ColumnID,
+				FromPosition: card.Position,
+			})
+			if err == nil {
+				card.ColumnID = action.ColumnID
+			}
+		case ActionSetColor, ActionSetTag:
+			updated := *card
+			updated.Version = 0
+			if action.Type == ActionSetColor {
+				updated.Color = action.Value
+			} else {
+				updated.Tag = action.Value
+			}
+			if updated.CardProperties == card.CardProperties {
+				continue
+			}
+			if err = h.cardService.Update(ctx, &updated); err == nil {
+				card.CardProperties = updated.CardProperties
+			}
+		case ActionPostComment:
+			err = h.commentService.Create(ctx, &commentDomain.Comment{
+				CardID: card.ID,
+				UserID: rule.CreatedBy,
+				Text:   action.Value,
+			})
+		case ActionCallWebhook:
+			err = h.webhook.Send(ctx, action.URL, &WebhookPayload{
+				RuleID:   rule.ID,
+				RuleName: rule.Name,
+				Event:    event.Name(),
+				BoardID:  card.BoardID,
+				Card: WebhookCard{
+					ID:       card.ID,
+					Number:   card.Number,
+					ColumnID: card.ColumnID,
+					Text:     card.Text,
+					Color:    card.Color,
+					Tag:      card.Tag,
+				},
+			})
+		default:
+			err = errUnknownAction
+		}
+		if err != nil {
+			return fmt.Errorf("action %d (%s): %w", i+1, action.Type, err)
+		}
+	}
+	return nil
+}
+
+func eventCard(event events.Event) (uint64, string) {
+	switch e := event.(type) {
+	case events.CardCreatedEvent:
+		return e.CardID, e.BoardID
+	case events.CardMovedEvent:
+		return e.CardID, e.BoardID
+	case events.CardPropertiesChangedEvent:
+		return e.CardID, e.BoardID
+	}
+	return 0, ""
+}
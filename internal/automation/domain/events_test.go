@@ -0,0 +1,89 @@
+package domain
+
+import (
+	cardDomain "backend/internal/card/domain"
+	"backend/internal/shared/domain/events"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRuleRepo struct {
+	RuleRepo
+	rules      []*Rule
+	executions []*Execution
+}
+
+func (r *stubRuleRepo) GetEnabledRules(ctx context.Context, boardID string) ([]*Rule, error) {
+	return r.rules, nil
+}
+
+func (r *stubRuleRepo) CreateExecution(ctx context.Context, execution *Execution) error {
+	r.executions = append(r.executions, execution)
+	return nil
+}
+
+// stubCardService хранит одну карточку и, как шина, сразу передаёт событие об изменении свойств обработчику
+type stubCardService struct {
+	CardService
+	card    cardDomain.Card
+	handler events.EventHandler
+}
+
+func (s *stubCardService) Get(ctx context.Context, userID uint64, req *cardDomain.Card, archived bool) (*cardDomain.CardListItem, error) {
+	return &cardDomain.CardListItem{Card: s.card}, nil
+}
+
+func (s *stubCardService) Update(ctx context.Context, req *cardDomain.Card) error {
+	changed := cardDomain.ChangedProperties(s.card.CardProperties, req.CardProperties)
+	s.card.CardProperties = req.CardProperties
+	return s.handler.Handle(ctx, events.CardPropertiesChangedEvent{
+		CardID:  req.ID,
+		BoardID: req.BoardID,
+		Changed: changed,
+	})
+}
+
+func Test_HandleStopsRuleLoop(t *testing.T) {
+	const boardID = "93a49b99-a029-4a18-bbbc-c10d91a8c267"
+	// правила перекрашивают карточку и меняют тег в ответ друг на друга
+	repo := &stubRuleRepo{rules: []*Rule{
+		{
+			ID:      1,
+			BoardID: boardID,
+			Trigger: Trigger{Type: TriggerPropertyChanged, Property: "tag"},
+			Actions: Actions{{Type: ActionSetColor, Value: "#ff0000"}},
+		},
+		{
+			ID:      2,
+			BoardID: boardID,
+			Trigger: Trigger{Type: TriggerPropertyChanged, Property: "color"},
+			Actions: Actions{{Type: ActionSetTag, Value: "urgent"}},
+		},
+	}}
+	cards := &stubCardService{card: cardDomain.Card{
+		ID:             10,
+		BoardID:        boardID,
+		CardProperties: cardDomain.CardProperties{Tag: "bug"},
+	}}
+	handler := NewAutomationEventHandler(repo, cards, nil, nil)
+	cards.handler = handler
+
+	require.NoError(t, handler.Handle(context.Background(), events.CardPropertiesChangedEvent{
+		CardID:  10,
+		BoardID: boardID,
+		Changed: []string{"tag"},
+	}))
+
+	statuses := make(map[uint64][]string)
+	for _, execution := range repo.executions {
+		statuses[execution.RuleID] = append(statuses[execution.RuleID], execution.Status)
+	}
+	assert.Equal(t, map[uint64][]string{
+		1: {ExecutionSkipped, ExecutionSuccess},
+		2: {ExecutionSuccess},
+	}, statuses)
+	assert.Equal(t, cardDomain.CardProperties{Color: "#ff0000", Tag: "urgent"}, cards.card.CardProperties)
+}
@@ -0,0 +1,280 @@
+package domain
+
+import (
+	cardDomain "backend/internal/card/domain"
+	"backend/internal/shared/domain/events"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Триггеры: на какое событие карточки срабатывает правило
+const (
+	TriggerCardCreated     = "card_created"
+	TriggerCardMovedIn     = "card_moved_in"
+	TriggerCardMovedOut    = "card_moved_out"
+	TriggerPropertyChanged = "property_changed"
+)
+
+// Действия, которые выполняет сработавшее правило
+const (
+	ActionMoveCard    = "move_card"
+	ActionSetColor    = "set_color"
+	ActionSetTag      = "set_tag"
+	ActionPostComment = "post_comment"
+	ActionCallWebhook = "call_webhook"
+)
+
+// Статусы записей журнала выполнения
+const (
+	ExecutionSuccess = "success"
+	ExecutionFailed  = "failed"
+	ExecutionSkipped = "skipped"
+)
+
+// maxRuleDepth — сколько правил может сработать друг за другом в одной цепочке событий
+const maxRuleDepth = 5
+
+const executionListLimit = 100
+
+type Rule struct {
+	ID         uint64
+	BoardID    string
+	CreatedBy  uint64
+	Name       string
+	Enabled    bool
+	Trigger    Trigger
+	Conditions Conditions
+	Actions    Actions
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Trigger — ColumnID обязателен для перемещений и необязателен для создания;
+// Property сужает property_changed до одного свойства
+type Trigger struct {
+	Type     string `json:"type"`
+	ColumnID uint64 `json:"column_id,omitempty"`
+	Property string `json:"property,omitempty"`
+}
+
+// Conditions — все заданные условия должны выполняться одновременно, пустые не проверяются
+type Conditions struct {
+	ColumnID    uint64 `json:"column_id,omitempty"`
+	Tag         string `json:"tag,omitempty"`
+	Color       string `json:"color,omitempty"`
+	TextPattern string `json:"text_pattern,omitempty"`
+}
+
+type Action struct {
+	Type     string `json:"type"`
+	ColumnID uint64 `json:"column_id,omitempty"`
+	Value    string `json:"value,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+type Actions []Action
+
+type Execution struct {
+	ID        uint64
+	RuleID    uint64
+	CardID    uint64
+	Event     string
+	Status    string
+	Error     string
+	CreatedAt time.Time
+}
+
+type ConditionResult struct {
+	Field   string
+	Matched bool
+}
+
+// DryRunResult — Actions заполняются только если карточка подошла под условия
+type DryRunResult struct {
+	Matched    bool
+	Conditions []ConditionResult
+	Actions    Actions
+}
+
+type RuleCommand struct {
+	ID      uint64
+	UserID  uint64
+	BoardID string
+}
+
+type DryRunCommand struct {
+	RuleCommand
+	CardID uint64
+}
+
+// Matches проверяет, что событие подходит под триггер
+func (t Trigger) Matches(event events.Event) bool {
+	switch e := event.(type) {
+	case events.CardCreatedEvent:
+		return t.Type == TriggerCardCreated && (t.ColumnID == 0 || t.ColumnID == e.ColumnID)
+	case events.CardMovedEvent:
+		switch t.Type {
+		case TriggerCardMovedIn:
+			return t.ColumnID == e.ToColumnID
+		case TriggerCardMovedOut:
+			return t.ColumnID == e.FromColumnID
+		}
+	case events.CardPropertiesChangedEvent:
+		return t.Type == TriggerPropertyChanged && (t.Property == "" || slices.Contains(e.Changed, t.Property))
+	}
+	return false
+}
+
+// Evaluate проверяет заданные условия на карточке; matched — выполнились все
+func (c Conditions) Evaluate(card *cardDomain.Card) (results []ConditionResult, matched bool) {
+	matched = true
+	check := func(field string, ok bool) {
+		results = append(results, ConditionResult{Field: field, Matched: ok})
+		matched = matched && ok
+	}
+	if c.ColumnID != 0 {
+		check("column_id", card.ColumnID == c.ColumnID)
+	}
+	if c.Tag != "" {
+		check("tag", card.Tag == c.Tag)
+	}
+	if c.Color != "" {
+		check("color", card.Color == c.Color)
+	}
+	if c.TextPattern != "" {
+		pattern, err := regexp.Compile(c.TextPattern)
+		check("text_pattern", err == nil && pattern.MatchString(card.Text))
+	}
+	return results, matched
+}
+
+// Validate проверяет то, что не выражается тегами валидатора: зависимости полей от типа и шаблон текста
+func (r *Rule) Validate() error {
+	switch r.Trigger.Type {
+	case TriggerCardCreated, TriggerPropertyChanged:
+	case TriggerCardMovedIn, TriggerCardMovedOut:
+		if r.Trigger.ColumnID == 0 {
+			return ErrInvalidTrigger
+		}
+	default:
+		return ErrInvalidTrigger
+	}
+	if r.Trigger.Property != "" && r.Trigger.Type != TriggerPropertyChanged {
+		return ErrInvalidTrigger
+	}
+	if r.Conditions.TextPattern != "" {
+		if _, err := regexp.Compile(r.Conditions.TextPattern); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidPattern, err)
+		}
+	}
+	if len(r.Actions) == 0 {
+		return ErrInvalidAction
+	}
+	for _, action := range r.Actions {
+		if err := action.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a Action) validate() error {
+	switch a.Type {
+	case ActionMoveCard:
+		if a.ColumnID == 0 {
+			return ErrInvalidAction
+		}
+	case ActionSetColor, ActionSetTag:
+	case ActionPostComment:
+		if a.Value == "" {
+			return ErrInvalidAction
+		}
+	case ActionCallWebhook:
+		u, err := url.Parse(a.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" || internalHost(u.Hostname()) {
+			return ErrInvalidAction
+		}
+	default:
+		return ErrInvalidAction
+	}
+	return nil
+}
+
+// internalHost отсекает явные внутренние адреса при сохранении правила, чтобы ошибка была видна сразу.
+// Имена, которые разрешаются во внутреннюю сеть, отклоняет клиент вебхуков при подключении.
+func internalHost(host string) bool {
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return true
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	ip = ip.Unmap()
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsMulticast() || ip.IsUnspecified()
+}
+
+// ColumnIDs возвращает колонки, на которые ссылается правило: их нужно проверить на принадлежность доске
+func (r *Rule) ColumnIDs() []uint64 {
+	var ids []uint64
+	for _, id := range []uint64{r.Trigger.ColumnID, r.Conditions.ColumnID} {
+		if id != 0 {
+			ids = append(ids, id)
+		}
+	}
+	for _, action := range r.Actions {
+		if action.ColumnID != 0 {
+			ids = append(ids, action.ColumnID)
+		}
+	}
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
+
+func (t *Trigger) Scan(value interface{}) error {
+	return scanJSON(value, t)
+}
+
+func (t Trigger) Value() (driver.Value, error) {
+	return json.Marshal(t)
+}
+
+func (c *Conditions) Scan(value interface{}) error {
+	return scanJSON(value, c)
+}
+
+func (c Conditions) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+func (a *Actions) Scan(value interface{}) error {
+	return scanJSON(value, a)
+}
+
+func (a Actions) Value() (driver.Value, error) {
+	if a == nil {
+		return "[]", nil
+	}
+	return json.Marshal(a)
+}
+
+func scanJSON(value interface{}, dest any) error {
+	if value == nil {
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("automation.Scan: expected []byte, got %T", value)
+	}
+	if len(bytes) == 0 {
+		return nil
+	}
+	return json.Unmarshal(bytes, dest)
+}
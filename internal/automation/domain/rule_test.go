@@ -0,0 +1,192 @@
+package domain
+
+import (
+	cardDomain "backend/internal/card/domain"
+	"backend/internal/shared/domain/events"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TriggerMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		trigger  Trigger
+		event    events.Event
+		expected bool
+	}{
+		{
+			name:     "created in any column",
+			trigger:  Trigger{Type: TriggerCardCreated},
+			event:    events.CardCreatedEvent{CardID: 1, ColumnID: 3},
+			expected: true,
+		},
+		{
+			name:     "created in other column",
+			trigger:  Trigger{Type: TriggerCardCreated, ColumnID: 2},
+			event:    events.CardCreatedEvent{CardID: 1, ColumnID: 3},
+			expected: false,
+		},
+		{
+			name:     "moved into column",
+			trigger:  Trigger{Type: TriggerCardMovedIn, ColumnID: 3},
+			event:    events.CardMovedEvent{CardID: 1, FromColumnID: 2, ToColumnID: 3},
+			expected: true,
+		},
+		{
+			name:     "moved out of column",
+			trigger:  Trigger{Type: TriggerCardMovedOut, ColumnID: 3},
+			event:    events.CardMovedEvent{CardID: 1, FromColumnID: 2, ToColumnID: 3},
+			expected: false,
+		},
+		{
+			name:     "watched property changed",
+			trigger:  Trigger{Type: TriggerPropertyChanged, Property: "tag"},
+			event:    events.CardPropertiesChangedEvent{CardID: 1, Changed: []string{"color", "tag"}},
+			expected: true,
+		},
+		{
+			name:     "other property changed",
+			trigger:  Trigger{Type: TriggerPropertyChanged, Property: "estimate"},
+			event:    events.CardPropertiesChangedEvent{CardID: 1, Changed: []string{"color"}},
+			expected: false,
+		},
+		{
+			name:     "event of other kind",
+			trigger:  Trigger{Type: TriggerCardCreated},
+			event:    events.CardMovedEvent{CardID: 1, FromColumnID: 2, ToColumnID: 3},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.trigger.Matches(tt.event))
+		})
+	}
+}
+
+func Test_ConditionsEvaluate(t *testing.T) {
+	card := &cardDomain.Card{
+		ColumnID: 3,
+		Text:     "Fix login bug",
+		CardProperties: cardDomain.CardProperties{
+			Color: "#ff0000",
+			Tag:   "backend",
+		},
+	}
+	tests := []struct {
+		name       string
+		conditions Conditions
+		results    []ConditionResult
+		matched    bool
+	}{
+		{
+			name:       "no conditions",
+			conditions: Conditions{},
+			matched:    true,
+		},
+		{
+			name:       "all match",
+			conditions: Conditions{ColumnID: 3, Tag: "backend", TextPattern: "(?i)bug"},
+			results: []ConditionResult{
+				{Field: "column_id", Matched: true},
+				{Field: "tag", Matched: true},
+				{Field: "text_pattern", Matched: true},
+			},
+			matched: true,
+		},
+		{
+			name:       "one fails",
+			conditions: Conditions{Color: "#00ff00", TextPattern: "^Fix"},
+			results: []ConditionResult{
+				{Field: "color", Matched: false},
+				{Field: "text_pattern", Matched: true},
+			},
+			matched: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, matched := tt.conditions.Evaluate(card)
+			assert.Equal(t, tt.results, results)
+			assert.Equal(t, tt.matched, matched)
+		})
+	}
+}
+
+func Test_RuleValidate(t *testing.T) {
+	comment := Actions{{Type: ActionPostComment, Value: "done"}}
+	tests := []struct {
+		name string
+		rule Rule
+		err  error
+	}{
+		{
+			name: "valid",
+			rule: Rule{Trigger: Trigger{Type: TriggerCardMovedIn, ColumnID: 1}, Actions: comment},
+		},
+		{
+			name: "move trigger without column",
+			rule: Rule{Trigger: Trigger{Type: TriggerCardMovedOut}, Actions: comment},
+			err:  ErrInvalidTrigger,
+		},
+		{
+			name: "property on non property trigger",
+			rule: Rule{Trigger: Trigger{Type: TriggerCardCreated, Property: "tag"}, Actions: comment},
+			err:  ErrInvalidTrigger,
+		},
+		{
+			name: "broken text pattern",
+			rule: Rule{
+				Trigger:    Trigger{Type: TriggerCardCreated},
+				Conditions: Conditions{TextPattern: "(bug"},
+				Actions:    comment,
+			},
+			err: ErrInvalidPattern,
+		},
+		{
+			name: "no actions",
+			rule: Rule{Trigger: Trigger{Type: TriggerCardCreated}},
+			err:  ErrInvalidAction,
+		},
+		{
+			name: "move without column",
+			rule: Rule{Trigger: Trigger{Type: TriggerCardCreated}, Actions: Actions{{Type: ActionMoveCard}}},
+			err:  ErrInvalidAction,
+		},
+		{
+			name: "webhook with non http url",
+			rule: Rule{
+				Trigger: Trigger{Type: TriggerCardCreated},
+				Actions: Actions{{Type: ActionCallWebhook, URL: "ftp://example.com/hook"}},
+			},
+			err: ErrInvalidAction,
+		},
+		{
+			name: "webhook to metadata address",
+			rule: Rule{
+				Trigger: Trigger{Type: TriggerCardCreated},
+				Actions: Actions{{Type: ActionCallWebhook, URL: "http://169.254.169.254/latest"}},
+			},
+			err: ErrInvalidAction,
+		},
+		{
+			name: "webhook to localhost",
+			rule: Rule{
+				Trigger: Trigger{Type: TriggerCardCreated},
+				Actions: Actions{{Type: ActionCallWebhook, URL: "http://localhost:8080/hook"}},
+			},
+			err: ErrInvalidAction,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
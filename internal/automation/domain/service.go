@@ -0,0 +1,182 @@
+package domain
+
+import (
+	cardDomain "backend/internal/card/domain"
+	"context"
+	"errors"
+	"fmt"
+)
+
+type RuleGetter interface {
+	GetRuleList(ctx context.Context, boardID string) ([]*Rule, error)
+	GetEnabledRules(ctx context.Context, boardID string) ([]*Rule, error)
+	GetRule(ctx context.Context, boardID string, id uint64) (*Rule, error)
+	GetExecutionList(ctx context.Context, ruleID uint64, limit int) ([]*Execution, error)
+	IsBoardEditable(ctx context.Context, userID uint64, boardID string) (bool, error)
+	ColumnsExist(ctx context.Context, boardID string, columnIDs []uint64) (bool, error)
+}
+
+type RuleCreator interface {
+	CreateRule(ctx context.Context, rule *Rule) error
+	CreateExecution(ctx context.Context, execution *Execution) error
+}
+
+type RuleUpdater interface {
+	UpdateRule(ctx context.Context, rule *Rule) error
+}
+
+type RuleDeleter interface {
+	DeleteRule(ctx context.Context, boardID string, id uint64) error
+}
+
+type RuleRepo interface {
+	RuleGetter
+	RuleCreator
+	RuleUpdater
+	RuleDeleter
+}
+
+type CardService interface {
+	Get(ctx context.Context, userID uint64, req *cardDomain.Card, archived bool) (*cardDomain.CardListItem, error)
+	Update(ctx context.Context, req *cardDomain.Card) error
+	MoveToNewPosition(ctx context.Context, req *cardDomain.CardMoveCommand) error
+}
+
+type AutomationService struct {
+	repo        RuleRepo
+	cardService CardService
+}
+
+func NewAutomationService(repo RuleRepo, cardService CardService) *AutomationService {
+	return &AutomationService{
+		repo:        repo,
+		cardService: cardService,
+	}
+}
+
+func (s *AutomationService) GetList(ctx context.Context, cmd *RuleCommand) ([]*Rule, error) {
+	const op = "automation.service.GetList"
+	if err := s.checkBoardEditable(ctx, cmd.UserID, cmd.BoardID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	rules, err := s.repo.GetRuleList(ctx, cmd.BoardID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return rules, nil
+}
+
+func (s *AutomationService) Get(ctx context.Context, cmd *RuleCommand) (*Rule, error) {
+	const op = "automation.service.Get"
+	if err := s.checkBoardEditable(ctx, cmd.UserID, cmd.BoardID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	rule, err := s.repo.GetRule(ctx, cmd.BoardID, cmd.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return rule, nil
+}
+
+func (s *AutomationService) Create(ctx context.Context, userID uint64, rule *Rule) error {
+	const op = "automation.service.Create"
+	if err := s.prepareRule(ctx, userID, rule); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	rule.CreatedBy = userID
+	if err := s.repo.CreateRule(ctx, rule); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *AutomationService) Update(ctx context.Context, userID uint64, rule *Rule) error {
+	const op = "automation.service.Update"
+	if err := s.prepareRule(ctx, userID, rule); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.repo.UpdateRule(ctx, rule); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *AutomationService) Delete(ctx context.Context, cmd *RuleCommand) error {
+	const op = "automation.service.Delete"
+	if err := s.checkBoardEditable(ctx, cmd.UserID, cmd.BoardID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.repo.DeleteRule(ctx, cmd.BoardID, cmd.ID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// GetExecutionList возвращает последние записи журнала правила, новые первыми
+func (s *AutomationService) GetExecutionList(ctx context.Context, cmd *RuleCommand) ([]*Execution, error) {
+	const op = "automation.service.GetExecutionList"
+	if _, err := s.Get(ctx, cmd); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	executions, err := s.repo.GetExecutionList(ctx, cmd.ID, executionListLimit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return executions, nil
+}
+
+// DryRun проверяет условия правила на существующей карточке и показывает, какие действия выполнились бы.
+// Триггер не проверяется, карточка не меняется, в журнал ничего не пишется.
+func (s *AutomationService) DryRun(ctx context.Context, cmd *DryRunCommand) (*DryRunResult, error) {
+	const op = "automation.service.DryRun"
+	rule, err := s.Get(ctx, &cmd.RuleCommand)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	card, err := s.cardService.Get(ctx, cmd.UserID, &cardDomain.Card{ID: cmd.CardID, BoardID: cmd.BoardID}, false)
+	if errors.Is(err, cardDomain.ErrCardNotFound) {
+		return nil, fmt.Errorf("%s: %w", op, ErrCardNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := &DryRunResult{}
+	result.Conditions, result.Matched = rule.Conditions.Evaluate(&card.Card)
+	if result.Matched {
+		result.Actions = rule.Actions
+	}
+	return result, nil
+}
+
+func (s *AutomationService) prepareRule(ctx context.Context, userID uint64, rule *Rule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+	if err := s.checkBoardEditable(ctx, userID, rule.BoardID); err != nil {
+		return err
+	}
+	columnIDs := rule.ColumnIDs()
+	if len(columnIDs) == 0 {
+		return nil
+	}
+	exist, err := s.repo.ColumnsExist(ctx, rule.BoardID, columnIDs)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return ErrColumnNotExist
+	}
+	return nil
+}
+
+func (s *AutomationService) checkBoardEditable(ctx context.Context, userID uint64, boardID string) error {
+	editable, err := s.repo.IsBoardEditable(ctx, userID, boardID)
+	if err != nil {
+		return err
+	}
+	if !editable {
+		return ErrBoardNotEditable
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"backend/internal/shared/domain/events"
+	"context"
+	"log/slog"
+)
+
+type automationJob struct {
+	event events.Event
+	chain []uint64
+}
+
+// AutomationWorker выполняет правила вне запроса: шина только ставит событие в очередь,
+// поэтому медленный вебхук не задерживает ответ, а разрыв соединения клиента
+// не прерывает действия правила на середине. Цепочка сработавших правил переносится
+// вместе с событием, чтобы защита от зацикливания работала и через очередь.
+type AutomationWorker struct {
+	handler *AutomationEventHandler
+	jobs    chan automationJob
+}
+
+func NewAutomationWorker(handler *AutomationEventHandler, queueSize int) *AutomationWorker {
+	return &AutomationWorker{
+		handler: handler,
+		jobs:    make(chan automationJob, queueSize),
+	}
+}
+
+// Handle не блокирует: при переполненной очереди событие пропускается с записью в журнал.
+// Иначе обработчик, публикующий события из самого воркера, ждал бы сам себя.
+func (w *AutomationWorker) Handle(ctx context.Context, event events.Event) error {
+	const op = "automation.worker.Handle"
+	chain, _ := ctx.Value(ruleChainKey{}).([]uint64)
+	select {
+	case w.jobs <- automationJob{event: event, chain: chain}:
+	default:
+		slog.Error("automation queue is full, event dropped",
+			slog.String("op", op), slog.String("event", event.Name()))
+	}
+	return nil
+}
+
+func (w *AutomationWorker) Run(ctx context.Context) {
+	const op = "automation.worker.Run"
+	// начатое правило доводится до конца и при остановке сервера
+	jobCtx := context.WithoutCancel(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job := <-w.jobs:
+			if err := w.handler.Handle(context.WithValue(jobCtx, ruleChainKey{}, job.chain), job.event); err != nil {
+				slog.Error("run automation rules", slog.String("op", op), slog.Any("err", err))
+			}
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	cardDomain "backend/internal/card/domain"
+	"backend/internal/shared/domain/events"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WorkerCarriesRuleChain(t *testing.T) {
+	const boardID = "93a49b99-a029-4a18-bbbc-c10d91a8c267"
+	repo := &stubRuleRepo{rules: []*Rule{{
+		ID:      1,
+		BoardID: boardID,
+		Trigger: Trigger{Type: TriggerPropertyChanged, Property: "tag"},
+		Actions: Actions{{Type: ActionSetTag, Value: "urgent"}},
+	}}}
+	cards := &stubCardService{card: cardDomain.Card{
+		ID:             10,
+		BoardID:        boardID,
+		CardProperties: cardDomain.CardProperties{Tag: "bug"},
+	}}
+	worker := NewAutomationWorker(NewAutomationEventHandler(repo, cards, nil, nil), 8)
+	cards.handler = worker
+
+	// отменённый контекст запроса не должен мешать выполнению правила
+	reqCtx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, worker.Handle(reqCtx, events.CardPropertiesChangedEvent{
+		CardID:  10,
+		BoardID: boardID,
+		Changed: []string{"tag"},
+	}))
+	cancel()
+	assert.Empty(t, repo.executions)
+
+	// обработка по одному заданию, как в Run, без гонок со stub-репозиторием
+	runOne := func() {
+		job := <-worker.jobs
+		require.NoError(t, worker.handler.Handle(context.WithValue(context.Background(), ruleChainKey{}, job.chain), job.event))
+	}
+	runOne()
+	runOne()
+	assert.Zero(t, len(worker.jobs))
+
+	require.Len(t, repo.executions, 2)
+	assert.Equal(t, ExecutionSuccess, repo.executions[0].Status)
+	assert.Equal(t, ExecutionSkipped, repo.executions[1].Status)
+	assert.Equal(t, "urgent", cards.card.Tag)
+}
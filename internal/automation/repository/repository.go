@@ -0,0 +1,256 @@
+package repository
+
+import (
+	"backend/internal/automation/domain"
+	"backend/internal/shared/utils"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+const (
+	existsEditableBoardQuery = "SELECT EXISTS (SELECT 1 FROM boards WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL)"
+	// countBoardColumnsQuery — сколько колонок из списка $2 принадлежат доске $1
+	countBoardColumnsQuery = `
+		SELECT COUNT(*) FROM board_columns
+		WHERE board_id = $1 AND id = ANY($2) AND deleted_at IS NULL
+	`
+	selectRuleQuery = `
+		SELECT id, board_id, COALESCE(created_by, 0), name, enabled, trigger, conditions, actions,
+			created_at, updated_at
+		FROM automation_rules
+		WHERE board_id = $1 AND deleted_at IS NULL
+	`
+)
+
+type Storage interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+	Begin() (*sql.Tx, error)
+
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+	GetDB() *sql.DB
+	Close() error
+}
+
+type AutomationRepository struct {
+	storage Storage
+}
+
+func NewAutomationRepository(storage Storage) *AutomationRepository {
+	return &AutomationRepository{
+		storage: storage,
+	}
+}
+
+func (r *AutomationRepository) GetRuleList(ctx context.Context, boardID string) ([]*domain.Rule, error) {
+	const op = "automation.repository.GetRuleList"
+	rules, err := r.queryRules(ctx, selectRuleQuery+" ORDER BY id", boardID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return rules, nil
+}
+
+// GetEnabledRules возвращает включённые правила доски в порядке создания: в нём они и выполняются
+func (r *AutomationRepository) GetEnabledRules(ctx context.Context, boardID string) ([]*domain.Rule, error) {
+	const op = "automation.repository.GetEnabledRules"
+	rules, err := r.queryRules(ctx, selectRuleQuery+" AND enabled ORDER BY id", boardID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return rules, nil
+}
+
+func (r *AutomationRepository) GetRule(ctx context.Context, boardID string, id uint64) (*domain.Rule, error) {
+	const op = "automation.repository.GetRule"
+	rule := &domain.Rule{}
+	err := scanRule(r.storage.QueryRowContext(ctx, selectRuleQuery+" AND id = $2", boardID, id), rule)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%s: %w", op, domain.ErrRuleNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return rule, nil
+}
+
+func (r *AutomationRepository) CreateRule(ctx context.Context, rule *domain.Rule) error {
+	const op = "automation.repository.CreateRule"
+	query := `
+		INSERT INTO automation_rules (board_id, created_by, name, enabled, trigger, conditions, actions)
+		VALUES ($1, NULLIF($2, 0), $3, $4, $5, $6, $7)
+		RETURNING id, created_at, updated_at
+	`
+	if err := r.storage.QueryRowContext(
+		ctx,
+		query,
+		rule.BoardID,
+		rule.CreatedBy,
+		rule.Name,
+		rule.Enabled,
+		rule.Trigger,
+		rule.Conditions,
+		rule.Actions,
+	).Scan(&rule.ID, &rule.CreatedAt, &rule.UpdatedAt); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (r *AutomationRepository) UpdateRule(ctx context.Context, rule *domain.Rule) error {
+	const op = "automation.repository.UpdateRule"
+	query := `
+		UPDATE automation_rules
+		SET name = $1, enabled = $2, trigger = $3, conditions = $4, actions = $5, updated_at = NOW()
+		WHERE id = $6 AND board_id = $7 AND deleted_at IS NULL
+		RETURNING COALESCE(created_by, 0), created_at, updated_at
+	`
+	err := r.storage.QueryRowContext(
+		ctx,
+		query,
+		rule.Name,
+		rule.Enabled,
+		rule.Trigger,
+		rule.Conditions,
+		rule.Actions,
+		rule.ID,
+		rule.BoardID,
+	).Scan(&rule.CreatedBy, &rule.CreatedAt, &rule.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%s: %w", op, domain.ErrRuleNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (r *AutomationRepository) DeleteRule(ctx context.Context, boardID string, id uint64) error {
+	const op = "automation.repository.DeleteRule"
+	query := "UPDATE automation_rules SET deleted_at = NOW() WHERE id = $1 AND board_id = $2 AND deleted_at IS NULL"
+	return utils.OpExec(ctx, r.storage.ExecContext, op, query, domain.ErrRuleNotFound, id, boardID)
+}
+
+func (r *AutomationRepository) CreateExecution(ctx context.Context, execution *domain.Execution) error {
+	const op = "automation.repository.CreateExecution"
+	query := `
+		INSERT INTO automation_executions (rule_id, card_id, event, status, error)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+	if err := r.storage.QueryRowContext(
+		ctx,
+		query,
+		execution.RuleID,
+		execution.CardID,
+		execution.Event,
+		execution.Status,
+		execution.Error,
+	).Scan(&execution.ID, &execution.CreatedAt); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (r *AutomationRepository) GetExecutionList(ctx context.Context, ruleID uint64, limit int) ([]*domain.Execution, error) {
+	const op = "automation.repository.GetExecutionList"
+	query := `
+		SELECT id, rule_id, card_id, event, status, error, created_at
+		FROM automation_executions
+		WHERE rule_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`
+	rows, err := r.storage.QueryContext(ctx, query, ruleID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	executions := []*domain.Execution{}
+	for rows.Next() {
+		execution := &domain.Execution{}
+		if err := rows.Scan(
+			&execution.ID,
+			&execution.RuleID,
+			&execution.CardID,
+			&execution.Event,
+			&execution.Status,
+			&execution.Error,
+			&execution.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		executions = append(executions, execution)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return executions, nil
+}
+
+func (r *AutomationRepository) IsBoardEditable(ctx context.Context, userID uint64, boardID string) (bool, error) {
+	const op = "automation.repository.IsBoardEditable"
+	editable, err := utils.ExistsQueryWrapper(ctx, r.storage, existsEditableBoardQuery, boardID, userID)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return editable, nil
+}
+
+func (r *AutomationRepository) ColumnsExist(ctx context.Context, boardID string, columnIDs []uint64) (bool, error) {
+	const op = "automation.repository.ColumnsExist"
+	var count int
+	if err := r.storage.QueryRowContext(
+		ctx, countBoardColumnsQuery, boardID, utils.ToInt64Slice(columnIDs),
+	).Scan(&count); err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return count == len(columnIDs), nil
+}
+
+func (r *AutomationRepository) queryRules(ctx context.Context, query string, args ...any) ([]*domain.Rule, error) {
+	rows, err := r.storage.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := []*domain.Rule{}
+	for rows.Next() {
+		rule := &domain.Rule{}
+		if err := scanRule(rows, rule); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanRule(row scanner, rule *domain.Rule) error {
+	return row.Scan(
+		&rule.ID,
+		&rule.BoardID,
+		&rule.CreatedBy,
+		&rule.Name,
+		&rule.Enabled,
+		&rule.Trigger,
+		&rule.Conditions,
+		&rule.Actions,
+		&rule.CreatedAt,
+		&rule.UpdatedAt,
+	)
+}
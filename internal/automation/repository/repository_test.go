@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"backend/internal/automation/domain"
+	"backend/internal/shared/testdb"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestBoard создаёт доску с одной колонкой и одной карточкой
+func createTestBoard(t *testing.T, storage *testdb.Storage) (userID uint64, boardID string, columnID, cardID uint64) {
+	t.Helper()
+	email := fmt.Sprintf("automation-repository-%d@test.local", time.Now().UnixNano())
+	require.NoError(t, storage.QueryRow(
+		"INSERT INTO users (name, email, password, refresh_token) VALUES ('test', $1, '', '') RETURNING id", email,
+	).Scan(&userID))
+	require.NoError(t, storage.QueryRow(
+		"INSERT INTO boards (name, user_id, key) VALUES ('test', $1, 'TST') RETURNING id", userID,
+	).Scan(&boardID))
+	require.NoError(t, storage.QueryRow(
+		"INSERT INTO board_columns (board_id, name, rank) VALUES ($1, 'todo', '1') RETURNING id", boardID,
+	).Scan(&columnID))
+	require.NoError(t, storage.QueryRow(
+		"INSERT INTO cards (board_id, column_id, text, rank, number) VALUES ($1, $2, 'card', 'a', 1) RETURNING id",
+		boardID, columnID,
+	).Scan(&cardID))
+
+	t.Cleanup(func() {
+		storage.Exec("DELETE FROM automation_rules WHERE board_id = $1", boardID)
+		storage.Exec("DELETE FROM cards WHERE board_id = $1", boardID)
+		storage.Exec("DELETE FROM board_columns WHERE board_id = $1", boardID)
+		storage.Exec("DELETE FROM boards WHERE id = $1", boardID)
+		storage.Exec("DELETE FROM users WHERE id = $1", userID)
+	})
+	return userID, boardID, columnID, cardID
+}
+
+func Test_RuleRoundTrip(t *testing.T) {
+	storage := testdb.Open(t)
+	repo := NewAutomationRepository(storage)
+	userID, boardID, columnID, cardID := createTestBoard(t, storage)
+	ctx := context.Background()
+
+	rule := &domain.Rule{
+		BoardID:    boardID,
+		CreatedBy:  userID,
+		Name:       "tag bugs",
+		Enabled:    true,
+		Trigger:    domain.Trigger{Type: domain.TriggerCardMovedIn, ColumnID: columnID},
+		Conditions: domain.Conditions{TextPattern: "(?i)bug"},
+		Actions:    domain.Actions{{Type: domain.ActionSetTag, Value: "bug"}},
+	}
+	require.NoError(t, repo.CreateRule(ctx, rule))
+
+	saved, err := repo.GetRule(ctx, boardID, rule.ID)
+	require.NoError(t, err)
+	assert.Equal(t, rule.Trigger, saved.Trigger)
+	assert.Equal(t, rule.Conditions, saved.Conditions)
+	assert.Equal(t, rule.Actions, saved.Actions)
+	assert.Equal(t, userID, saved.CreatedBy)
+
+	exist, err := repo.ColumnsExist(ctx, boardID, []uint64{columnID})
+	require.NoError(t, err)
+	assert.True(t, exist)
+	exist, err = repo.ColumnsExist(ctx, boardID, []uint64{columnID, columnID + 1000000})
+	require.NoError(t, err)
+	assert.False(t, exist)
+
+	rule.Enabled = false
+	require.NoError(t, repo.UpdateRule(ctx, rule))
+	enabled, err := repo.GetEnabledRules(ctx, boardID)
+	require.NoError(t, err)
+	assert.Empty(t, enabled)
+
+	for _, status := range []string{domain.ExecutionSuccess, domain.ExecutionSkipped} {
+		require.NoError(t, repo.CreateExecution(ctx, &domain.Execution{
+			RuleID: rule.ID,
+			CardID: cardID,
+			Event:  "CardMoved",
+			Status: status,
+		}))
+	}
+	executions, err := repo.GetExecutionList(ctx, rule.ID, 1)
+	require.NoError(t, err)
+	require.Len(t, executions, 1)
+	assert.Equal(t, domain.ExecutionSkipped, executions[0].Status)
+
+	require.NoError(t, repo.DeleteRule(ctx, boardID, rule.ID))
+	_, err = repo.GetRule(ctx, boardID, rule.ID)
+	assert.ErrorIs(t, err, domain.ErrRuleNotFound)
+	assert.ErrorIs(t, repo.DeleteRule(ctx, boardID, rule.ID), domain.ErrRuleNotFound)
+}
@@ -0,0 +1,232 @@
+package transport
+
+import (
+	"backend/internal/automation/domain"
+	"backend/internal/shared/ports/http"
+	"backend/internal/shared/utils"
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	BoardIDKey = "id"
+	RuleIDKey  = "rule_id"
+)
+
+type AutomationService interface {
+	GetList(ctx context.Context, cmd *domain.RuleCommand) ([]*domain.Rule, error)
+	Get(ctx context.Context, cmd *domain.RuleCommand) (*domain.Rule, error)
+	Create(ctx context.Context, userID uint64, rule *domain.Rule) error
+	Update(ctx context.Context, userID uint64, rule *domain.Rule) error
+	Delete(ctx context.Context, cmd *domain.RuleCommand) error
+	GetExecutionList(ctx context.Context, cmd *domain.RuleCommand) ([]*domain.Execution, error)
+	DryRun(ctx context.Context, cmd *domain.DryRunCommand) (*domain.DryRunResult, error)
+}
+
+type AutomationHandler struct {
+	validator        http.Validator
+	service          AutomationService
+	automationMapper *AutomationMapper
+}
+
+func NewAutomationHandler(validator http.Validator, service AutomationService) *AutomationHandler {
+	return &AutomationHandler{
+		validator:        validator,
+		service:          service,
+		automationMapper: &AutomationMapper{},
+	}
+}
+
+func (h *AutomationHandler) GetRuleList(c *fiber.Ctx) error {
+	const op = "automation.transport.handler.GetRuleList"
+	userID, ok := c.Locals(utils.UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"errors": "Unauthorized"})
+	}
+
+	rules, err := h.service.GetList(c.Context(), &domain.RuleCommand{
+		UserID:  userID,
+		BoardID: c.Params(BoardIDKey),
+	})
+	if err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.JSON(h.automationMapper.ToRuleListResponse(rules))
+}
+
+func (h *AutomationHandler) GetRule(c *fiber.Ctx) error {
+	const op = "automation.transport.handler.GetRule"
+	cmd, err := h.commandFromParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid rule ID"})
+	}
+
+	rule, err := h.service.Get(c.Context(), cmd)
+	if err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.JSON(h.automationMapper.ToRuleResponse(rule))
+}
+
+func (h *AutomationHandler) CreateRule(c *fiber.Ctx) error {
+	const op = "automation.transport.handler.CreateRule"
+	body, err := utils.ParseBody[RuleRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	body.BoardID = c.Params(BoardIDKey)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	rule := h.automationMapper.ToRule(body)
+	if err := h.service.Create(c.Context(), body.UserID, rule); err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(h.automationMapper.ToRuleResponse(rule))
+}
+
+func (h *AutomationHandler) UpdateRule(c *fiber.Ctx) error {
+	const op = "automation.transport.handler.UpdateRule"
+	body, err := utils.ParseBody[RuleRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	ruleID, err := strconv.ParseUint(c.Params(RuleIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid rule ID"})
+	}
+	body.ID = ruleID
+	body.BoardID = c.Params(BoardIDKey)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	rule := h.automationMapper.ToRule(body)
+	if err := h.service.Update(c.Context(), body.UserID, rule); err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.JSON(h.automationMapper.ToRuleResponse(rule))
+}
+
+func (h *AutomationHandler) DeleteRule(c *fiber.Ctx) error {
+	const op = "automation.transport.handler.DeleteRule"
+	cmd, err := h.commandFromParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid rule ID"})
+	}
+
+	if err := h.service.Delete(c.Context(), cmd); err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *AutomationHandler) GetExecutionList(c *fiber.Ctx) error {
+	const op = "automation.transport.handler.GetExecutionList"
+	cmd, err := h.commandFromParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid rule ID"})
+	}
+
+	executions, err := h.service.GetExecutionList(c.Context(), cmd)
+	if err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.JSON(h.automationMapper.ToExecutionListResponse(executions))
+}
+
+func (h *AutomationHandler) DryRun(c *fiber.Ctx) error {
+	const op = "automation.transport.handler.DryRun"
+	body, err := utils.ParseBody[DryRunRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	ruleID, err := strconv.ParseUint(c.Params(RuleIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid rule ID"})
+	}
+	body.RuleID = ruleID
+	body.BoardID = c.Params(BoardIDKey)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	result, err := h.service.DryRun(c.Context(), h.automationMapper.ToDryRunCommand(body))
+	if err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.JSON(h.automationMapper.ToDryRunResponse(result))
+}
+
+func (h *AutomationHandler) commandFromParams(c *fiber.Ctx) (*domain.RuleCommand, error) {
+	ruleID, err := strconv.ParseUint(c.Params(RuleIDKey), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	userID, ok := c.Locals(utils.UserIDKey).(uint64)
+	if !ok {
+		return nil, errors.New("missing user ID")
+	}
+	return &domain.RuleCommand{
+		ID:      ruleID,
+		UserID:  userID,
+		BoardID: c.Params(BoardIDKey),
+	}, nil
+}
+
+func (h *AutomationHandler) serviceError(c *fiber.Ctx, op string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrRuleNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Automation rule not found"})
+	case errors.Is(err, domain.ErrCardNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Card not found"})
+	case errors.Is(err, domain.ErrColumnNotExist):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Column not found"})
+	case errors.Is(err, domain.ErrBoardNotEditable):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"errors": domain.ErrBoardNotEditable.Error()})
+	}
+	for _, target := range []error{
+		domain.ErrInvalidTrigger,
+		domain.ErrInvalidAction,
+		domain.ErrInvalidPattern,
+	} {
+		if errors.Is(err, target) {
+			return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{"errors": target.Error()})
+		}
+	}
+	slog.Error(
+		"service error",
+		slog.String("operation", op),
+		slog.Any("errors", err),
+	)
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+}
@@ -0,0 +1,133 @@
+package transport
+
+import (
+	"backend/internal/automation/domain"
+)
+
+type AutomationMapper struct{}
+
+// ToRule — правило без поля enabled создаётся включённым
+func (m *AutomationMapper) ToRule(req *RuleRequest) *domain.Rule {
+	if req == nil {
+		return nil
+	}
+
+	rule := &domain.Rule{
+		ID:      req.ID,
+		BoardID: req.BoardID,
+		Name:    req.Name,
+		Enabled: req.Enabled == nil || *req.Enabled,
+		Trigger: domain.Trigger{
+			Type:     req.Trigger.Type,
+			ColumnID: req.Trigger.ColumnID,
+			Property: req.Trigger.Property,
+		},
+		Conditions: domain.Conditions{
+			ColumnID:    req.Conditions.ColumnID,
+			Tag:         req.Conditions.Tag,
+			Color:       req.Conditions.Color,
+			TextPattern: req.Conditions.TextPattern,
+		},
+		Actions: make(domain.Actions, 0, len(req.Actions)),
+	}
+	for _, action := range req.Actions {
+		rule.Actions = append(rule.Actions, domain.Action{
+			Type:     action.Type,
+			ColumnID: action.ColumnID,
+			Value:    action.Value,
+			URL:      action.URL,
+		})
+	}
+	return rule
+}
+
+func (m *AutomationMapper) ToDryRunCommand(req *DryRunRequest) *domain.DryRunCommand {
+	if req == nil {
+		return nil
+	}
+
+	return &domain.DryRunCommand{
+		RuleCommand: domain.RuleCommand{
+			ID:      req.RuleID,
+			UserID:  req.UserID,
+			BoardID: req.BoardID,
+		},
+		CardID: req.CardID,
+	}
+}
+
+func (m *AutomationMapper) ToRuleResponse(rule *domain.Rule) *RuleResponse {
+	return &RuleResponse{
+		ID:        rule.ID,
+		BoardID:   rule.BoardID,
+		CreatedBy: rule.CreatedBy,
+		Name:      rule.Name,
+		Enabled:   rule.Enabled,
+		Trigger: TriggerResponse{
+			Type:     rule.Trigger.Type,
+			ColumnID: rule.Trigger.ColumnID,
+			Property: rule.Trigger.Property,
+		},
+		Conditions: ConditionsResponse{
+			ColumnID:    rule.Conditions.ColumnID,
+			Tag:         rule.Conditions.Tag,
+			Color:       rule.Conditions.Color,
+			TextPattern: rule.Conditions.TextPattern,
+		},
+		Actions:   m.toActionListResponse(rule.Actions),
+		CreatedAt: rule.CreatedAt,
+		UpdatedAt: rule.UpdatedAt,
+	}
+}
+
+func (m *AutomationMapper) ToRuleListResponse(rules []*domain.Rule) []*RuleResponse {
+	response := make([]*RuleResponse, 0, len(rules))
+	for _, rule := range rules {
+		response = append(response, m.ToRuleResponse(rule))
+	}
+	return response
+}
+
+func (m *AutomationMapper) ToExecutionListResponse(executions []*domain.Execution) []*ExecutionResponse {
+	response := make([]*ExecutionResponse, 0, len(executions))
+	for _, execution := range executions {
+		response = append(response, &ExecutionResponse{
+			ID:        execution.ID,
+			RuleID:    execution.RuleID,
+			CardID:    execution.CardID,
+			Event:     execution.Event,
+			Status:    execution.Status,
+			Error:     execution.Error,
+			CreatedAt: execution.CreatedAt,
+		})
+	}
+	return response
+}
+
+func (m *AutomationMapper) ToDryRunResponse(result *domain.DryRunResult) *DryRunResponse {
+	response := &DryRunResponse{
+		Matched:    result.Matched,
+		Conditions: make([]ConditionResponse, 0, len(result.Conditions)),
+		Actions:    m.toActionListResponse(result.Actions),
+	}
+	for _, condition := range result.Conditions {
+		response.Conditions = append(response.Conditions, ConditionResponse{
+			Field:   condition.Field,
+			Matched: condition.Matched,
+		})
+	}
+	return response
+}
+
+func (m *AutomationMapper) toActionListResponse(actions domain.Actions) []ActionResponse {
+	response := make([]ActionResponse, 0, len(actions))
+	for _, action := range actions {
+		response = append(response, ActionResponse{
+			Type:     action.Type,
+			ColumnID: action.ColumnID,
+			Value:    action.Value,
+			URL:      action.URL,
+		})
+	}
+	return response
+}
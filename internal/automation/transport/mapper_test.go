@@ -0,0 +1,113 @@
+package transport
+
+import (
+	"backend/internal/automation/domain"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ToRule(t *testing.T) {
+	mapper := AutomationMapper{}
+	boardID := "93a49b99-a029-4a18-bbbc-c10d91a8c267"
+	disabled := false
+	tests := []struct {
+		name     string
+		req      *RuleRequest
+		expected *domain.Rule
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "enabled by default",
+			req: &RuleRequest{
+				ID:         3,
+				UserID:     1,
+				BoardID:    boardID,
+				Name:       "done",
+				Trigger:    TriggerRequest{Type: domain.TriggerCardMovedIn, ColumnID: 7},
+				Conditions: ConditionsRequest{Tag: "bug", TextPattern: "^Fix"},
+				Actions: []ActionRequest{
+					{Type: domain.ActionPostComment, Value: "Fixed"},
+					{Type: domain.ActionCallWebhook, URL: "https://example.com/hook"},
+				},
+			},
+			expected: &domain.Rule{
+				ID:         3,
+				BoardID:    boardID,
+				Name:       "done",
+				Enabled:    true,
+				Trigger:    domain.Trigger{Type: domain.TriggerCardMovedIn, ColumnID: 7},
+				Conditions: domain.Conditions{Tag: "bug", TextPattern: "^Fix"},
+				Actions: domain.Actions{
+					{Type: domain.ActionPostComment, Value: "Fixed"},
+					{Type: domain.ActionCallWebhook, URL: "https://example.com/hook"},
+				},
+			},
+		},
+		{
+			name: "disabled",
+			req: &RuleRequest{
+				BoardID: boardID,
+				Name:    "paint",
+				Enabled: &disabled,
+				Trigger: TriggerRequest{Type: domain.TriggerPropertyChanged, Property: "tag"},
+				Actions: []ActionRequest{{Type: domain.ActionSetColor, Value: "#ff0000"}},
+			},
+			expected: &domain.Rule{
+				BoardID: boardID,
+				Name:    "paint",
+				Trigger: domain.Trigger{Type: domain.TriggerPropertyChanged, Property: "tag"},
+				Actions: domain.Actions{{Type: domain.ActionSetColor, Value: "#ff0000"}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToRule(tc.req))
+		})
+	}
+}
+
+func Test_ToDryRunResponse(t *testing.T) {
+	mapper := AutomationMapper{}
+	tests := []struct {
+		name     string
+		result   *domain.DryRunResult
+		expected *DryRunResponse
+	}{
+		{
+			name:   "not matched",
+			result: &domain.DryRunResult{Conditions: []domain.ConditionResult{{Field: "tag"}}},
+			expected: &DryRunResponse{
+				Conditions: []ConditionResponse{{Field: "tag"}},
+				Actions:    []ActionResponse{},
+			},
+		},
+		{
+			name: "matched",
+			result: &domain.DryRunResult{
+				Matched: true,
+				Actions: domain.Actions{{Type: domain.ActionMoveCard, ColumnID: 2}},
+			},
+			expected: &DryRunResponse{
+				Matched:    true,
+				Conditions: []ConditionResponse{},
+				Actions:    []ActionResponse{{Type: domain.ActionMoveCard, ColumnID: 2}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToDryRunResponse(tc.result))
+		})
+	}
+}
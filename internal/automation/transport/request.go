@@ -0,0 +1,40 @@
+package transport
+
+// RuleRequest — зависимости полей от типа триггера и действия проверяет домен
+type RuleRequest struct {
+	ID         uint64
+	UserID     uint64            `validate:"required,min=1"`
+	BoardID    string            `validate:"required,uuid"`
+	Name       string            `json:"name" validate:"required,min=1,max=255"`
+	Enabled    *bool             `json:"enabled,omitempty"`
+	Trigger    TriggerRequest    `json:"trigger"`
+	Conditions ConditionsRequest `json:"conditions"`
+	Actions    []ActionRequest   `json:"actions" validate:"required,min=1,max=10,dive"`
+}
+
+type TriggerRequest struct {
+	Type     string `json:"type" validate:"required,oneof=card_created card_moved_in card_moved_out property_changed"`
+	ColumnID uint64 `json:"column_id,omitempty"`
+	Property string `json:"property,omitempty" validate:"omitempty,oneof=color tag estimate"`
+}
+
+type ConditionsRequest struct {
+	ColumnID    uint64 `json:"column_id,omitempty"`
+	Tag         string `json:"tag,omitempty" validate:"max=255"`
+	Color       string `json:"color,omitempty" validate:"omitempty,hexcolor"`
+	TextPattern string `json:"text_pattern,omitempty" validate:"max=255"`
+}
+
+type ActionRequest struct {
+	Type     string `json:"type" validate:"required,oneof=move_card set_color set_tag post_comment call_webhook"`
+	ColumnID uint64 `json:"column_id,omitempty"`
+	Value    string `json:"value,omitempty" validate:"max=1000"`
+	URL      string `json:"url,omitempty" validate:"omitempty,url,max=2048"`
+}
+
+type DryRunRequest struct {
+	UserID  uint64 `validate:"required,min=1"`
+	BoardID string `validate:"required,uuid"`
+	RuleID  uint64 `validate:"required,min=1"`
+	CardID  uint64 `json:"card_id" validate:"required,min=1"`
+}
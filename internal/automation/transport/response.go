@@ -0,0 +1,57 @@
+package transport
+
+import "time"
+
+type RuleResponse struct {
+	ID         uint64             `json:"id"`
+	BoardID    string             `json:"board_id"`
+	CreatedBy  uint64             `json:"created_by,omitempty"`
+	Name       string             `json:"name"`
+	Enabled    bool               `json:"enabled"`
+	Trigger    TriggerResponse    `json:"trigger"`
+	Conditions ConditionsResponse `json:"conditions"`
+	Actions    []ActionResponse   `json:"actions"`
+	CreatedAt  time.Time          `json:"created_at"`
+	UpdatedAt  time.Time          `json:"updated_at"`
+}
+
+type TriggerResponse struct {
+	Type     string `json:"type"`
+	ColumnID uint64 `json:"column_id,omitempty"`
+	Property string `json:"property,omitempty"`
+}
+
+type ConditionsResponse struct {
+	ColumnID    uint64 `json:"column_id,omitempty"`
+	Tag         string `json:"tag,omitempty"`
+	Color       string `json:"color,omitempty"`
+	TextPattern string `json:"text_pattern,omitempty"`
+}
+
+type ActionResponse struct {
+	Type     string `json:"type"`
+	ColumnID uint64 `json:"column_id,omitempty"`
+	Value    string `json:"value,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+type ExecutionResponse struct {
+	ID        uint64    `json:"id"`
+	RuleID    uint64    `json:"rule_id"`
+	CardID    uint64    `json:"card_id"`
+	Event     string    `json:"event"`
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type DryRunResponse struct {
+	Matched    bool                `json:"matched"`
+	Conditions []ConditionResponse `json:"conditions"`
+	Actions    []ActionResponse    `json:"actions"`
+}
+
+type ConditionResponse struct {
+	Field   string `json:"field"`
+	Matched bool   `json:"matched"`
+}
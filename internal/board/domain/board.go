@@ -1,17 +1,49 @@
 package domain
 
-import "time"
+import (
+	cardDomain "backend/internal/card/domain"
+	viewDomain "backend/internal/view/domain"
+	"strings"
+	"time"
+)
 
 type Board struct {
 	ID          string
+	Key         string
 	Name        string
 	Description string
 	UserID      uint64
+	Version     uint64
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	ArchivedAt  *time.Time
 	DeletedAt   *time.Time
 }
 
+const (
+	// DefaultBoardKey — префикс для досок, в названии которых нет латинских букв
+	DefaultBoardKey      = "BRD"
+	boardKeyPrefixLength = 4
+	maxBoardKeyAttempts  = 100
+)
+
+// BoardKeyFromName строит префикс ключа карточек из первых латинских букв названия: "Web app" -> "WEBA"
+func BoardKeyFromName(name string) string {
+	key := make([]rune, 0, boardKeyPrefixLength)
+	for _, r := range strings.ToUpper(name) {
+		if r >= 'A' && r <= 'Z' {
+			key = append(key, r)
+		}
+		if len(key) == boardKeyPrefixLength {
+			break
+		}
+	}
+	if len(key) == 0 {
+		return DefaultBoardKey
+	}
+	return string(key)
+}
+
 type BoardListResult struct {
 	Data        []*Board
 	PerPage     uint64
@@ -27,6 +59,15 @@ type BoardWithDetails[T any] struct {
 	*Board
 	Columns []*BoardColumn
 	Cards   []*T
+	View    *viewDomain.View
+}
+
+// BoardDetailsFilter — фильтр карточек доски; условия сохранённого представления ViewID
+// добавляются к условиям Cards. Archived открывает архивную доску и добавляет архивные колонки и карточки.
+type BoardDetailsFilter struct {
+	ViewID   *uint64
+	Archived bool
+	Cards    *cardDomain.CardListFilter
 }
 
 type BoardMoveCommand struct {
@@ -36,10 +77,35 @@ type BoardMoveCommand struct {
 	ToPosition   uint64
 }
 
+const (
+	DuplicateColumns = "columns"
+	DuplicateCards   = "cards"
+)
+
+// BoardDuplicateCommand — копия доски для пользователя UserID. Depth columns копирует только колонки,
+// cards — колонки с карточками; WithComments добавляет комментарии карточек. Пустые Name и Key берутся
+// из исходной доски и подбираются заново.
+type BoardDuplicateCommand struct {
+	UserID       uint64
+	BoardID      string
+	Name         string
+	Key          string
+	Depth        string
+	WithComments bool
+}
+
+// BoardColumnOrderCommand — новый порядок всех живых колонок доски
+type BoardColumnOrderCommand struct {
+	BoardID   string
+	ColumnIDs []uint64
+}
+
+// BoardGetFilter — Archived добавляет к списку архивные доски
 type BoardGetFilter struct {
 	UserID       uint64
 	PerPage      uint64
 	Page         uint64
+	Archived     bool
 	FilterFields *Filters
 }
 
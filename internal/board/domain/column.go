@@ -2,11 +2,21 @@ package domain
 
 import "time"
 
+const (
+	ColumnCategoryTodo       = "todo"
+	ColumnCategoryInProgress = "in_progress"
+	ColumnCategoryDone       = "done"
+)
+
 type BoardColumn struct {
-	ID        uint64
-	Position  uint64
-	BoardID   string
-	Name      string
-	Color     string
-	CreatedAt time.Time
+	ID         uint64
+	Position   uint64
+	Rank       string
+	BoardID    string
+	Name       string
+	Color      string
+	Category   string
+	Version    uint64
+	CreatedAt  time.Time
+	ArchivedAt *time.Time
 }
@@ -5,7 +5,15 @@ import "errors"
 var (
 	ErrBoardAlreadyExists      = errors.New("board already exists")
 	ErrBoardNotFound           = errors.New("board not found")
+	ErrBoardKeyTaken           = errors.New("board key is already taken")
 	ErrColumnNotFound          = errors.New("column not found")
+	ErrBoardArchived           = errors.New("board is archived")
+	ErrBoardNotArchived        = errors.New("board is not archived")
+	ErrColumnArchived          = errors.New("column is archived")
+	ErrColumnNotArchived       = errors.New("column is not archived")
 	ErrInvalidPosition         = errors.New("invalid position")
+	ErrColumnSetMismatch       = errors.New("column list does not match board columns")
 	ErrInvalidMaxPositionValue = errors.New("invalid max position value")
+	ErrTemplateNotFound        = errors.New("template not found")
+	ErrBuiltinTemplate         = errors.New("built-in template cannot be changed")
 )
@@ -3,6 +3,9 @@ package domain
 import (
 	"backend/internal/card/domain"
 	"backend/internal/shared/domain/events"
+	queryFilter "backend/internal/shared/filter"
+	"backend/internal/shared/rank"
+	viewDomain "backend/internal/view/domain"
 	"context"
 	"fmt"
 	"math"
@@ -12,55 +15,90 @@ import (
 
 type BoardCreator interface {
 	Create(ctx context.Context, board *Board) error
+	Duplicate(ctx context.Context, cmd *BoardDuplicateCommand, board *Board) (string, error)
 	CreateColumn(ctx context.Context, column *BoardColumn) error
 }
 
 type BoardUpdater interface {
 	Update(ctx context.Context, board *Board) error
 	UpdateColumn(ctx context.Context, column *BoardColumn) error
-	MoveColumn(ctx context.Context, id string, columnID, fromPosition, toPosition uint64) error
+	MoveColumn(ctx context.Context, id string, columnID, position uint64) error
+	ReorderColumns(ctx context.Context, uuid string, columnIDs []uint64) error
+	RebalanceColumns(ctx context.Context, uuid string) error
+	Archive(ctx context.Context, board *Board) error
+	Restore(ctx context.Context, board *Board) error
+	ArchiveColumn(ctx context.Context, column *BoardColumn) error
+	RestoreColumn(ctx context.Context, column *BoardColumn) error
 }
 
 type BoardDeleter interface {
 	Delete(ctx context.Context, uuid string) error
+	DeleteCascade(ctx context.Context, uuid string) error
 	DeleteColumn(ctx context.Context, column *BoardColumn) error
+	DeleteColumnCascade(ctx context.Context, column *BoardColumn) error
 }
 
 type BoardGetter interface {
 	Get(ctx context.Context, board *Board) (*Board, error)
 	GetList(ctx context.Context, filter *BoardGetFilter) (*BoardListResult, error)
 	GetColumnByID(ctx context.Context, column *BoardColumn) (*BoardColumn, error)
-	GetColumnList(ctx context.Context, uuid string) ([]*BoardColumn, error)
+	GetColumnList(ctx context.Context, uuid string, archived bool) ([]*BoardColumn, error)
 	GetMaxPositionValue(ctx context.Context, uuid string) (uint64, error)
+	GetBoardsToRebalance(ctx context.Context, maxLength int) ([]string, error)
 	Exists(ctx context.Context, uuid string) (bool, error)
+	KeyExists(ctx context.Context, userID uint64, key, excludeBoardID string) (bool, error)
 	ExistsColumn(ctx context.Context, uuid string, columnID uint64) (bool, error)
 }
 
+type BoardTemplateRepo interface {
+	GetTemplate(ctx context.Context, id string, userID uint64) (*BoardTemplate, error)
+	GetTemplateList(ctx context.Context, userID uint64) ([]*BoardTemplate, error)
+	CreateTemplate(ctx context.Context, cmd *BoardTemplatePublishCommand) (string, error)
+	DeleteTemplate(ctx context.Context, id string, userID uint64) error
+	CreateFromTemplate(ctx context.Context, board *Board, content BoardTemplateContent) (string, error)
+}
+
 type BoardRepo interface {
 	BoardCreator
 	BoardUpdater
 	BoardDeleter
 	BoardGetter
+	BoardTemplateRepo
 }
 
 type CardService interface {
-	GetListWithComments(ctx context.Context, boardID string) ([]*domain.CardWithComments, error)
+	GetListWithComments(ctx context.Context, boardID string, filter *domain.CardListFilter) ([]*domain.CardWithComments, error)
 	Create(ctx context.Context, req *domain.Card) error
 	Update(ctx context.Context, req *domain.Card) error
 	Delete(ctx context.Context, req *domain.Card) error
 	MoveToNewPosition(ctx context.Context, req *domain.CardMoveCommand) error
 }
 
+type ViewService interface {
+	Get(ctx context.Context, req *viewDomain.View) (*viewDomain.View, error)
+}
+
+// Translator переводит ключи встроенных шаблонов на язык запроса
+type Translator interface {
+	GetTemplateText(ctx context.Context, key string) string
+}
+
 type BoardService struct {
 	repo        BoardRepo
 	cardService CardService
+	viewService ViewService
+	translator  Translator
 	bus         events.EventDispatcher
 }
 
-func NewBoardService(repo BoardRepo, cardService CardService, bus events.EventDispatcher) *BoardService {
+func NewBoardService(
+	repo BoardRepo, cardService CardService, viewService ViewService, translator Translator, bus events.EventDispatcher,
+) *BoardService {
 	return &BoardService{
 		repo:        repo,
 		cardService: cardService,
+		viewService: viewService,
+		translator:  translator,
 		bus:         bus,
 	}
 }
@@ -95,16 +133,23 @@ func (s *BoardService) GetList(
 	return response, nil
 }
 
-func (s *BoardService) GetByUUID(ctx context.Context, req *Board) (*BoardWithDetails[domain.CardWithComments], error) {
+func (s *BoardService) GetByUUID(
+	ctx context.Context, req *Board, filter *BoardDetailsFilter,
+) (*BoardWithDetails[domain.CardWithComments], error) {
 	const op = "board.service.GetByUUID"
 	var (
 		rawBoard   *Board
 		rawColumns []*BoardColumn
 		cards      []*domain.CardWithComments
 	)
+	archived := filter != nil && filter.Archived
+	cardFilter, view, err := s.applyView(ctx, req, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 	eg, ctx := errgroup.WithContext(ctx)
 	eg.Go(func() error {
-		result, err := s.cardService.GetListWithComments(ctx, req.ID)
+		result, err := s.cardService.GetListWithComments(ctx, req.ID, cardFilter)
 		cards = result
 		return err
 	})
@@ -114,23 +159,29 @@ func (s *BoardService) GetByUUID(ctx context.Context, req *Board) (*BoardWithDet
 		return err
 	})
 	eg.Go(func() error {
-		result, err := s.repo.GetColumnList(ctx, req.ID)
+		result, err := s.repo.GetColumnList(ctx, req.ID, archived)
 		rawColumns = result
 		return err
 	})
 	if err := eg.Wait(); err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	if rawBoard.ArchivedAt != nil && !archived {
+		return nil, fmt.Errorf("%s: %w", op, ErrBoardNotFound)
+	}
 
 	var columns []*BoardColumn
 	for _, rawColumn := range rawColumns {
 		column := &BoardColumn{
-			ID:        rawColumn.ID,
-			Name:      rawColumn.Name,
-			Color:     rawColumn.Color,
-			Position:  rawColumn.Position,
-			BoardID:   rawColumn.BoardID,
-			CreatedAt: rawColumn.CreatedAt,
+			ID:         rawColumn.ID,
+			Name:       rawColumn.Name,
+			Color:      rawColumn.Color,
+			Category:   rawColumn.Category,
+			Position:   rawColumn.Position,
+			Rank:       rawColumn.Rank,
+			BoardID:    rawColumn.BoardID,
+			CreatedAt:  rawColumn.CreatedAt,
+			ArchivedAt: rawColumn.ArchivedAt,
 		}
 		columns = append(columns, column)
 	}
@@ -141,18 +192,66 @@ func (s *BoardService) GetByUUID(ctx context.Context, req *Board) (*BoardWithDet
 			Description: rawBoard.Description,
 			CreatedAt:   rawBoard.CreatedAt,
 			UpdatedAt:   rawBoard.UpdatedAt,
+			ArchivedAt:  rawBoard.ArchivedAt,
 		},
 		Cards:   cards,
 		Columns: columns,
+		View:    view,
 	}
 
 	return board, nil
 }
 
+// applyView дополняет фильтр карточек условиями и сортировкой сохранённого представления
+func (s *BoardService) applyView(
+	ctx context.Context, req *Board, filter *BoardDetailsFilter,
+) (*domain.CardListFilter, *viewDomain.View, error) {
+	if filter == nil {
+		return nil, nil, nil
+	}
+	if filter.ViewID == nil {
+		return filter.Cards, nil, nil
+	}
+
+	view, err := s.viewService.Get(ctx, &viewDomain.View{
+		ID:     *filter.ViewID,
+		UserID: req.UserID,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if view.BoardID != nil && *view.BoardID != req.ID {
+		return nil, nil, viewDomain.ErrViewNotFound
+	}
+	viewQuery, err := queryFilter.Parse(view.Query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cardFilter := &domain.CardListFilter{
+		Query:     &queryFilter.Query{Terms: viewQuery.Terms},
+		SortBy:    view.SortBy,
+		SortOrder: view.SortOrder,
+		Archived:  filter.Archived,
+	}
+	if filter.Cards != nil {
+		cardFilter.SprintID = filter.Cards.SprintID
+		if !filter.Cards.Query.Empty() {
+			cardFilter.Query.Terms = append(cardFilter.Query.Terms, filter.Cards.Query.Terms...)
+		}
+	}
+	return cardFilter, view, nil
+}
+
 func (s *BoardService) Create(ctx context.Context, board *Board) error {
 	const op = "board.service.Create"
+	key, err := s.resolveKey(ctx, board)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
 	data := &Board{
 		UserID:      board.UserID,
+		Key:         key,
 		Name:        board.Name,
 		Description: board.Description,
 	}
@@ -162,13 +261,96 @@ func (s *BoardService) Create(ctx context.Context, board *Board) error {
 	return nil
 }
 
+// Duplicate копирует доску пользователя в новую доску и возвращает её
+func (s *BoardService) Duplicate(ctx context.Context, cmd *BoardDuplicateCommand) (*Board, error) {
+	const op = "board.service.Duplicate"
+	source, err := s.repo.Get(ctx, &Board{
+		ID:     cmd.BoardID,
+		UserID: cmd.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	board := &Board{
+		UserID:      cmd.UserID,
+		Key:         cmd.Key,
+		Name:        cmd.Name,
+		Description: source.Description,
+	}
+	if board.Name == "" {
+		board.Name = source.Name
+	}
+	if board.Key, err = s.resolveKey(ctx, board); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if board.ID, err = s.repo.Duplicate(ctx, cmd, board); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	duplicate, err := s.repo.Get(ctx, board)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return duplicate, nil
+}
+
+// resolveKey проверяет заданный ключ доски или подбирает свободный по названию: WEB, WEB2, WEB3...
+func (s *BoardService) resolveKey(ctx context.Context, board *Board) (string, error) {
+	if board.Key != "" {
+		taken, err := s.repo.KeyExists(ctx, board.UserID, board.Key, "")
+		if err != nil {
+			return "", err
+		}
+		if taken {
+			return "", ErrBoardKeyTaken
+		}
+		return board.Key, nil
+	}
+	prefix := BoardKeyFromName(board.Name)
+	for attempt := 1; attempt <= maxBoardKeyAttempts; attempt++ {
+		key := prefix
+		if attempt > 1 {
+			key = fmt.Sprintf("%s%d", prefix, attempt)
+		}
+		taken, err := s.repo.KeyExists(ctx, board.UserID, key, "")
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return key, nil
+		}
+	}
+	return "", ErrBoardKeyTaken
+}
+
+func (s *BoardService) Get(ctx context.Context, board *Board) (*Board, error) {
+	const op = "board.service.Get"
+	data, err := s.repo.Get(ctx, board)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return data, nil
+}
+
 func (s *BoardService) Update(ctx context.Context, board *Board) error {
 	const op = "board.service.Update"
+	if board.Key != "" {
+		taken, err := s.repo.KeyExists(ctx, board.UserID, board.Key, board.ID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if taken {
+			return fmt.Errorf("%s: %w", op, ErrBoardKeyTaken)
+		}
+	}
 	data := &Board{
 		ID:          board.ID,
 		UserID:      board.UserID,
+		Key:         board.Key,
 		Name:        board.Name,
 		Description: board.Description,
+		Version:     board.Version,
 	}
 	if err := s.repo.Update(ctx, data); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
@@ -176,7 +358,8 @@ func (s *BoardService) Update(ctx context.Context, board *Board) error {
 	return nil
 }
 
-func (s *BoardService) Delete(ctx context.Context, board *Board) error {
+// Delete удаляет доску; без cascade подписчики запрещают удаление доски с карточками
+func (s *BoardService) Delete(ctx context.Context, board *Board, cascade bool) error {
 	const op = "board.service.Delete"
 	exists, err := s.repo.Exists(ctx, board.ID)
 	if err != nil {
@@ -188,10 +371,39 @@ func (s *BoardService) Delete(ctx context.Context, board *Board) error {
 	if err := s.bus.Dispatch(ctx, events.BoardDeletedEvent{
 		UserID:  board.UserID,
 		BoardID: board.ID,
+		Cascade: cascade,
 	}); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
-	if err := s.repo.Delete(ctx, board.ID); err != nil {
+	if cascade {
+		err = s.repo.DeleteCascade(ctx, board.ID)
+	} else {
+		err = s.repo.Delete(ctx, board.ID)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Archive убирает доску в архив; архивная доска скрыта из списка и открывается только с фильтром Archived
+func (s *BoardService) Archive(ctx context.Context, board *Board) error {
+	const op = "board.service.Archive"
+	if _, err := s.repo.Get(ctx, board); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.repo.Archive(ctx, board); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *BoardService) Restore(ctx context.Context, board *Board) error {
+	const op = "board.service.Restore"
+	if _, err := s.repo.Get(ctx, board); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.repo.Restore(ctx, board); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 	return nil
@@ -199,15 +411,15 @@ func (s *BoardService) Delete(ctx context.Context, board *Board) error {
 
 func (s *BoardService) CreateColumn(ctx context.Context, req *BoardColumn) error {
 	const op = "board.service.CreateColumn"
-	maxVal, err := s.repo.GetMaxPositionValue(ctx, req.BoardID)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+	category := req.Category
+	if category == "" {
+		category = ColumnCategoryTodo
 	}
 	column := &BoardColumn{
 		BoardID:  req.BoardID,
 		Name:     req.Name,
 		Color:    req.Color,
-		Position: maxVal + 1,
+		Category: category,
 	}
 	if err := s.repo.CreateColumn(ctx, column); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
@@ -215,13 +427,27 @@ func (s *BoardService) CreateColumn(ctx context.Context, req *BoardColumn) error
 	return nil
 }
 
+func (s *BoardService) GetColumn(ctx context.Context, req *BoardColumn) (*BoardColumn, error) {
+	const op = "board.service.GetColumn"
+	column, err := s.repo.GetColumnByID(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if column.BoardID != req.BoardID {
+		return nil, fmt.Errorf("%s: %w", op, ErrColumnNotFound)
+	}
+	return column, nil
+}
+
 func (s *BoardService) UpdateColumn(ctx context.Context, req *BoardColumn) error {
 	const op = "board.service.UpdateColumn"
 	column := &BoardColumn{
-		ID:      req.ID,
-		BoardID: req.BoardID,
-		Name:    req.Name,
-		Color:   req.Color,
+		ID:       req.ID,
+		BoardID:  req.BoardID,
+		Name:     req.Name,
+		Color:    req.Color,
+		Category: req.Category,
+		Version:  req.Version,
 	}
 	if err := s.repo.UpdateColumn(ctx, column); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
@@ -229,7 +455,7 @@ func (s *BoardService) UpdateColumn(ctx context.Context, req *BoardColumn) error
 	return nil
 }
 
-func (s *BoardService) DeleteColumn(ctx context.Context, req *BoardColumn) error {
+func (s *BoardService) DeleteColumn(ctx context.Context, req *BoardColumn, cascade bool) error {
 	const op = "board.service.DeleteColumn"
 	column := &BoardColumn{
 		ID:      req.ID,
@@ -237,6 +463,7 @@ func (s *BoardService) DeleteColumn(ctx context.Context, req *BoardColumn) error
 	}
 	if err := s.bus.Dispatch(ctx, events.ColumnDeletedEvent{
 		ColumnID: req.ID,
+		Cascade:  cascade,
 	}); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -244,7 +471,35 @@ func (s *BoardService) DeleteColumn(ctx context.Context, req *BoardColumn) error
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
-	if err := s.repo.DeleteColumn(ctx, column); err != nil {
+	if cascade {
+		err = s.repo.DeleteColumnCascade(ctx, column)
+	} else {
+		err = s.repo.DeleteColumn(ctx, column)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// ArchiveColumn убирает колонку в архив вместе с её карточками, не меняя их состояния
+func (s *BoardService) ArchiveColumn(ctx context.Context, req *BoardColumn) error {
+	const op = "board.service.ArchiveColumn"
+	if _, err := s.GetColumn(ctx, req); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.repo.ArchiveColumn(ctx, req); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *BoardService) RestoreColumn(ctx context.Context, req *BoardColumn) error {
+	const op = "board.service.RestoreColumn"
+	if _, err := s.GetColumn(ctx, req); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.repo.RestoreColumn(ctx, req); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 	return nil
@@ -269,14 +524,169 @@ func (s *BoardService) MoveColumn(ctx context.Context, req *BoardMoveCommand) er
 	if req.FromPosition > maxValue || req.ToPosition > maxValue+1 {
 		return fmt.Errorf("%s: %w", op, ErrInvalidPosition)
 	}
-	if err := s.repo.MoveColumn(
-		ctx,
-		req.BoardID,
-		req.ColumnID,
-		req.FromPosition,
-		req.ToPosition,
-	); err != nil {
+	if err := s.repo.MoveColumn(ctx, req.BoardID, req.ColumnID, req.ToPosition); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// ReorderColumns переставляет все колонки доски в порядке cmd.ColumnIDs.
+// Список сверяется с живыми колонками под блокировкой доски, поэтому устаревший порядок клиента отклоняется.
+func (s *BoardService) ReorderColumns(ctx context.Context, cmd *BoardColumnOrderCommand) error {
+	const op = "board.service.ReorderColumns"
+	exists, err := s.repo.Exists(ctx, cmd.BoardID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !exists {
+		return fmt.Errorf("%s: %w", op, ErrBoardNotFound)
+	}
+	if err := s.repo.ReorderColumns(ctx, cmd.BoardID, cmd.ColumnIDs); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// RebalanceRanks перестраивает ключи колонок досок, где ключи стали слишком длинными или совпали
+func (s *BoardService) RebalanceRanks(ctx context.Context) error {
+	const op = "board.service.RebalanceRanks"
+	boardIDs, err := s.repo.GetBoardsToRebalance(ctx, rank.MaxLength)
+	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
+	for _, boardID := range boardIDs {
+		if err := s.repo.RebalanceColumns(ctx, boardID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
 	return nil
 }
+
+// GetTemplateList возвращает встроенные шаблоны на языке запроса и личные шаблоны пользователя
+func (s *BoardService) GetTemplateList(ctx context.Context, userID uint64) ([]*BoardTemplate, error) {
+	const op = "board.service.GetTemplateList"
+	own, err := s.repo.GetTemplateList(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	templates := make([]*BoardTemplate, 0, len(builtinTemplates)+len(own))
+	for _, template := range builtinTemplates {
+		templates = append(templates, s.localizeTemplate(ctx, template))
+	}
+	return append(templates, own...), nil
+}
+
+func (s *BoardService) GetTemplate(ctx context.Context, id string, userID uint64) (*BoardTemplate, error) {
+	const op = "board.service.GetTemplate"
+	for _, template := range builtinTemplates {
+		if template.ID == id {
+			return s.localizeTemplate(ctx, template), nil
+		}
+	}
+	template, err := s.repo.GetTemplate(ctx, id, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return template, nil
+}
+
+// PublishTemplate сохраняет колонки доски и, по желанию, её карточки как личный шаблон пользователя
+func (s *BoardService) PublishTemplate(ctx context.Context, cmd *BoardTemplatePublishCommand) (*BoardTemplate, error) {
+	const op = "board.service.PublishTemplate"
+	if _, err := s.repo.Get(ctx, &Board{
+		ID:     cmd.BoardID,
+		UserID: cmd.UserID,
+	}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	id, err := s.repo.CreateTemplate(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	template, err := s.repo.GetTemplate(ctx, id, cmd.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return template, nil
+}
+
+// InstantiateTemplate создаёт из шаблона новую доску пользователя и возвращает её
+func (s *BoardService) InstantiateTemplate(ctx context.Context, cmd *BoardTemplateInstantiateCommand) (*Board, error) {
+	const op = "board.service.InstantiateTemplate"
+	template, err := s.GetTemplate(ctx, cmd.TemplateID, cmd.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	board := &Board{
+		UserID:      cmd.UserID,
+		Key:         cmd.Key,
+		Name:        cmd.Name,
+		Description: template.Description,
+	}
+	if board.Name == "" {
+		board.Name = template.Name
+	}
+	if board.Key, err = s.resolveKey(ctx, board); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	content := template.Content
+	if !cmd.WithCards {
+		content = content.WithoutCards()
+	}
+
+	id, err := s.repo.CreateFromTemplate(ctx, board, content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	created, err := s.repo.Get(ctx, &Board{
+		ID:     id,
+		UserID: cmd.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return created, nil
+}
+
+func (s *BoardService) DeleteTemplate(ctx context.Context, id string, userID uint64) error {
+	const op = "board.service.DeleteTemplate"
+	for _, template := range builtinTemplates {
+		if template.ID == id {
+			return fmt.Errorf("%s: %w", op, ErrBuiltinTemplate)
+		}
+	}
+	if err := s.repo.DeleteTemplate(ctx, id, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// localizeTemplate возвращает копию встроенного шаблона с переведёнными текстами
+func (s *BoardService) localizeTemplate(ctx context.Context, template *BoardTemplate) *BoardTemplate {
+	translate := func(key string) string {
+		if key == "" {
+			return ""
+		}
+		return s.translator.GetTemplateText(ctx, key)
+	}
+	columns := make([]TemplateColumn, len(template.Content.Columns))
+	for i, column := range template.Content.Columns {
+		cards := make([]TemplateCard, len(column.Cards))
+		for j, card := range column.Cards {
+			card.Text = translate(card.Text)
+			card.Description = translate(card.Description)
+			cards[j] = card
+		}
+		column.Name = translate(column.Name)
+		column.Cards = cards
+		columns[i] = column
+	}
+	return &BoardTemplate{
+		ID:          template.ID,
+		Name:        translate(template.Name),
+		Description: translate(template.Description),
+		Builtin:     true,
+		Content:     BoardTemplateContent{Columns: columns},
+	}
+}
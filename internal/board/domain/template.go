@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// BoardTemplate — заготовка доски: колонки с цветами и, по желанию, карточки-примеры.
+// Встроенные шаблоны живут в коде: ID у них — слаг, а названия — ключи переводов.
+type BoardTemplate struct {
+	ID          string
+	UserID      uint64
+	Name        string
+	Description string
+	Builtin     bool
+	Content     BoardTemplateContent
+	CreatedAt   time.Time
+}
+
+type BoardTemplateContent struct {
+	Columns []TemplateColumn `json:"columns"`
+}
+
+type TemplateColumn struct {
+	Name     string         `json:"name"`
+	Color    string         `json:"color,omitempty"`
+	Category string         `json:"category"`
+	Cards    []TemplateCard `json:"cards,omitempty"`
+}
+
+type TemplateCard struct {
+	Text        string `json:"text"`
+	Description string `json:"description,omitempty"`
+	Color       string `json:"color,omitempty"`
+	Tag         string `json:"tag,omitempty"`
+}
+
+// BoardTemplatePublishCommand сохраняет доску пользователя как его личный шаблон
+type BoardTemplatePublishCommand struct {
+	UserID      uint64
+	BoardID     string
+	Name        string
+	Description string
+	WithCards   bool
+}
+
+// BoardTemplateInstantiateCommand создаёт из шаблона новую доску пользователя
+type BoardTemplateInstantiateCommand struct {
+	UserID     uint64
+	TemplateID string
+	Name       string
+	Key        string
+	WithCards  bool
+}
+
+// CardCount возвращает число карточек-примеров во всех колонках
+func (c BoardTemplateContent) CardCount() int {
+	count := 0
+	for _, column := range c.Columns {
+		count += len(column.Cards)
+	}
+	return count
+}
+
+// WithoutCards возвращает копию содержимого без карточек-примеров
+func (c BoardTemplateContent) WithoutCards() BoardTemplateContent {
+	columns := make([]TemplateColumn, len(c.Columns))
+	for i, column := range c.Columns {
+		column.Cards = nil
+		columns[i] = column
+	}
+	return BoardTemplateContent{Columns: columns}
+}
+
+func (c *BoardTemplateContent) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("BoardTemplateContent.Scan: expected []byte, got %T", value)
+	}
+
+	if len(bytes) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(bytes, c)
+}
+
+func (c BoardTemplateContent) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+// builtinTemplates — шаблоны, доступные всем пользователям.
+// Name, Description, названия колонок и тексты карточек — ключи для Translator.
+var builtinTemplates = []*BoardTemplate{
+	{
+		ID:          "scrum",
+		Name:        "template.scrum.name",
+		Description: "template.scrum.description",
+		Content: BoardTemplateContent{Columns: []TemplateColumn{
+			{Name: "template.scrum.backlog", Color: "#9E9E9E", Category: ColumnCategoryTodo},
+			{Name: "template.scrum.todo", Color: "#2196F3", Category: ColumnCategoryTodo},
+			{Name: "template.scrum.in_progress", Color: "#FF9800", Category: ColumnCategoryInProgress},
+			{Name: "template.scrum.review", Color: "#9C27B0", Category: ColumnCategoryInProgress},
+			{Name: "template.scrum.done", Color: "#4CAF50", Category: ColumnCategoryDone},
+		}},
+	},
+	{
+		ID:          "kanban",
+		Name:        "template.kanban.name",
+		Description: "template.kanban.description",
+		Content: BoardTemplateContent{Columns: []TemplateColumn{
+			{
+				Name:     "template.kanban.todo",
+				Color:    "#2196F3",
+				Category: ColumnCategoryTodo,
+				Cards: []TemplateCard{
+					{Text: "template.kanban.sample_card", Description: "template.kanban.sample_card_description"},
+				},
+			},
+			{Name: "template.kanban.in_progress", Color: "#FF9800", Category: ColumnCategoryInProgress},
+			{Name: "template.kanban.done", Color: "#4CAF50", Category: ColumnCategoryDone},
+		}},
+	},
+	{
+		ID:          "bug-triage",
+		Name:        "template.bug_triage.name",
+		Description: "template.bug_triage.description",
+		Content: BoardTemplateContent{Columns: []TemplateColumn{
+			{
+				Name:     "template.bug_triage.new",
+				Color:    "#F44336",
+				Category: ColumnCategoryTodo,
+				Cards: []TemplateCard{
+					{
+						Text:        "template.bug_triage.sample_card",
+						Description: "template.bug_triage.sample_card_description",
+						Color:       "#F44336",
+						Tag:         "bug",
+					},
+				},
+			},
+			{Name: "template.bug_triage.confirmed", Color: "#FF9800", Category: ColumnCategoryTodo},
+			{Name: "template.bug_triage.in_progress", Color: "#2196F3", Category: ColumnCategoryInProgress},
+			{Name: "template.bug_triage.fixed", Color: "#4CAF50", Category: ColumnCategoryDone},
+			{Name: "template.bug_triage.wont_fix", Color: "#9E9E9E", Category: ColumnCategoryDone},
+		}},
+	},
+}
@@ -2,17 +2,107 @@ package repository
 
 import (
 	"backend/internal/board/domain"
+	"backend/internal/shared/filter"
 	"backend/internal/shared/utils"
 	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
+)
+
+var (
+	boardFilterFields = filter.Fields{
+		"name":        {Kind: filter.KindText},
+		"description": {Kind: filter.KindText},
+	}
+	boardFilterMapping = filter.Mapping{
+		Columns: map[string]string{
+			"name":        "name",
+			"description": "description",
+		},
+	}
 )
 
 const (
-	existsBoardQuery  = "SELECT EXISTS(SELECT 1 FROM boards WHERE id = $1 AND deleted_at IS NULL)"
-	existsColumnQuery = "SELECT EXISTS(SELECT 1 FROM board_columns WHERE board_id = $1 AND id = $2 AND deleted_at IS NULL)"
+	existsBoardQuery = "SELECT EXISTS(SELECT 1 FROM boards WHERE id = $1 AND deleted_at IS NULL)"
+	// existsColumnQuery — архивная колонка считается отсутствующей: в неё нельзя переносить карточки
+	existsColumnQuery = "SELECT EXISTS(SELECT 1 FROM board_columns WHERE board_id = $1 AND id = $2 AND deleted_at IS NULL AND archived_at IS NULL)"
+	// cascade-запросы удаляют потомков с тем же deleted_at, что и у родителя: NOW() постоянен в транзакции,
+	// по совпадению отметок корзина восстанавливает поддерево целиком
+	cascadeDeleteBoardColumnsQuery = "UPDATE board_columns SET deleted_at = NOW(), position = NULL WHERE board_id = $1 AND deleted_at IS NULL"
+	cascadeDeleteBoardCardsQuery   = "UPDATE cards SET deleted_at = NOW(), position = NULL WHERE board_id = $1 AND deleted_at IS NULL"
+	// cascadeDeleteColumnCardsQuery удаляет карточки колонки вместе со всеми их подзадачами,
+	// даже если те лежат в других колонках: иначе живые подзадачи ссылались бы на удалённого родителя
+	cascadeDeleteColumnCardsQuery = `
+		WITH RECURSIVE subtree(id) AS (
+			SELECT id FROM cards WHERE column_id = $1 AND deleted_at IS NULL
+			UNION
+			SELECT c.id FROM cards c JOIN subtree ON c.parent_card_id = subtree.id WHERE c.deleted_at IS NULL
+		)
+		UPDATE cards SET deleted_at = NOW(), position = NULL WHERE id IN (SELECT id FROM subtree)
+	`
+	cascadeDeleteCommentsQuery = `
+		UPDATE comments SET deleted_at = NOW()
+		WHERE deleted_at IS NULL
+			AND card_id IN (SELECT id FROM cards WHERE board_id = $1 AND deleted_at = NOW())
+	`
+	// insertDuplicateBoardQuery — счётчик номеров карточек переносится вместе с карточками
+	insertDuplicateBoardQuery = `
+		INSERT INTO boards (name, description, user_id, key, card_seq)
+		SELECT $1, $2, $3, $4, CASE WHEN $5 THEN card_seq ELSE 0 END FROM boards WHERE id = $6
+		RETURNING id
+	`
+	createDuplicateColumnMapQuery = `
+		CREATE TEMP TABLE duplicate_column_map (old_id INTEGER PRIMARY KEY, new_id INTEGER NOT NULL) ON COMMIT DROP
+	`
+	fillDuplicateColumnMapQuery = `
+		INSERT INTO duplicate_column_map (old_id, new_id)
+		SELECT id, nextval(pg_get_serial_sequence('board_columns', 'id'))
+		FROM board_columns WHERE board_id = $1 AND deleted_at IS NULL AND archived_at IS NULL
+	`
+	copyDuplicateColumnsQuery = `
+		INSERT INTO board_columns (id, board_id, name, color, category, rank)
+		SELECT m.new_id, $1, c.name, c.color, c.category, c.rank
+		FROM duplicate_column_map m
+		JOIN board_columns c ON c.id = m.old_id
+	`
+	createDuplicateCardMapQuery = `
+		CREATE TEMP TABLE duplicate_card_map (old_id INTEGER PRIMARY KEY, new_id INTEGER NOT NULL) ON COMMIT DROP
+	`
+	fillDuplicateCardMapQuery = `
+		INSERT INTO duplicate_card_map (old_id, new_id)
+		SELECT cards.id, nextval(pg_get_serial_sequence('cards', 'id'))
+		FROM cards
+		JOIN duplicate_column_map ON duplicate_column_map.old_id = cards.column_id
+		WHERE cards.board_id = $1 AND cards.deleted_at IS NULL AND cards.archived_at IS NULL
+	`
+	// copyDuplicateCardsQuery — автором копий становится владелец новой доски $2
+	copyDuplicateCardsQuery = `
+		INSERT INTO cards (
+			id, board_id, column_id, text, description, rank, properties, created_by, due_date, number, parent_card_id
+		)
+		SELECT m.new_id, $1, cm.new_id, c.text, c.description, c.rank, c.properties, $2, c.due_date, c.number, pm.new_id
+		FROM duplicate_card_map m
+		JOIN cards c ON c.id = m.old_id
+		JOIN duplicate_column_map cm ON cm.old_id = c.column_id
+		LEFT JOIN duplicate_card_map pm ON pm.old_id = c.parent_card_id
+	`
+	copyDuplicateCommentsQuery = `
+		INSERT INTO comments (card_id, user_id, text, created_at, updated_at)
+		SELECT m.new_id, c.user_id, c.text, c.created_at, c.updated_at
+		FROM comments c
+		JOIN duplicate_card_map m ON m.old_id = c.card_id
+		WHERE c.deleted_at IS NULL
+		ORDER BY c.id
+	`
+	existsKeyQuery = `
+		SELECT EXISTS(
+			SELECT 1 FROM boards
+			WHERE user_id = $1 AND key = $2 AND deleted_at IS NULL AND ($3 = '' OR id::TEXT <> $3)
+		)
+	`
 )
 
 type Storage interface {
@@ -41,16 +131,22 @@ func NewBoardRepository(storage Storage) *BoardRepository {
 
 func (r *BoardRepository) Get(ctx context.Context, info *domain.Board) (*domain.Board, error) {
 	const op = "board.repository.Get"
-	query := "SELECT id, name, description, created_at, updated_at FROM boards WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL"
+	query := `
+		SELECT id, key, name, description, version, created_at, updated_at, archived_at
+		FROM boards WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+	`
 	board := &domain.Board{}
 
 	row := r.storage.QueryRowContext(ctx, query, info.ID, info.UserID)
 	err := row.Scan(
 		&board.ID,
+		&board.Key,
 		&board.Name,
 		&board.Description,
+		&board.Version,
 		&board.CreatedAt,
 		&board.UpdatedAt,
+		&board.ArchivedAt,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -69,7 +165,10 @@ func (r *BoardRepository) GetList(
 	boards := []*domain.Board{}
 	limit := filter.PerPage
 	offset := (filter.Page - 1) * filter.PerPage
-	query, params := buildQuery(filter)
+	query, params, err := buildQuery(filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 	params = append(params, limit, offset)
 	rows, err := r.storage.QueryContext(
 		ctx,
@@ -84,23 +183,28 @@ func (r *BoardRepository) GetList(
 		board := &domain.Board{}
 		if err := rows.Scan(
 			&board.ID,
+			&board.Key,
 			&board.Name,
 			&board.Description,
+			&board.Version,
 			&board.CreatedAt,
 			&board.UpdatedAt,
+			&board.ArchivedAt,
 		); err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 		boards = append(boards, board)
 	}
 	var count uint64
-	countQuery := "SELECT COUNT(*) FROM boards WHERE user_id = $1 AND deleted_at IS NULL"
-	where := []string{}
-	params = []any{filter.UserID}
-	where, params, _ = addFilterToQuery(where, params, filter.FilterFields)
-	if len(where) > 0 {
-		countQuery += " AND " + strings.Join(where, " AND ")
+	countQuery := "SELECT COUNT(*) FROM boards WHERE user_id = $1 AND deleted_at IS NULL" + boardArchivedCondition(filter)
+	conditions, err := compileBoardFilters(filter.FilterFields)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	if len(conditions.Where) > 0 {
+		countQuery += " AND " + strings.Join(conditions.Where, " AND ")
+	}
+	params = append([]any{filter.UserID}, conditions.Params...)
 	row := r.storage.QueryRowContext(ctx, countQuery, params...)
 	err = row.Scan(&count)
 	if err != nil {
@@ -113,47 +217,51 @@ func (r *BoardRepository) GetList(
 	return result, nil
 }
 
-func buildQuery(filter *domain.BoardGetFilter) (string, []any) {
-	var where []string
-	params := []any{filter.UserID}
+func buildQuery(filter *domain.BoardGetFilter) (string, []any, error) {
 	baseQuery := `
-        SELECT id, name, description, created_at, updated_at
+        SELECT id, key, name, description, version, created_at, updated_at, archived_at
         FROM boards
         WHERE user_id = $1 AND deleted_at IS NULL
-    `
-	where, params, paramIndex := addFilterToQuery(where, params, filter.FilterFields)
-	if len(where) > 0 {
-		baseQuery += " AND " + strings.Join(where, " AND ")
+    ` + boardArchivedCondition(filter)
+	conditions, err := compileBoardFilters(filter.FilterFields)
+	if err != nil {
+		return "", nil, err
+	}
+	params := append([]any{filter.UserID}, conditions.Params...)
+	if len(conditions.Where) > 0 {
+		baseQuery += " AND " + strings.Join(conditions.Where, " AND ")
 	}
+	paramIndex := len(params) + 1
 	baseQuery += fmt.Sprintf(" ORDER BY created_at DESC LIMIT $%d OFFSET $%d", paramIndex, paramIndex+1)
-	return baseQuery, params
-}
-
-func addFilterToQuery(
-	where []string,
-	params []any,
-	filter *domain.Filters,
-) ([]string, []any, int) {
-	paramIndex := 2
-	if filter != nil {
-		if filter.Name != nil {
-			where = append(where, fmt.Sprintf("name ILIKE $%d", paramIndex))
-			params = append(params, "%"+*filter.Name+"%")
-			paramIndex++
+	return baseQuery, params, nil
+}
+
+// boardArchivedCondition скрывает архивные доски, если фильтр не просит их показать
+func boardArchivedCondition(filter *domain.BoardGetFilter) string {
+	if filter.Archived {
+		return ""
+	}
+	return " AND archived_at IS NULL"
+}
+
+// compileBoardFilters собирает условия списка досок; $1 занят user_id
+func compileBoardFilters(filters *domain.Filters) (*filter.Compiled, error) {
+	query := &filter.Query{}
+	if filters != nil {
+		if filters.Name != nil && *filters.Name != "" {
+			query.Terms = append(query.Terms, &filter.Term{Field: "name", Op: filter.OpMatch, Value: *filters.Name})
 		}
-		if filter.Description != nil {
-			where = append(where, fmt.Sprintf("description ILIKE $%d", paramIndex))
-			params = append(params, "%"+*filter.Description+"%")
-			paramIndex++
+		if filters.Description != nil && *filters.Description != "" {
+			query.Terms = append(query.Terms, &filter.Term{Field: "description", Op: filter.OpMatch, Value: *filters.Description})
 		}
 	}
-	return where, params, paramIndex
+	return filter.Compile(query, boardFilterFields, boardFilterMapping, 1, time.Now())
 }
 
 func (r *BoardRepository) Create(ctx context.Context, board *domain.Board) error {
 	const op = "board.repository.Create"
-	query := "INSERT INTO boards (name, description, user_id) VALUES ($1, $2, $3)"
-	return utils.OpExec(
+	query := "INSERT INTO boards (name, description, user_id, key) VALUES ($1, $2, $3, $4)"
+	err := utils.OpExec(
 		ctx,
 		r.storage.ExecContext,
 		op,
@@ -162,22 +270,106 @@ func (r *BoardRepository) Create(ctx context.Context, board *domain.Board) error
 		board.Name,
 		board.Description,
 		board.UserID,
+		board.Key,
 	)
+	if utils.IsUniqueViolation(err) {
+		return fmt.Errorf("%s: %w", op, domain.ErrBoardKeyTaken)
+	}
+	return err
+}
+
+// Duplicate копирует доску cmd.BoardID в новую доску board одной транзакцией набором INSERT ... SELECT.
+// Новые id выдаются заранее через nextval и запоминаются во временных таблицах соответствия,
+// чтобы перенести ссылки карточек на колонки, родительские карточки и комментарии.
+// Ключи rank и номера карточек копируются как есть, поэтому позиции и ключи карточек совпадают с исходными.
+func (r *BoardRepository) Duplicate(ctx context.Context, cmd *domain.BoardDuplicateCommand, board *domain.Board) (string, error) {
+	const op = "board.repository.Duplicate"
+	withCards := cmd.Depth == domain.DuplicateCards
+	var boardID string
+	err := utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		if err := tx.QueryRowContext(
+			ctx,
+			insertDuplicateBoardQuery,
+			board.Name,
+			board.Description,
+			board.UserID,
+			board.Key,
+			withCards,
+			cmd.BoardID,
+		).Scan(&boardID); err != nil {
+			return err
+		}
+
+		type statement struct {
+			query string
+			args  []any
+		}
+		statements := []statement{
+			{query: createDuplicateColumnMapQuery},
+			{query: fillDuplicateColumnMapQuery, args: []any{cmd.BoardID}},
+			{query: copyDuplicateColumnsQuery, args: []any{boardID}},
+		}
+		if withCards {
+			statements = append(statements,
+				statement{query: createDuplicateCardMapQuery},
+				statement{query: fillDuplicateCardMapQuery, args: []any{cmd.BoardID}},
+				statement{query: copyDuplicateCardsQuery, args: []any{boardID, board.UserID}},
+			)
+			if cmd.WithComments {
+				statements = append(statements, statement{query: copyDuplicateCommentsQuery})
+			}
+		}
+		for _, statement := range statements {
+			if _, err := tx.ExecContext(ctx, statement.query, statement.args...); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if utils.IsUniqueViolation(err) {
+		return "", fmt.Errorf("%s: %w", op, domain.ErrBoardKeyTaken)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	return boardID, nil
 }
 
 func (r *BoardRepository) Update(ctx context.Context, board *domain.Board) error {
 	const op = "board.repository.Update"
-	query := "UPDATE boards SET name = $1, description = $2, updated_at = NOW() WHERE id = $3 AND deleted_at IS NULL"
-	return utils.OpExec(
+	query := `
+		UPDATE boards
+		SET name = $1, description = $2, key = COALESCE(NULLIF($4, ''), key), version = version + 1, updated_at = NOW()
+		WHERE id = $3 AND deleted_at IS NULL AND ($5::BIGINT = 0 OR version = $5)
+	`
+	err := utils.OpExecVersion(
 		ctx,
-		r.storage.ExecContext,
+		r.storage,
 		op,
 		query,
 		domain.ErrBoardNotFound,
+		board.Version,
+		existsBoardQuery,
+		[]any{board.ID},
 		board.Name,
 		board.Description,
 		board.ID,
+		board.Key,
+		board.Version,
 	)
+	if utils.IsUniqueViolation(err) {
+		return fmt.Errorf("%s: %w", op, domain.ErrBoardKeyTaken)
+	}
+	return err
+}
+
+func (r *BoardRepository) KeyExists(ctx context.Context, userID uint64, key, excludeBoardID string) (bool, error) {
+	const op = "board.repository.KeyExists"
+	exists, err := utils.ExistsQueryWrapper(ctx, r.storage, existsKeyQuery, userID, key, excludeBoardID)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return exists, nil
 }
 
 func (r *BoardRepository) Delete(ctx context.Context, uuid string) error {
@@ -190,6 +382,45 @@ func (r *BoardRepository) Delete(ctx context.Context, uuid string) error {
 	return nil
 }
 
+// DeleteCascade удаляет доску вместе с колонками, карточками и их комментариями в одной транзакции
+func (r *BoardRepository) DeleteCascade(ctx context.Context, uuid string) error {
+	const op = "board.repository.DeleteCascade"
+	query := "UPDATE boards SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL"
+	return utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		if err := utils.OpExec(ctx, tx.ExecContext, op, query, domain.ErrBoardNotFound, uuid); err != nil {
+			return err
+		}
+		for _, cascade := range []string{
+			cascadeDeleteBoardColumnsQuery,
+			cascadeDeleteBoardCardsQuery,
+			cascadeDeleteCommentsQuery,
+		} {
+			if _, err := tx.ExecContext(ctx, cascade, uuid); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *BoardRepository) Archive(ctx context.Context, board *domain.Board) error {
+	const op = "board.repository.Archive"
+	query := `
+		UPDATE boards SET archived_at = NOW(), version = version + 1, updated_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL AND archived_at IS NULL
+	`
+	return utils.OpExec(ctx, r.storage.ExecContext, op, query, domain.ErrBoardArchived, board.ID, board.UserID)
+}
+
+func (r *BoardRepository) Restore(ctx context.Context, board *domain.Board) error {
+	const op = "board.repository.Restore"
+	query := `
+		UPDATE boards SET archived_at = NULL, version = version + 1, updated_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL AND archived_at IS NOT NULL
+	`
+	return utils.OpExec(ctx, r.storage.ExecContext, op, query, domain.ErrBoardNotArchived, board.ID, board.UserID)
+}
+
 func (r *BoardRepository) Exists(ctx context.Context, uuid string) (bool, error) {
 	const op = "board.repository.Exists"
 	var exists bool
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"backend/internal/board/domain"
+	"backend/internal/shared/testdb"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Duplicate(t *testing.T) {
+	storage := testdb.Open(t)
+	repo := NewBoardRepository(storage)
+	sourceID := createTestBoard(t, storage)
+	ctx := context.Background()
+
+	for i := range 2 {
+		require.NoError(t, repo.CreateColumn(ctx, &domain.BoardColumn{
+			BoardID:  sourceID,
+			Name:     fmt.Sprintf("column %d", i),
+			Category: domain.ColumnCategoryTodo,
+		}))
+	}
+	columns := assertUniqueRanks(t, repo, sourceID, 2)
+
+	var userID, parentID uint64
+	require.NoError(t, storage.QueryRow("SELECT user_id FROM boards WHERE id = $1", sourceID).Scan(&userID))
+	require.NoError(t, storage.QueryRow(
+		"INSERT INTO cards (board_id, column_id, text, rank, number) VALUES ($1, $2, 'parent', 'a', 1) RETURNING id",
+		sourceID, columns[0].ID,
+	).Scan(&parentID))
+	_, err := storage.Exec(
+		"INSERT INTO cards (board_id, column_id, text, rank, number, parent_card_id) VALUES ($1, $2, 'child', 'b', 2, $3)",
+		sourceID, columns[1].ID, parentID,
+	)
+	require.NoError(t, err)
+	_, err = storage.Exec("INSERT INTO comments (card_id, user_id, text) VALUES ($1, $2, 'comment')", parentID, userID)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		storage.Exec("DELETE FROM comments WHERE card_id IN (SELECT id FROM cards WHERE board_id = $1)", sourceID)
+		storage.Exec("DELETE FROM cards WHERE board_id = $1", sourceID)
+	})
+
+	tests := []struct {
+		name         string
+		depth        string
+		withComments bool
+		cards        int
+		comments     int
+	}{
+		{name: "columns only", depth: domain.DuplicateColumns},
+		{name: "cards without comments", depth: domain.DuplicateCards, cards: 2},
+		{name: "cards with comments", depth: domain.DuplicateCards, withComments: true, cards: 2, comments: 1},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boardID, err := repo.Duplicate(ctx, &domain.BoardDuplicateCommand{
+				UserID:       userID,
+				BoardID:      sourceID,
+				Depth:        tt.depth,
+				WithComments: tt.withComments,
+			}, &domain.Board{Name: "copy", UserID: userID, Key: fmt.Sprintf("CPY%d", i)})
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				storage.Exec("DELETE FROM comments WHERE card_id IN (SELECT id FROM cards WHERE board_id = $1)", boardID)
+				storage.Exec("DELETE FROM cards WHERE board_id = $1", boardID)
+				storage.Exec("DELETE FROM board_columns WHERE board_id = $1", boardID)
+				storage.Exec("DELETE FROM boards WHERE id = $1", boardID)
+			})
+
+			copied := assertUniqueRanks(t, repo, boardID, len(columns))
+			for j, column := range copied {
+				assert.Equal(t, columns[j].Name, column.Name)
+				assert.NotEqual(t, columns[j].ID, column.ID)
+			}
+
+			var cards, children, comments int
+			require.NoError(t, storage.QueryRow(`
+				SELECT COUNT(*), COUNT(c.parent_card_id) FILTER (WHERE p.board_id = c.board_id)
+				FROM cards c LEFT JOIN cards p ON p.id = c.parent_card_id
+				WHERE c.board_id = $1`, boardID,
+			).Scan(&cards, &children))
+			require.NoError(t, storage.QueryRow(
+				"SELECT COUNT(*) FROM comments WHERE card_id IN (SELECT id FROM cards WHERE board_id = $1)", boardID,
+			).Scan(&comments))
+			assert.Equal(t, tt.cards, cards)
+			assert.Equal(t, tt.cards/2, children)
+			assert.Equal(t, tt.comments, comments)
+		})
+	}
+}
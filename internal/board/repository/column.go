@@ -2,18 +2,49 @@ package repository
 
 import (
 	"backend/internal/board/domain"
+	"backend/internal/shared/rank"
 	"backend/internal/shared/utils"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 )
 
+const (
+	// selectColumnNeighborRanksQuery — ключи колонок доски $1, между которыми встанет колонка на позиции $3;
+	// сама перемещаемая колонка $2 не учитывается, позиция за концом доски означает конец
+	selectColumnNeighborRanksQuery = `
+		WITH siblings AS (
+			SELECT rank, ROW_NUMBER() OVER (ORDER BY rank, id) AS n
+			FROM board_columns
+			WHERE board_id = $1 AND id <> $2 AND deleted_at IS NULL AND archived_at IS NULL
+		)
+		SELECT
+			COALESCE((SELECT rank FROM siblings WHERE n = LEAST($3 - 1, (SELECT COUNT(*) FROM siblings))), ''),
+			COALESCE((SELECT rank FROM siblings WHERE n = $3), '')
+	`
+	selectLastColumnRankQuery    = "SELECT COALESCE(MAX(rank), '') FROM board_columns WHERE board_id = $1 AND deleted_at IS NULL"
+	selectBoardsToRebalanceQuery = `
+		SELECT board_id FROM board_columns
+		WHERE deleted_at IS NULL
+		GROUP BY board_id
+		HAVING MAX(LENGTH(rank)) > $1 OR COUNT(DISTINCT rank) < COUNT(*)
+	`
+)
+
 func (r *BoardRepository) GetColumnByID(ctx context.Context, column *domain.BoardColumn) (*domain.BoardColumn, error) {
 	const op = "board.repository.GetColumnByID"
 	query := `
-		SELECT id, board_id, position, name, color, created_at
+		SELECT
+			id, board_id,
+			(
+				SELECT COUNT(*) FROM board_columns sibling
+				WHERE sibling.board_id = bc.board_id AND sibling.deleted_at IS NULL
+					AND (sibling.archived_at IS NULL) = (bc.archived_at IS NULL)
+					AND (sibling.rank, sibling.id) <= (bc.rank, bc.id)
+			),
+			rank, name, color, category, version, created_at, archived_at
 		FROM board_columns bc WHERE id = $1 AND deleted_at IS NULL
-		ORDER BY id
 	`
 	data := &domain.BoardColumn{}
 	row := r.storage.QueryRowContext(ctx, query, column.ID)
@@ -21,25 +52,36 @@ func (r *BoardRepository) GetColumnByID(ctx context.Context, column *domain.Boar
 		&data.ID,
 		&data.BoardID,
 		&data.Position,
+		&data.Rank,
 		&data.Name,
 		&data.Color,
+		&data.Category,
+		&data.Version,
 		&data.CreatedAt,
+		&data.ArchivedAt,
 	)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, domain.ErrColumnNotFound)
+		}
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 	return data, nil
 }
 
-func (r *BoardRepository) GetColumnList(ctx context.Context, uuid string) ([]*domain.BoardColumn, error) {
+// GetColumnList возвращает колонки доски по порядку; архивные добавляются только при archived,
+// их позиции считаются отдельно от живых колонок
+func (r *BoardRepository) GetColumnList(ctx context.Context, uuid string, archived bool) ([]*domain.BoardColumn, error) {
 	const op = "board.repository.GetColumnList"
 	columnsRaw := []*domain.BoardColumn{}
 	query := `
-		SELECT id, board_id, position, name, color, created_at
-		FROM board_columns bc WHERE board_id = $1 AND deleted_at IS NULL
-		ORDER BY id
+		SELECT
+			id, board_id, ROW_NUMBER() OVER (PARTITION BY archived_at IS NULL ORDER BY rank, id),
+			rank, name, color, category, version, created_at, archived_at
+		FROM board_columns bc WHERE board_id = $1 AND deleted_at IS NULL AND ($2 OR archived_at IS NULL)
+		ORDER BY rank, id
 	`
-	rows, err := r.storage.QueryContext(ctx, query, uuid)
+	rows, err := r.storage.QueryContext(ctx, query, uuid, archived)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -50,9 +92,13 @@ func (r *BoardRepository) GetColumnList(ctx context.Context, uuid string) ([]*do
 			&column.ID,
 			&column.BoardID,
 			&column.Position,
+			&column.Rank,
 			&column.Name,
 			&column.Color,
+			&column.Category,
+			&column.Version,
 			&column.CreatedAt,
+			&column.ArchivedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
@@ -65,20 +111,35 @@ func (r *BoardRepository) GetColumnList(ctx context.Context, uuid string) ([]*do
 func (r *BoardRepository) CreateColumn(ctx context.Context, column *domain.BoardColumn) error {
 	const op = "board.repository.CreateColumn"
 	query := `
-		INSERT INTO board_columns (board_id, name, color, position)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO board_columns (board_id, name, color, category, rank)
+		VALUES ($1, $2, $3, $4, $5)
 	`
-	return utils.OpExec(
-		ctx,
-		r.storage.ExecContext,
-		op,
-		query,
-		domain.ErrBoardNotFound,
-		column.BoardID,
-		column.Name,
-		column.Color,
-		column.Position,
-	)
+	// колонка встаёт в конец доски; блокировка доски не даёт двум вставкам получить один ключ
+	return utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		if err := lockBoardColumns(ctx, tx, column.BoardID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		var last string
+		if err := tx.QueryRowContext(ctx, selectLastColumnRankQuery, column.BoardID).Scan(&last); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		key, err := rank.Between(last, "")
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return utils.OpExec(
+			ctx,
+			tx.ExecContext,
+			op,
+			query,
+			domain.ErrBoardNotFound,
+			column.BoardID,
+			column.Name,
+			column.Color,
+			column.Category,
+			key,
+		)
+	})
 }
 
 func (r *BoardRepository) UpdateColumn(ctx context.Context, column *domain.BoardColumn) error {
@@ -86,46 +147,107 @@ func (r *BoardRepository) UpdateColumn(ctx context.Context, column *domain.Board
 	query := `
 		UPDATE board_columns
 		SET
-			name = $1, color = $2, updated_at = NOW()
+			name = $1, color = $2, category = COALESCE(NULLIF($3, ''), category),
+			version = version + 1, updated_at = NOW()
 		WHERE
-			board_id = $3 AND id = $4
-			and deleted_at is NULL;
+			board_id = $4 AND id = $5
+			and deleted_at is NULL
+			AND ($6::BIGINT = 0 OR version = $6);
 	`
-	return utils.OpExec(
+	return utils.OpExecVersion(
 		ctx,
-		r.storage.ExecContext,
+		r.storage,
 		op,
 		query,
 		domain.ErrColumnNotFound,
+		column.Version,
+		existsColumnQuery,
+		[]any{column.BoardID, column.ID},
 		column.Name,
 		column.Color,
+		column.Category,
 		column.BoardID,
 		column.ID,
+		column.Version,
 	)
 }
 
 func (r *BoardRepository) DeleteColumn(ctx context.Context, column *domain.BoardColumn) error {
 	const op = "board.repository.DeleteColumn"
-	tx, err := r.storage.BeginTx(ctx, &sql.TxOptions{
-		Isolation: sql.LevelRepeatableRead,
-	})
-	defer tx.Rollback()
-	query := "UPDATE board_columns SET position = position - 1 WHERE board_id = $1  AND position > $2 AND deleted_at IS NULL"
-	_, err = tx.ExecContext(ctx, query, column.BoardID, column.Position)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
-	query = `
+	query := `
 		UPDATE board_columns SET
 			position = NULL,
 			deleted_at = NOW()
 		WHERE board_id = $1 AND id = $2 AND deleted_at IS NULL
 	`
-	err = utils.OpExec(ctx, tx.ExecContext, op, query, domain.ErrColumnNotFound, column.BoardID, column.ID)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
-	return tx.Commit()
+	return utils.OpExec(ctx, r.storage.ExecContext, op, query, domain.ErrColumnNotFound, column.BoardID, column.ID)
+}
+
+// DeleteColumnCascade удаляет колонку вместе с её карточками и их комментариями в одной транзакции
+func (r *BoardRepository) DeleteColumnCascade(ctx context.Context, column *domain.BoardColumn) error {
+	const op = "board.repository.DeleteColumnCascade"
+	query := `
+		UPDATE board_columns SET
+			position = NULL,
+			deleted_at = NOW()
+		WHERE board_id = $1 AND id = $2 AND deleted_at IS NULL
+	`
+	return utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		if err := utils.LockTx(ctx, tx, utils.CardColumnLockKey(column.ID)); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := utils.OpExec(ctx, tx.ExecContext, op, query, domain.ErrColumnNotFound, column.BoardID, column.ID); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, cascadeDeleteColumnCardsQuery, column.ID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if _, err := tx.ExecContext(ctx, cascadeDeleteCommentsQuery, column.BoardID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
+}
+
+func (r *BoardRepository) ArchiveColumn(ctx context.Context, column *domain.BoardColumn) error {
+	const op = "board.repository.ArchiveColumn"
+	query := `
+		UPDATE board_columns SET archived_at = NOW(), version = version + 1, updated_at = NOW()
+		WHERE board_id = $1 AND id = $2 AND deleted_at IS NULL AND archived_at IS NULL
+	`
+	return utils.OpExec(ctx, r.storage.ExecContext, op, query, domain.ErrColumnArchived, column.BoardID, column.ID)
+}
+
+// RestoreColumn возвращает колонку из архива на прежнее место среди живых колонок.
+// Если её ключ успела занять другая колонка, восстановленная встаёт в конец доски.
+func (r *BoardRepository) RestoreColumn(ctx context.Context, column *domain.BoardColumn) error {
+	const op = "board.repository.RestoreColumn"
+	query := `
+		UPDATE board_columns bc SET
+			archived_at = NULL,
+			rank = CASE WHEN EXISTS(
+				SELECT 1 FROM board_columns sibling
+				WHERE sibling.board_id = bc.board_id AND sibling.id <> bc.id AND sibling.rank = bc.rank
+					AND sibling.deleted_at IS NULL AND sibling.archived_at IS NULL
+			) THEN $3 ELSE rank END,
+			version = version + 1,
+			updated_at = NOW()
+		WHERE board_id = $1 AND id = $2 AND deleted_at IS NULL AND archived_at IS NOT NULL
+	`
+	return utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		if err := lockBoardColumns(ctx, tx, column.BoardID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		var last string
+		if err := tx.QueryRowContext(ctx, selectLastColumnRankQuery, column.BoardID).Scan(&last); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		key, err := rank.Between(last, "")
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return utils.OpExec(ctx, tx.ExecContext, op, query, domain.ErrColumnNotArchived, column.BoardID, column.ID, key)
+	})
 }
 
 func (r *BoardRepository) ExistsColumn(ctx context.Context, uuid string, columnID uint64) (bool, error) {
@@ -147,9 +269,9 @@ func (r *BoardRepository) ExistsColumn(ctx context.Context, uuid string, columnI
 
 func (r *BoardRepository) GetMaxPositionValue(ctx context.Context, uuid string) (uint64, error) {
 	const op = "board.repository.GetMaxPositionValue"
-	var maxPosition sql.NullInt64
+	var maxPosition uint64
 	var query string
-	query = "SELECT MAX(position) FROM board_columns WHERE board_id = $1 AND deleted_at IS NULL"
+	query = "SELECT COUNT(*) FROM board_columns WHERE board_id = $1 AND deleted_at IS NULL AND archived_at IS NULL"
 	row := r.storage.QueryRowContext(
 		ctx,
 		query,
@@ -159,48 +281,156 @@ func (r *BoardRepository) GetMaxPositionValue(ctx context.Context, uuid string)
 	if err != nil {
 		return 0, fmt.Errorf("%s: %w", op, domain.ErrInvalidMaxPositionValue)
 	}
-	if !maxPosition.Valid {
-		return 0, nil
+	return maxPosition, nil
+}
+
+func (r *BoardRepository) GetBoardsToRebalance(ctx context.Context, maxLength int) ([]string, error) {
+	const op = "board.repository.GetBoardsToRebalance"
+	rows, err := r.storage.QueryContext(ctx, selectBoardsToRebalanceQuery, maxLength)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-	return uint64(maxPosition.Int64), nil
+	return ids, nil
 }
 
-func (r *BoardRepository) MoveColumn(ctx context.Context, id string, columnID, fromPosition, toPosition uint64) error {
+// MoveColumn ставит колонку на позицию position доски.
+// Меняется только строка колонки; блокировка доски упорядочивает одновременные перемещения.
+func (r *BoardRepository) MoveColumn(ctx context.Context, id string, columnID, position uint64) error {
 	const op = "board.repository.MoveColumn"
-	tx, err := r.storage.BeginTx(ctx, &sql.TxOptions{
-		Isolation: sql.LevelRepeatableRead,
+	query := `
+		UPDATE board_columns SET rank = $1, version = version + 1, updated_at = NOW()
+		WHERE board_id = $2 AND id = $3 AND deleted_at IS NULL
+	`
+	return utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		if err := lockBoardColumns(ctx, tx, id); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		key, err := columnRankAt(ctx, tx, id, columnID, position)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return utils.OpExec(ctx, tx.ExecContext, op, query, domain.ErrColumnNotFound, key, id, columnID)
+	})
+}
+
+// ReorderColumns присваивает колонкам доски ключи по порядку columnIDs.
+// columnIDs должен совпадать с набором живых колонок доски, иначе возвращается ErrColumnSetMismatch.
+func (r *BoardRepository) ReorderColumns(ctx context.Context, uuid string, columnIDs []uint64) error {
+	const op = "board.repository.ReorderColumns"
+	query := `
+		UPDATE board_columns SET rank = data.rank, version = board_columns.version + 1, updated_at = NOW()
+		FROM UNNEST($1::BIGINT[], $2::TEXT[]) AS data(id, rank)
+		WHERE board_columns.id = data.id AND board_columns.board_id = $3
+	`
+	return utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		if err := lockBoardColumns(ctx, tx, uuid); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		live, err := liveColumnIDs(ctx, tx, uuid)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if !sameColumnSet(live, columnIDs) {
+			return fmt.Errorf("%s: %w", op, domain.ErrColumnSetMismatch)
+		}
+		ids := make([]int64, 0, len(columnIDs))
+		for _, id := range columnIDs {
+			ids = append(ids, int64(id))
+		}
+		if _, err := tx.ExecContext(ctx, query, ids, rank.Spread(len(ids)), uuid); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
 	})
+}
+
+func liveColumnIDs(ctx context.Context, tx *sql.Tx, uuid string) ([]uint64, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT id FROM board_columns WHERE board_id = $1 AND deleted_at IS NULL AND archived_at IS NULL FOR UPDATE", uuid)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return nil, err
 	}
-	defer tx.Rollback()
-	moveQueryToPosition := `UPDATE board_columns SET position = $1 WHERE position = $2 AND board_id = $3 AND deleted_at IS null`
-	fromPositionInt := int64(fromPosition)
+	defer rows.Close()
 
-	err = utils.OpExec(ctx, tx.ExecContext, op, moveQueryToPosition, sql.ErrNoRows, -fromPositionInt, fromPosition, id)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+	ids := []uint64{}
+	for rows.Next() {
+		var id uint64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
 	}
-	err = utils.OpExec(ctx, tx.ExecContext, op, chooseMoveDirectionQuery(fromPosition, toPosition), sql.ErrNoRows, fromPosition, toPosition, id)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+	return ids, rows.Err()
+}
+
+// sameColumnSet сообщает, что ids — перестановка live без повторов и лишних колонок
+func sameColumnSet(live, ids []uint64) bool {
+	if len(live) != len(ids) {
+		return false
 	}
+	pending := make(map[uint64]bool, len(live))
+	for _, id := range live {
+		pending[id] = true
+	}
+	for _, id := range ids {
+		if !pending[id] {
+			return false
+		}
+		delete(pending, id)
+	}
+	return true
+}
 
-	err = utils.OpExec(ctx, tx.ExecContext, op, moveQueryToPosition, sql.ErrNoRows, toPosition, -fromPositionInt, id)
+func (r *BoardRepository) RebalanceColumns(ctx context.Context, uuid string) error {
+	const op = "board.repository.RebalanceColumns"
+	err := utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		if err := lockBoardColumns(ctx, tx, uuid); err != nil {
+			return err
+		}
+		return rebalanceColumns(ctx, tx, uuid)
+	})
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
-
-	return tx.Commit()
+	return nil
 }
 
-func chooseMoveDirectionQuery(from, to uint64) string {
-	var moveQuery string
+func lockBoardColumns(ctx context.Context, tx *sql.Tx, uuid string) error {
+	return utils.LockTx(ctx, tx, utils.BoardColumnsLockKey(uuid))
+}
 
-	if from < to {
-		moveQuery = `update board_columns set position = position - 1 where position > $1 AND position <= $2 and board_id = $3 and deleted_at is null` // move to right
-	} else {
-		moveQuery = `update board_columns set position = position + 1 where position < $1 and position >= $2 and board_id = $3 and deleted_at is null` // move to left
+// columnRankAt подбирает ключ для позиции position доски без учёта колонки columnID.
+// Совпавшие ключи соседей остаются от данных до блокировок: колонки перестраиваются, и подбор повторяется.
+func columnRankAt(ctx context.Context, tx *sql.Tx, uuid string, columnID, position uint64) (string, error) {
+	var prev, next string
+	if err := tx.QueryRowContext(ctx, selectColumnNeighborRanksQuery, uuid, columnID, position).Scan(&prev, &next); err != nil {
+		return "", err
+	}
+	key, err := rank.Between(prev, next)
+	if !errors.Is(err, rank.ErrInvalidRange) {
+		return key, err
+	}
+	if err := rebalanceColumns(ctx, tx, uuid); err != nil {
+		return "", err
 	}
-	return moveQuery
+	if err := tx.QueryRowContext(ctx, selectColumnNeighborRanksQuery, uuid, columnID, position).Scan(&prev, &next); err != nil {
+		return "", err
+	}
+	return rank.Between(prev, next)
+}
+
+func rebalanceColumns(ctx context.Context, tx *sql.Tx, uuid string) error {
+	return utils.SpreadRanks(ctx, tx, "board_columns", "board_id", uuid)
 }
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"backend/internal/board/domain"
+	"backend/internal/shared/testdb"
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestBoard(t *testing.T, storage *testdb.Storage) string {
+	t.Helper()
+	var userID uint64
+	var boardID string
+	email := fmt.Sprintf("board-repository-%d@test.local", time.Now().UnixNano())
+	require.NoError(t, storage.QueryRow(
+		"INSERT INTO users (name, email, password, refresh_token) VALUES ('test', $1, '', '') RETURNING id", email,
+	).Scan(&userID))
+	require.NoError(t, storage.QueryRow(
+		"INSERT INTO boards (name, user_id, key) VALUES ('test', $1, 'TST') RETURNING id", userID,
+	).Scan(&boardID))
+
+	t.Cleanup(func() {
+		storage.Exec("DELETE FROM board_columns WHERE board_id = $1", boardID)
+		storage.Exec("DELETE FROM boards WHERE id = $1", boardID)
+		storage.Exec("DELETE FROM users WHERE id = $1", userID)
+	})
+	return boardID
+}
+
+// assertUniqueRanks проверяет, что на доске total живых колонок, а их сохранённые ключи строго возрастают.
+// Position выводится из порядка rank, поэтому отдельно не проверяется.
+func assertUniqueRanks(t *testing.T, repo *BoardRepository, boardID string, total int) []*domain.BoardColumn {
+	t.Helper()
+	columns, err := repo.GetColumnList(context.Background(), boardID, false)
+	require.NoError(t, err)
+	require.Len(t, columns, total)
+
+	lastRank := ""
+	for _, column := range columns {
+		assert.Greater(t, column.Rank, lastRank, "column %d", column.ID)
+		lastRank = column.Rank
+	}
+	return columns
+}
+
+func Test_ConcurrentCreateAndMoveColumn(t *testing.T) {
+	storage := testdb.Open(t)
+	repo := NewBoardRepository(storage)
+	boardID := createTestBoard(t, storage)
+	ctx := context.Background()
+
+	const workers, columnsPerWorker, movesPerWorker = 6, 3, 30
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*columnsPerWorker)
+	for worker := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range columnsPerWorker {
+				errs <- repo.CreateColumn(ctx, &domain.BoardColumn{
+					BoardID:  boardID,
+					Name:     fmt.Sprintf("column %d-%d", worker, i),
+					Category: domain.ColumnCategoryTodo,
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	columns := assertUniqueRanks(t, repo, boardID, workers*columnsPerWorker)
+
+	errs = make(chan error, workers*movesPerWorker)
+	for worker := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			random := rand.New(rand.NewPCG(uint64(worker), 0))
+			for range movesPerWorker {
+				errs <- repo.MoveColumn(
+					ctx,
+					boardID,
+					columns[random.IntN(len(columns))].ID,
+					uint64(random.IntN(len(columns))+1),
+				)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	assertUniqueRanks(t, repo, boardID, len(columns))
+}
+
+func Test_ReorderColumns(t *testing.T) {
+	storage := testdb.Open(t)
+	repo := NewBoardRepository(storage)
+	boardID := createTestBoard(t, storage)
+	ctx := context.Background()
+
+	for i := range 4 {
+		require.NoError(t, repo.CreateColumn(ctx, &domain.BoardColumn{
+			BoardID:  boardID,
+			Name:     fmt.Sprintf("column %d", i),
+			Category: domain.ColumnCategoryTodo,
+		}))
+	}
+	columns := assertUniqueRanks(t, repo, boardID, 4)
+	order := []uint64{columns[2].ID, columns[0].ID, columns[3].ID, columns[1].ID}
+
+	tests := []struct {
+		name string
+		ids  []uint64
+		err  error
+	}{
+		{name: "missing column", ids: order[:3], err: domain.ErrColumnSetMismatch},
+		{name: "duplicate column", ids: []uint64{order[0], order[0], order[1], order[2]}, err: domain.ErrColumnSetMismatch},
+		{name: "foreign column", ids: append(slices.Clone(order[:3]), math.MaxInt32), err: domain.ErrColumnSetMismatch},
+		{name: "full order", ids: order},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := repo.ReorderColumns(ctx, boardID, tt.ids)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			reordered := assertUniqueRanks(t, repo, boardID, len(order))
+			for i, column := range reordered {
+				assert.Equal(t, order[i], column.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"backend/internal/board/domain"
+	"backend/internal/shared/rank"
+	"backend/internal/shared/utils"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+const (
+	selectTemplateQuery = `
+		SELECT id, user_id, name, description, content, created_at
+		FROM board_templates
+		WHERE user_id = $1 AND deleted_at IS NULL
+	`
+	// insertTemplateQuery собирает содержимое шаблона из колонок доски $4 в порядке rank;
+	// карточки попадают в шаблон, только если $5 = true
+	insertTemplateQuery = `
+		INSERT INTO board_templates (user_id, name, description, content)
+		SELECT $1, $2, $3, jsonb_build_object('columns', COALESCE(jsonb_agg(jsonb_build_object(
+			'name', bc.name,
+			'color', COALESCE(bc.color, ''),
+			'category', bc.category,
+			'cards', CASE WHEN $5 THEN (
+				SELECT COALESCE(jsonb_agg(jsonb_build_object(
+					'text', c.text,
+					'description', COALESCE(c.description, ''),
+					'color', COALESCE(c.properties->>'color', ''),
+					'tag', COALESCE(c.properties->>'tag', '')
+				) ORDER BY c.rank, c.id), '[]')
+				FROM cards c
+				WHERE c.column_id = bc.id AND c.deleted_at IS NULL AND c.archived_at IS NULL
+			) ELSE '[]' END
+		) ORDER BY bc.rank, bc.id), '[]'))
+		FROM board_columns bc
+		WHERE bc.board_id = $4 AND bc.deleted_at IS NULL AND bc.archived_at IS NULL
+		RETURNING id
+	`
+	insertTemplateBoardQuery = `
+		INSERT INTO boards (name, description, user_id, key, card_seq)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`
+	insertTemplateColumnQuery = `
+		INSERT INTO board_columns (board_id, name, color, category, rank)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`
+	// insertTemplateCardsQuery вставляет карточки одной колонки; номера продолжают счётчик с $4
+	insertTemplateCardsQuery = `
+		INSERT INTO cards (board_id, column_id, created_by, number, text, description, rank, properties)
+		SELECT $1, $2, $3, $4 + data.n, data.text, data.description, data.rank,
+			jsonb_strip_nulls(jsonb_build_object('color', NULLIF(data.color, ''), 'tag', NULLIF(data.tag, '')))
+		FROM UNNEST($5::TEXT[], $6::TEXT[], $7::TEXT[], $8::TEXT[], $9::TEXT[])
+			WITH ORDINALITY AS data(text, description, rank, color, tag, n)
+	`
+)
+
+func (r *BoardRepository) GetTemplate(ctx context.Context, id string, userID uint64) (*domain.BoardTemplate, error) {
+	const op = "board.repository.GetTemplate"
+	template := &domain.BoardTemplate{}
+	row := r.storage.QueryRowContext(ctx, selectTemplateQuery+" AND id::TEXT = $2", userID, id)
+	if err := scanTemplate(row, template); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, domain.ErrTemplateNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return template, nil
+}
+
+func (r *BoardRepository) GetTemplateList(ctx context.Context, userID uint64) ([]*domain.BoardTemplate, error) {
+	const op = "board.repository.GetTemplateList"
+	rows, err := r.storage.QueryContext(ctx, selectTemplateQuery+" ORDER BY name, created_at", userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	templates := []*domain.BoardTemplate{}
+	for rows.Next() {
+		template := &domain.BoardTemplate{}
+		if err := scanTemplate(rows, template); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		templates = append(templates, template)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return templates, nil
+}
+
+func (r *BoardRepository) CreateTemplate(ctx context.Context, cmd *domain.BoardTemplatePublishCommand) (string, error) {
+	const op = "board.repository.CreateTemplate"
+	var id string
+	if err := r.storage.QueryRowContext(
+		ctx,
+		insertTemplateQuery,
+		cmd.UserID,
+		cmd.Name,
+		cmd.Description,
+		cmd.BoardID,
+		cmd.WithCards,
+	).Scan(&id); err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (r *BoardRepository) DeleteTemplate(ctx context.Context, id string, userID uint64) error {
+	const op = "board.repository.DeleteTemplate"
+	query := "UPDATE board_templates SET deleted_at = NOW() WHERE id::TEXT = $1 AND user_id = $2 AND deleted_at IS NULL"
+	return utils.OpExec(
+		ctx,
+		r.storage.ExecContext,
+		op,
+		query,
+		domain.ErrTemplateNotFound,
+		id,
+		userID,
+	)
+}
+
+// CreateFromTemplate создаёт доску с колонками и карточками шаблона одной транзакцией.
+// Доска новая, поэтому карточки нумеруются с 1, а card_seq сразу выставляется в их число.
+func (r *BoardRepository) CreateFromTemplate(
+	ctx context.Context, board *domain.Board, content domain.BoardTemplateContent,
+) (string, error) {
+	const op = "board.repository.CreateFromTemplate"
+	var boardID string
+	err := utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		if err := tx.QueryRowContext(
+			ctx,
+			insertTemplateBoardQuery,
+			board.Name,
+			board.Description,
+			board.UserID,
+			board.Key,
+			content.CardCount(),
+		).Scan(&boardID); err != nil {
+			return err
+		}
+
+		columnRanks := rank.Spread(len(content.Columns))
+		number := 0
+		for i, column := range content.Columns {
+			var columnID uint64
+			if err := tx.QueryRowContext(
+				ctx,
+				insertTemplateColumnQuery,
+				boardID,
+				column.Name,
+				column.Color,
+				column.Category,
+				columnRanks[i],
+			).Scan(&columnID); err != nil {
+				return err
+			}
+			if len(column.Cards) == 0 {
+				continue
+			}
+
+			texts := make([]string, 0, len(column.Cards))
+			descriptions := make([]string, 0, len(column.Cards))
+			colors := make([]string, 0, len(column.Cards))
+			tags := make([]string, 0, len(column.Cards))
+			for _, card := range column.Cards {
+				texts = append(texts, card.Text)
+				descriptions = append(descriptions, card.Description)
+				colors = append(colors, card.Color)
+				tags = append(tags, card.Tag)
+			}
+			if _, err := tx.ExecContext(
+				ctx,
+				insertTemplateCardsQuery,
+				boardID,
+				columnID,
+				board.UserID,
+				number,
+				texts,
+				descriptions,
+				rank.Spread(len(column.Cards)),
+				colors,
+				tags,
+			); err != nil {
+				return err
+			}
+			number += len(column.Cards)
+		}
+		return nil
+	})
+	if utils.IsUniqueViolation(err) {
+		return "", fmt.Errorf("%s: %w", op, domain.ErrBoardKeyTaken)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	return boardID, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTemplate(row scanner, template *domain.BoardTemplate) error {
+	return row.Scan(
+		&template.ID,
+		&template.UserID,
+		&template.Name,
+		&template.Description,
+		&template.Content,
+		&template.CreatedAt,
+	)
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"backend/internal/board/domain"
+	"backend/internal/shared/testdb"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TemplateRoundTrip(t *testing.T) {
+	storage := testdb.Open(t)
+	repo := NewBoardRepository(storage)
+	sourceID := createTestBoard(t, storage)
+	ctx := context.Background()
+
+	require.NoError(t, repo.CreateColumn(ctx, &domain.BoardColumn{
+		BoardID:  sourceID,
+		Name:     "todo",
+		Color:    "#2196F3",
+		Category: domain.ColumnCategoryTodo,
+	}))
+	columns := assertUniqueRanks(t, repo, sourceID, 1)
+
+	var userID uint64
+	require.NoError(t, storage.QueryRow("SELECT user_id FROM boards WHERE id = $1", sourceID).Scan(&userID))
+	_, err := storage.Exec(
+		`INSERT INTO cards (board_id, column_id, text, rank, number, properties)
+		VALUES ($1, $2, 'sample', 'a', 1, '{"tag": "ops"}')`,
+		sourceID, columns[0].ID,
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		storage.Exec("DELETE FROM cards WHERE board_id = $1", sourceID)
+		storage.Exec("DELETE FROM board_templates WHERE user_id = $1", userID)
+	})
+
+	templateID, err := repo.CreateTemplate(ctx, &domain.BoardTemplatePublishCommand{
+		UserID:    userID,
+		BoardID:   sourceID,
+		Name:      "template",
+		WithCards: true,
+	})
+	require.NoError(t, err)
+
+	template, err := repo.GetTemplate(ctx, templateID, userID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.BoardTemplateContent{Columns: []domain.TemplateColumn{{
+		Name:     "todo",
+		Color:    "#2196F3",
+		Category: domain.ColumnCategoryTodo,
+		Cards:    []domain.TemplateCard{{Text: "sample", Tag: "ops"}},
+	}}}, template.Content)
+
+	_, err = repo.GetTemplate(ctx, templateID, userID+1)
+	assert.ErrorIs(t, err, domain.ErrTemplateNotFound)
+
+	boardID, err := repo.CreateFromTemplate(ctx, &domain.Board{Name: "from template", UserID: userID, Key: "TPL"}, template.Content)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		storage.Exec("DELETE FROM cards WHERE board_id = $1", boardID)
+		storage.Exec("DELETE FROM board_columns WHERE board_id = $1", boardID)
+		storage.Exec("DELETE FROM boards WHERE id = $1", boardID)
+	})
+
+	created := assertUniqueRanks(t, repo, boardID, 1)
+	assert.Equal(t, "#2196F3", created[0].Color)
+	var cards, cardSeq int
+	require.NoError(t, storage.QueryRow("SELECT COUNT(*) FROM cards WHERE column_id = $1", created[0].ID).Scan(&cards))
+	require.NoError(t, storage.QueryRow("SELECT card_seq FROM boards WHERE id = $1", boardID).Scan(&cardSeq))
+	assert.Equal(t, 1, cards)
+	assert.Equal(t, 1, cardSeq)
+
+	require.NoError(t, repo.DeleteTemplate(ctx, templateID, userID))
+	assert.ErrorIs(t, repo.DeleteTemplate(ctx, templateID, userID), domain.ErrTemplateNotFound)
+}
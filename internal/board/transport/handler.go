@@ -4,8 +4,10 @@ import (
 	"backend/internal/board/domain"
 	cardDomain "backend/internal/card/domain"
 	boardError "backend/internal/shared/errors"
+	queryFilter "backend/internal/shared/filter"
 	"backend/internal/shared/ports/http"
 	"backend/internal/shared/utils"
+	viewDomain "backend/internal/view/domain"
 	"context"
 	"errors"
 	"log/slog"
@@ -18,28 +20,52 @@ const (
 	BoardIDKey  = "id"
 	ColumnIDKey = "column_id"
 	UserIDKey   = "userID"
+	SprintKey   = "sprint"
+	FilterKey   = "q"
+	ViewKey     = "view"
+	ArchivedKey = "archived"
+	CascadeKey  = "cascade"
+	TemplateKey = "template_id"
 )
 
 const (
-	CreatedMessage = "created"
-	UpdatedMessage = "updated"
-	MovedMessage   = "moved"
+	CreatedMessage  = "created"
+	UpdatedMessage  = "updated"
+	MovedMessage    = "moved"
+	ArchivedMessage = "archived"
+	RestoredMessage = "restored"
 )
 
 type LangMessage interface {
 	GetResponseMessage(ctx context.Context, key string) string
+	GetErrorMessage(ctx context.Context, key string, params map[string]string) string
 }
 
 type BoardService interface {
 	GetList(ctx context.Context, filter *domain.BoardGetFilter) (*domain.BoardListResult, error)
-	GetByUUID(ctx context.Context, board *domain.Board) (*domain.BoardWithDetails[cardDomain.CardWithComments], error)
+	GetByUUID(
+		ctx context.Context, board *domain.Board, filter *domain.BoardDetailsFilter,
+	) (*domain.BoardWithDetails[cardDomain.CardWithComments], error)
+	Get(ctx context.Context, board *domain.Board) (*domain.Board, error)
 	Create(ctx context.Context, board *domain.Board) error
+	Duplicate(ctx context.Context, cmd *domain.BoardDuplicateCommand) (*domain.Board, error)
 	Update(ctx context.Context, board *domain.Board) error
-	Delete(ctx context.Context, board *domain.Board) error
+	Delete(ctx context.Context, board *domain.Board, cascade bool) error
+	Archive(ctx context.Context, board *domain.Board) error
+	Restore(ctx context.Context, board *domain.Board) error
+	GetColumn(ctx context.Context, req *domain.BoardColumn) (*domain.BoardColumn, error)
 	CreateColumn(ctx context.Context, req *domain.BoardColumn) error
 	UpdateColumn(ctx context.Context, req *domain.BoardColumn) error
-	DeleteColumn(ctx context.Context, req *domain.BoardColumn) error
+	DeleteColumn(ctx context.Context, req *domain.BoardColumn, cascade bool) error
 	MoveColumn(ctx context.Context, req *domain.BoardMoveCommand) error
+	ReorderColumns(ctx context.Context, cmd *domain.BoardColumnOrderCommand) error
+	ArchiveColumn(ctx context.Context, req *domain.BoardColumn) error
+	RestoreColumn(ctx context.Context, req *domain.BoardColumn) error
+	GetTemplateList(ctx context.Context, userID uint64) ([]*domain.BoardTemplate, error)
+	GetTemplate(ctx context.Context, id string, userID uint64) (*domain.BoardTemplate, error)
+	PublishTemplate(ctx context.Context, cmd *domain.BoardTemplatePublishCommand) (*domain.BoardTemplate, error)
+	InstantiateTemplate(ctx context.Context, cmd *domain.BoardTemplateInstantiateCommand) (*domain.Board, error)
+	DeleteTemplate(ctx context.Context, id string, userID uint64) error
 }
 
 type BoardHandler struct {
@@ -72,11 +98,43 @@ func (h *BoardHandler) GetByUUID(c *fiber.Ctx) error {
 	}
 	body.UserID = userID
 
-	response, err := h.boardService.GetByUUID(c.Context(), h.boardMapper.ToBoard(body))
+	filter := &BoardDetailsFilter{}
+	if sprint := c.Query(SprintKey); sprint != "" {
+		sprintID, err := strconv.ParseUint(sprint, 10, 64)
+		if err != nil || sprintID == 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid sprint ID"})
+		}
+		filter.SprintID = &sprintID
+	}
+	if view := c.Query(ViewKey); view != "" {
+		viewID, err := strconv.ParseUint(view, 10, 64)
+		if err != nil || viewID == 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid view ID"})
+		}
+		filter.ViewID = &viewID
+	}
+	filter.Archived = c.QueryBool(ArchivedKey)
+	if q := c.Query(FilterKey); q != "" {
+		query, err := queryFilter.Parse(q)
+		if err != nil {
+			return h.filterError(c, err)
+		}
+		filter.Query = query
+	}
+
+	response, err := h.boardService.GetByUUID(
+		c.Context(),
+		h.boardMapper.ToBoard(body),
+		h.boardMapper.ToBoardDetailsFilter(filter),
+	)
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrBoardNotFound):
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Board not found"})
+		case errors.Is(err, viewDomain.ErrViewNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "View not found"})
+		case errors.As(err, new(*queryFilter.SyntaxError)):
+			return h.filterError(c, err)
 		}
 		slog.Error(
 			"service error",
@@ -86,9 +144,79 @@ func (h *BoardHandler) GetByUUID(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
 	}
 
+	utils.SetETag(c, response.Version)
 	return c.JSON(h.boardMapper.ToSingleBoardResponse(response))
 }
 
+// boardVersionConflict отвечает 412 с актуальным состоянием доски, чтобы клиент мог слить изменения
+func (h *BoardHandler) boardVersionConflict(c *fiber.Ctx, op string, body *BoardRequest) error {
+	board, err := h.boardService.Get(c.Context(), &domain.Board{
+		ID:     body.ID,
+		UserID: body.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrBoardNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Board not found"})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+	utils.SetETag(c, board.Version)
+	return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+		"errors":  boardError.ErrVersionMismatch.Error(),
+		"current": h.boardMapper.ToBoardResponse(board),
+	})
+}
+
+func (h *BoardHandler) columnVersionConflict(c *fiber.Ctx, op string, body *BoardColumnRequest) error {
+	column, err := h.boardService.GetColumn(c.Context(), &domain.BoardColumn{
+		ID:      body.ID,
+		BoardID: body.BoardID,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrColumnNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Column not found"})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+	utils.SetETag(c, column.Version)
+	return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+		"errors":  boardError.ErrVersionMismatch.Error(),
+		"current": h.boardMapper.ToBoardColumnResponse(column),
+	})
+}
+
+// patchError различает неподдерживаемый Content-Type и некорректный патч
+func (h *BoardHandler) patchError(c *fiber.Ctx, err error) error {
+	if errors.Is(err, utils.ErrUnsupportedPatchType) {
+		return c.Status(fiber.StatusUnsupportedMediaType).JSON(fiber.Map{"errors": err.Error()})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+}
+
+// filterError отдаёт локализованную ошибку разбора фильтра с позицией
+func (h *BoardHandler) filterError(c *fiber.Ctx, err error) error {
+	var syntaxErr *queryFilter.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid filter"})
+	}
+	return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+		"errors": fiber.Map{
+			FilterKey: h.lang.GetErrorMessage(c.Context(), syntaxErr.Key(), syntaxErr.MessageParams()),
+		},
+		"position": syntaxErr.Pos,
+	})
+}
+
 func (h *BoardHandler) GetList(c *fiber.Ctx) error {
 	const op = "board.transport.handler.GetList"
 	body, err := utils.ParseBody[BoardGetFilter](c)
@@ -142,6 +270,8 @@ func (h *BoardHandler) Store(c *fiber.Ctx) error {
 		switch {
 		case errors.Is(err, domain.ErrBoardAlreadyExists):
 			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": "Board already exists"})
+		case errors.Is(err, domain.ErrBoardKeyTaken):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrBoardKeyTaken.Error()})
 		}
 		slog.Error(
 			"service error",
@@ -158,6 +288,46 @@ func (h *BoardHandler) Store(c *fiber.Ctx) error {
 	)
 }
 
+// Duplicate создаёт копию доски и отдаёт новую доску
+func (h *BoardHandler) Duplicate(c *fiber.Ctx) error {
+	const op = "board.transport.handler.Duplicate"
+	body, err := utils.ParseBody[BoardDuplicateRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	body.BoardID = c.Params(BoardIDKey)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	board, err := h.boardService.Duplicate(c.Context(), h.boardMapper.ToBoardDuplicateCommand(body))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBoardNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Board not found"})
+		case errors.Is(err, domain.ErrBoardKeyTaken):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrBoardKeyTaken.Error()})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+
+	utils.SetETag(c, board.Version)
+	return c.Status(fiber.StatusCreated).JSON(h.boardMapper.ToBoardResponse(board))
+}
+
 func (h *BoardHandler) Update(c *fiber.Ctx) error {
 	const op = "board.transport.handler.Update"
 	body, err := utils.ParseBody[BoardRequest](c)
@@ -170,7 +340,58 @@ func (h *BoardHandler) Update(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Missing board ID"})
 	}
 	body.ID = uuid
+	body.Version, err = utils.IfMatchVersion(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": utils.ErrInvalidIfMatch.Error()})
+	}
+
+	return h.saveBoard(c, op, body)
+}
+
+// Patch применяет JSON Merge Patch к текущему состоянию доски
+func (h *BoardHandler) Patch(c *fiber.Ctx) error {
+	const op = "board.transport.handler.Patch"
+	userID, ok := c.Locals(UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"errors": "Unauthorized"})
+	}
+	uuid := c.Params(BoardIDKey)
+	if uuid == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Missing board ID"})
+	}
+	version, err := utils.IfMatchVersion(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": utils.ErrInvalidIfMatch.Error()})
+	}
+
+	current, err := h.boardService.Get(c.Context(), &domain.Board{
+		ID:     uuid,
+		UserID: userID,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrBoardNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Board not found"})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+
+	body, err := utils.ParsePatch(c, h.boardMapper.ToBoardRequest(current))
+	if err != nil {
+		return h.patchError(c, err)
+	}
+	body.ID = uuid
+	body.UserID = userID
+	body.Version = version
+
+	return h.saveBoard(c, op, body)
+}
 
+func (h *BoardHandler) saveBoard(c *fiber.Ctx, op string, body *BoardRequest) error {
 	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
 		if err != nil {
 			slog.Error("validator error",
@@ -186,6 +407,10 @@ func (h *BoardHandler) Update(c *fiber.Ctx) error {
 		switch {
 		case errors.Is(err, domain.ErrBoardNotFound):
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Board not found"})
+		case errors.Is(err, domain.ErrBoardKeyTaken):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrBoardKeyTaken.Error()})
+		case errors.Is(err, boardError.ErrVersionMismatch):
+			return h.boardVersionConflict(c, op, body)
 		}
 		slog.Error(
 			"service error",
@@ -218,7 +443,7 @@ func (h *BoardHandler) Delete(c *fiber.Ctx) error {
 	}
 	body.ID = uuid
 
-	if err := h.boardService.Delete(c.Context(), h.boardMapper.ToBoard(body)); err != nil {
+	if err := h.boardService.Delete(c.Context(), h.boardMapper.ToBoard(body), c.QueryBool(CascadeKey)); err != nil {
 		slog.Error(
 			"service error",
 			slog.String("operation", op),
@@ -234,6 +459,50 @@ func (h *BoardHandler) Delete(c *fiber.Ctx) error {
 	return c.SendStatus(fiber.StatusNoContent)
 }
 
+// Archive убирает доску в архив; открыть её можно с параметром archived=true
+func (h *BoardHandler) Archive(c *fiber.Ctx) error {
+	return h.changeBoardArchive(c, "board.transport.handler.Archive", h.boardService.Archive, ArchivedMessage)
+}
+
+func (h *BoardHandler) Restore(c *fiber.Ctx) error {
+	return h.changeBoardArchive(c, "board.transport.handler.Restore", h.boardService.Restore, RestoredMessage)
+}
+
+// changeBoardArchive выполняет перенос доски в архив или обратно и отвечает сообщением message
+func (h *BoardHandler) changeBoardArchive(
+	c *fiber.Ctx, op string, action func(context.Context, *domain.Board) error, message string,
+) error {
+	userID, ok := c.Locals(UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{})
+	}
+	uuid := c.Params(BoardIDKey)
+	if uuid == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Missing board ID"})
+	}
+
+	if err := action(c.Context(), h.boardMapper.ToBoard(&BoardRequest{ID: uuid, UserID: userID})); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBoardNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": domain.ErrBoardNotFound.Error()})
+		case errors.Is(err, domain.ErrBoardArchived):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrBoardArchived.Error()})
+		case errors.Is(err, domain.ErrBoardNotArchived):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrBoardNotArchived.Error()})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+
+	return c.JSON(fiber.Map{
+		"message": h.lang.GetResponseMessage(c.Context(), message),
+	})
+}
+
 func (h *BoardHandler) CreateColumn(c *fiber.Ctx) error {
 	const op = "board.transport.handler.CreateColumn"
 	body, err := utils.ParseBody[BoardColumnRequest](c)
@@ -297,7 +566,58 @@ func (h *BoardHandler) UpdateColumn(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid column ID"})
 	}
 	body.ID = columnIDUint64
+	body.Version, err = utils.IfMatchVersion(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": utils.ErrInvalidIfMatch.Error()})
+	}
+
+	return h.saveColumn(c, op, body)
+}
+
+// PatchColumn применяет JSON Merge Patch к текущему состоянию колонки
+func (h *BoardHandler) PatchColumn(c *fiber.Ctx) error {
+	const op = "board.transport.handler.PatchColumn"
+	uuid := c.Params(BoardIDKey)
+	if uuid == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Missing board ID"})
+	}
+	columnID, err := strconv.ParseUint(c.Params(ColumnIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid column ID"})
+	}
+	version, err := utils.IfMatchVersion(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": utils.ErrInvalidIfMatch.Error()})
+	}
+
+	current, err := h.boardService.GetColumn(c.Context(), &domain.BoardColumn{
+		ID:      columnID,
+		BoardID: uuid,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrColumnNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Column not found"})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
 
+	body, err := utils.ParsePatch(c, h.boardMapper.ToBoardColumnRequest(current))
+	if err != nil {
+		return h.patchError(c, err)
+	}
+	body.ID = columnID
+	body.BoardID = uuid
+	body.Version = version
+
+	return h.saveColumn(c, op, body)
+}
+
+func (h *BoardHandler) saveColumn(c *fiber.Ctx, op string, body *BoardColumnRequest) error {
 	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
 		if err != nil {
 			slog.Error("validator error",
@@ -313,6 +633,8 @@ func (h *BoardHandler) UpdateColumn(c *fiber.Ctx) error {
 		switch {
 		case errors.Is(err, domain.ErrColumnNotFound):
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Column not found"})
+		case errors.Is(err, boardError.ErrVersionMismatch):
+			return h.columnVersionConflict(c, op, body)
 		}
 		slog.Error(
 			"service error",
@@ -345,7 +667,7 @@ func (h *BoardHandler) DeleteColumn(c *fiber.Ctx) error {
 	body.ID = columnIDUint64
 	body.BoardID = uuid
 
-	if err := h.boardService.DeleteColumn(c.Context(), h.boardMapper.ToBoardColumn(body)); err != nil {
+	if err := h.boardService.DeleteColumn(c.Context(), h.boardMapper.ToBoardColumn(body), c.QueryBool(CascadeKey)); err != nil {
 		switch {
 		case errors.Is(err, domain.ErrColumnNotFound):
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Column not found"})
@@ -409,3 +731,203 @@ func (h *BoardHandler) MoveColumn(c *fiber.Ctx) error {
 		},
 	)
 }
+
+// ArchiveColumn убирает колонку в архив; её карточки скрываются вместе с ней
+func (h *BoardHandler) ArchiveColumn(c *fiber.Ctx) error {
+	return h.changeColumnArchive(c, "board.transport.handler.ArchiveColumn", h.boardService.ArchiveColumn, ArchivedMessage)
+}
+
+func (h *BoardHandler) RestoreColumn(c *fiber.Ctx) error {
+	return h.changeColumnArchive(c, "board.transport.handler.RestoreColumn", h.boardService.RestoreColumn, RestoredMessage)
+}
+
+func (h *BoardHandler) changeColumnArchive(
+	c *fiber.Ctx, op string, action func(context.Context, *domain.BoardColumn) error, message string,
+) error {
+	uuid := c.Params(BoardIDKey)
+	if uuid == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Missing board ID"})
+	}
+	columnID, err := strconv.ParseUint(c.Params(ColumnIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid column ID"})
+	}
+
+	if err := action(c.Context(), h.boardMapper.ToBoardColumn(&BoardColumnRequest{ID: columnID, BoardID: uuid})); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrColumnNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Column not found"})
+		case errors.Is(err, domain.ErrColumnArchived):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrColumnArchived.Error()})
+		case errors.Is(err, domain.ErrColumnNotArchived):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrColumnNotArchived.Error()})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+
+	return c.JSON(fiber.Map{
+		"message": h.lang.GetResponseMessage(c.Context(), message),
+	})
+}
+
+// ReorderColumns принимает полный список колонок доски в новом порядке
+func (h *BoardHandler) ReorderColumns(c *fiber.Ctx) error {
+	const op = "board.transport.handler.ReorderColumns"
+	body, err := utils.ParseBody[BoardColumnOrderRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	body.BoardID = c.Params(BoardIDKey)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	if err := h.boardService.ReorderColumns(c.Context(), h.boardMapper.ToBoardColumnOrderCommand(body)); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBoardNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Board not found"})
+		case errors.Is(err, domain.ErrColumnSetMismatch):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrColumnSetMismatch.Error()})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		fiber.Map{
+			"message": h.lang.GetResponseMessage(c.Context(), MovedMessage),
+		},
+	)
+}
+
+func (h *BoardHandler) GetTemplateList(c *fiber.Ctx) error {
+	const op = "board.transport.handler.GetTemplateList"
+	userID, ok := c.Locals(UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{})
+	}
+
+	templates, err := h.boardService.GetTemplateList(c.Context(), userID)
+	if err != nil {
+		return h.templateError(c, op, err)
+	}
+	return c.JSON(h.boardMapper.ToBoardTemplateListResponse(templates))
+}
+
+func (h *BoardHandler) GetTemplate(c *fiber.Ctx) error {
+	const op = "board.transport.handler.GetTemplate"
+	userID, ok := c.Locals(UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{})
+	}
+
+	template, err := h.boardService.GetTemplate(c.Context(), c.Params(TemplateKey), userID)
+	if err != nil {
+		return h.templateError(c, op, err)
+	}
+	return c.JSON(h.boardMapper.ToBoardTemplateResponse(template))
+}
+
+// PublishTemplate сохраняет доску пользователя как его личный шаблон
+func (h *BoardHandler) PublishTemplate(c *fiber.Ctx) error {
+	const op = "board.transport.handler.PublishTemplate"
+	body, err := utils.ParseBody[BoardTemplatePublishRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	template, err := h.boardService.PublishTemplate(c.Context(), h.boardMapper.ToBoardTemplatePublishCommand(body))
+	if err != nil {
+		return h.templateError(c, op, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(h.boardMapper.ToBoardTemplateResponse(template))
+}
+
+// InstantiateTemplate создаёт из шаблона новую доску и отдаёт её
+func (h *BoardHandler) InstantiateTemplate(c *fiber.Ctx) error {
+	const op = "board.transport.handler.InstantiateTemplate"
+	body, err := utils.ParseBody[BoardTemplateInstantiateRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	body.TemplateID = c.Params(TemplateKey)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	board, err := h.boardService.InstantiateTemplate(c.Context(), h.boardMapper.ToBoardTemplateInstantiateCommand(body))
+	if err != nil {
+		return h.templateError(c, op, err)
+	}
+
+	utils.SetETag(c, board.Version)
+	return c.Status(fiber.StatusCreated).JSON(h.boardMapper.ToBoardResponse(board))
+}
+
+func (h *BoardHandler) DeleteTemplate(c *fiber.Ctx) error {
+	const op = "board.transport.handler.DeleteTemplate"
+	userID, ok := c.Locals(UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{})
+	}
+
+	if err := h.boardService.DeleteTemplate(c.Context(), c.Params(TemplateKey), userID); err != nil {
+		return h.templateError(c, op, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *BoardHandler) templateError(c *fiber.Ctx, op string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrTemplateNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Template not found"})
+	case errors.Is(err, domain.ErrBoardNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Board not found"})
+	case errors.Is(err, domain.ErrBuiltinTemplate):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"errors": domain.ErrBuiltinTemplate.Error()})
+	case errors.Is(err, domain.ErrBoardKeyTaken):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrBoardKeyTaken.Error()})
+	}
+	slog.Error(
+		"service error",
+		slog.String("operation", op),
+		slog.Any("errors", err),
+	)
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+}
@@ -3,6 +3,7 @@ package transport
 import (
 	"backend/internal/board/domain"
 	cardDomain "backend/internal/card/domain"
+	viewDomain "backend/internal/view/domain"
 	"cmp"
 	"slices"
 )
@@ -15,9 +16,10 @@ func (m *BoardMapper) ToBoardGetFilter(req *BoardGetFilter) *domain.BoardGetFilt
 	}
 
 	filters := &domain.BoardGetFilter{
-		UserID:  req.UserID,
-		PerPage: req.PerPage,
-		Page:    req.Page,
+		UserID:   req.UserID,
+		PerPage:  req.PerPage,
+		Page:     req.Page,
+		Archived: req.Archived,
 	}
 
 	if req.FilterFields != nil {
@@ -44,9 +46,65 @@ func (m *BoardMapper) ToBoard(req *BoardRequest) *domain.Board {
 
 	return &domain.Board{
 		ID:          req.ID,
+		Key:         req.Key,
 		UserID:      req.UserID,
 		Name:        req.Name,
 		Description: req.Description,
+		Version:     req.Version,
+	}
+}
+
+func (m *BoardMapper) ToBoardRequest(board *domain.Board) *BoardRequest {
+	if board == nil {
+		return nil
+	}
+
+	return &BoardRequest{
+		ID:          board.ID,
+		UserID:      board.UserID,
+		Key:         board.Key,
+		Name:        board.Name,
+		Description: board.Description,
+		Version:     board.Version,
+	}
+}
+
+func (m *BoardMapper) ToBoardColumnRequest(column *domain.BoardColumn) *BoardColumnRequest {
+	if column == nil {
+		return nil
+	}
+
+	return &BoardColumnRequest{
+		ID:       column.ID,
+		BoardID:  column.BoardID,
+		Name:     column.Name,
+		Color:    column.Color,
+		Category: column.Category,
+		Version:  column.Version,
+	}
+}
+
+func (m *BoardMapper) ToBoardDetailsFilter(req *BoardDetailsFilter) *domain.BoardDetailsFilter {
+	if req == nil {
+		return nil
+	}
+
+	return &domain.BoardDetailsFilter{
+		ViewID:   req.ViewID,
+		Archived: req.Archived,
+		Cards:    m.ToCardListFilter(req),
+	}
+}
+
+func (m *BoardMapper) ToCardListFilter(req *BoardDetailsFilter) *cardDomain.CardListFilter {
+	if req == nil {
+		return nil
+	}
+
+	return &cardDomain.CardListFilter{
+		SprintID: req.SprintID,
+		Query:    req.Query,
+		Archived: req.Archived,
 	}
 }
 
@@ -56,10 +114,12 @@ func (m *BoardMapper) ToBoardColumn(req *BoardColumnRequest) *domain.BoardColumn
 	}
 
 	return &domain.BoardColumn{
-		ID:      req.ID,
-		BoardID: req.BoardID,
-		Name:    req.Name,
-		Color:   req.Color,
+		ID:       req.ID,
+		BoardID:  req.BoardID,
+		Name:     req.Name,
+		Color:    req.Color,
+		Category: req.Category,
+		Version:  req.Version,
 	}
 }
 
@@ -76,6 +136,32 @@ func (m *BoardMapper) ToBoardMoveCommand(req *BoardColumnMoveRequest) *domain.Bo
 	}
 }
 
+func (m *BoardMapper) ToBoardDuplicateCommand(req *BoardDuplicateRequest) *domain.BoardDuplicateCommand {
+	if req == nil {
+		return nil
+	}
+
+	return &domain.BoardDuplicateCommand{
+		UserID:       req.UserID,
+		BoardID:      req.BoardID,
+		Name:         req.Name,
+		Key:          req.Key,
+		Depth:        req.Depth,
+		WithComments: req.WithComments,
+	}
+}
+
+func (m *BoardMapper) ToBoardColumnOrderCommand(req *BoardColumnOrderRequest) *domain.BoardColumnOrderCommand {
+	if req == nil {
+		return nil
+	}
+
+	return &domain.BoardColumnOrderCommand{
+		BoardID:   req.BoardID,
+		ColumnIDs: req.ColumnIDs,
+	}
+}
+
 func (m *BoardMapper) ToBoardListResponse(data *domain.BoardListResult) *BoardListResponse {
 	if data == nil {
 		return nil
@@ -93,13 +179,7 @@ func (m *BoardMapper) ToBoardListResponse(data *domain.BoardListResult) *BoardLi
 	}
 
 	for _, data := range data.Data {
-		list.Data = append(list.Data, &BoardResponse{
-			ID:          data.ID,
-			Name:        data.Name,
-			Description: data.Description,
-			CreatedAt:   data.CreatedAt,
-			UpdatedAt:   data.UpdatedAt,
-		})
+		list.Data = append(list.Data, m.ToBoardResponse(data))
 	}
 
 	return list
@@ -113,63 +193,127 @@ func (m *BoardMapper) ToSingleBoardResponse(data *domain.BoardWithDetails[cardDo
 	return &SingleBoardResponse[CardWithComments]{
 		BoardResponse: m.toBoardResponse(data),
 		Columns:       m.mapAndSortColumns(data.Columns),
-		Cards:         m.mapAndSortCards(data.Cards),
+		Cards:         m.mapCards(data.Key, data.Cards),
+		View:          m.toBoardViewResponse(data.View),
+	}
+}
+
+func (m *BoardMapper) toBoardViewResponse(view *viewDomain.View) *BoardViewResponse {
+	if view == nil {
+		return nil
+	}
+
+	response := &BoardViewResponse{
+		ID:                 view.ID,
+		Name:               view.Name,
+		Query:              view.Query,
+		SortBy:             view.SortBy,
+		SortOrder:          view.SortOrder,
+		CollapsedColumns:   view.Layout.CollapsedColumns,
+		CollapsedSwimlanes: view.Layout.CollapsedSwimlanes,
+	}
+	if response.CollapsedColumns == nil {
+		response.CollapsedColumns = []uint64{}
+	}
+	if response.CollapsedSwimlanes == nil {
+		response.CollapsedSwimlanes = []string{}
 	}
+	return response
 }
 
 func (m *BoardMapper) toBoardResponse(data *domain.BoardWithDetails[cardDomain.CardWithComments]) *BoardResponse {
+	return m.ToBoardResponse(data.Board)
+}
+
+func (m *BoardMapper) ToBoardResponse(data *domain.Board) *BoardResponse {
+	if data == nil {
+		return nil
+	}
+
 	return &BoardResponse{
 		ID:          data.ID,
+		Key:         data.Key,
 		Name:        data.Name,
 		Description: data.Description,
+		Version:     data.Version,
 		CreatedAt:   data.CreatedAt,
 		UpdatedAt:   data.UpdatedAt,
+		ArchivedAt:  data.ArchivedAt,
+	}
+}
+
+func (m *BoardMapper) ToBoardColumnResponse(column *domain.BoardColumn) *BoardColumnResponse {
+	if column == nil {
+		return nil
+	}
+
+	return &BoardColumnResponse{
+		ID:         column.ID,
+		Position:   column.Position,
+		Rank:       column.Rank,
+		BoardID:    column.BoardID,
+		Name:       column.Name,
+		Color:      column.Color,
+		Category:   column.Category,
+		Version:    column.Version,
+		CreatedAt:  column.CreatedAt,
+		ArchivedAt: column.ArchivedAt,
 	}
 }
 
 func (m *BoardMapper) mapAndSortColumns(columns []*domain.BoardColumn) []*BoardColumnResponse {
 	mapped := make([]*BoardColumnResponse, 0, len(columns))
 	for _, column := range columns {
-		mapped = append(mapped, &BoardColumnResponse{
-			ID:        column.ID,
-			Position:  column.Position,
-			BoardID:   column.BoardID,
-			Name:      column.Name,
-			Color:     column.Color,
-			CreatedAt: column.CreatedAt,
-		})
+		mapped = append(mapped, m.ToBoardColumnResponse(column))
 	}
 
+	// архивные колонки нумеруются отдельно и идут после живых
 	slices.SortFunc(mapped, func(a, b *BoardColumnResponse) int {
-		return cmp.Compare(a.Position, b.Position)
+		return cmp.Or(
+			cmp.Compare(boolRank(a.ArchivedAt != nil), boolRank(b.ArchivedAt != nil)),
+			cmp.Compare(a.Position, b.Position),
+		)
 	})
 
 	return mapped
 }
 
-func (m *BoardMapper) mapAndSortCards(cards []*cardDomain.CardWithComments) []*CardWithComments {
+func boolRank(value bool) int {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+// mapCards сохраняет порядок карточек: его задаёт репозиторий с учётом сортировки представления
+func (m *BoardMapper) mapCards(boardKey string, cards []*cardDomain.CardWithComments) []*CardWithComments {
 	mapped := make([]*CardWithComments, 0, len(cards))
 	for _, card := range cards {
-		mapped = append(mapped, m.mapCardWithComments(card))
+		mapped = append(mapped, m.mapCardWithComments(boardKey, card))
 	}
 
-	slices.SortFunc(mapped, func(a, b *CardWithComments) int {
-		return cmp.Compare(a.Position, b.Position)
-	})
-
 	return mapped
 }
 
-func (m *BoardMapper) mapCardWithComments(card *cardDomain.CardWithComments) *CardWithComments {
+func (m *BoardMapper) mapCardWithComments(boardKey string, card *cardDomain.CardWithComments) *CardWithComments {
 	mapped := &CardWithComments{
-		ID:          card.ID,
-		ColumnID:    card.ColumnID,
-		Position:    card.Position,
-		BoardID:     card.BoardID,
-		Text:        &card.Text,
-		Description: &card.Description,
-		CreatedAt:   card.CreatedAt,
-		Comments:    m.mapAndSortComments(card.Comments),
+		ID:               card.ID,
+		Key:              cardDomain.CardKey(boardKey, card.Number),
+		Number:           card.Number,
+		ColumnID:         card.ColumnID,
+		Position:         card.Position,
+		Rank:             card.Rank,
+		BoardID:          card.BoardID,
+		Text:             &card.Text,
+		Description:      &card.Description,
+		DueDate:          card.DueDate,
+		Blocked:          card.Blocked,
+		ParentID:         card.ParentID,
+		ChildrenByColumn: card.ChildCounts,
+		Version:          card.Version,
+		CreatedAt:        card.CreatedAt,
+		ArchivedAt:       card.ArchivedAt,
+		Comments:         m.mapAndSortComments(card.Comments),
 	}
 
 	m.mapCardProperties(card, mapped)
@@ -177,7 +321,7 @@ func (m *BoardMapper) mapCardWithComments(card *cardDomain.CardWithComments) *Ca
 }
 
 func (m *BoardMapper) mapCardProperties(card *cardDomain.CardWithComments, mapped *CardWithComments) {
-	if card.CardProperties.Color == "" && card.CardProperties.Tag == "" {
+	if card.CardProperties.Color == "" && card.CardProperties.Tag == "" && card.CardProperties.Estimate == 0 {
 		return
 	}
 
@@ -188,6 +332,9 @@ func (m *BoardMapper) mapCardProperties(card *cardDomain.CardWithComments, mappe
 	if card.CardProperties.Tag != "" {
 		mapped.Properties.Tag = &card.CardProperties.Tag
 	}
+	if card.CardProperties.Estimate != 0 {
+		mapped.Properties.Estimate = &card.CardProperties.Estimate
+	}
 }
 
 func (m *BoardMapper) mapAndSortComments(comments []cardDomain.CardComment) []*CardComment {
@@ -197,6 +344,7 @@ func (m *BoardMapper) mapAndSortComments(comments []cardDomain.CardComment) []*C
 			ID:        comment.ID,
 			CardID:    comment.CardID,
 			Text:      comment.Text,
+			Version:   comment.Version,
 			CreatedAt: comment.CreatedAt,
 		})
 	}
@@ -207,3 +355,77 @@ func (m *BoardMapper) mapAndSortComments(comments []cardDomain.CardComment) []*C
 
 	return mapped
 }
+
+func (m *BoardMapper) ToBoardTemplatePublishCommand(req *BoardTemplatePublishRequest) *domain.BoardTemplatePublishCommand {
+	if req == nil {
+		return nil
+	}
+
+	return &domain.BoardTemplatePublishCommand{
+		UserID:      req.UserID,
+		BoardID:     req.BoardID,
+		Name:        req.Name,
+		Description: req.Description,
+		WithCards:   req.WithCards,
+	}
+}
+
+func (m *BoardMapper) ToBoardTemplateInstantiateCommand(
+	req *BoardTemplateInstantiateRequest,
+) *domain.BoardTemplateInstantiateCommand {
+	if req == nil {
+		return nil
+	}
+
+	return &domain.BoardTemplateInstantiateCommand{
+		UserID:     req.UserID,
+		TemplateID: req.TemplateID,
+		Name:       req.Name,
+		Key:        req.Key,
+		WithCards:  req.WithCards,
+	}
+}
+
+func (m *BoardMapper) ToBoardTemplateResponse(template *domain.BoardTemplate) *BoardTemplateResponse {
+	if template == nil {
+		return nil
+	}
+
+	columns := make([]*BoardTemplateColumnResponse, 0, len(template.Content.Columns))
+	for _, column := range template.Content.Columns {
+		cards := make([]*BoardTemplateCardResponse, 0, len(column.Cards))
+		for _, card := range column.Cards {
+			cards = append(cards, &BoardTemplateCardResponse{
+				Text:        card.Text,
+				Description: card.Description,
+				Color:       card.Color,
+				Tag:         card.Tag,
+			})
+		}
+		columns = append(columns, &BoardTemplateColumnResponse{
+			Name:     column.Name,
+			Color:    column.Color,
+			Category: column.Category,
+			Cards:    cards,
+		})
+	}
+	response := &BoardTemplateResponse{
+		ID:          template.ID,
+		Name:        template.Name,
+		Description: template.Description,
+		Builtin:     template.Builtin,
+		Columns:     columns,
+	}
+	if !template.Builtin {
+		response.CreatedAt = &template.CreatedAt
+	}
+	return response
+}
+
+func (m *BoardMapper) ToBoardTemplateListResponse(templates []*domain.BoardTemplate) []*BoardTemplateResponse {
+	response := make([]*BoardTemplateResponse, 0, len(templates))
+	for _, template := range templates {
+		response = append(response, m.ToBoardTemplateResponse(template))
+	}
+	return response
+}
@@ -46,6 +46,21 @@ func Test_ToBoardGetFilter(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with archived",
+			req: &BoardGetFilter{
+				UserID:   1,
+				PerPage:  1,
+				Page:     1,
+				Archived: true,
+			},
+			expected: &domain.BoardGetFilter{
+				UserID:   1,
+				PerPage:  1,
+				Page:     1,
+				Archived: true,
+			},
+		},
 		{
 			name: "only name filter",
 			req: &BoardGetFilter{
@@ -167,6 +182,21 @@ func Test_ToBoard(t *testing.T) {
 				Description: "test",
 			},
 		},
+		{
+			name: "with key",
+			req: &BoardRequest{
+				UserID:      1,
+				Key:         "WEB",
+				Name:        "Web",
+				Description: "test",
+			},
+			expected: &domain.Board{
+				UserID:      1,
+				Key:         "WEB",
+				Name:        "Web",
+				Description: "test",
+			},
+		},
 		{
 			name: "zero values",
 			req: &BoardRequest{
@@ -265,6 +295,23 @@ func Test_ToBoardColumn(t *testing.T) {
 				Color:   "",
 			},
 		},
+		{
+			name: "with category",
+			req: &BoardColumnRequest{
+				ID:       1,
+				BoardID:  "382a14b1-46f0-4df4-975c-e0d62bd6c358",
+				Name:     "Done",
+				Color:    "color",
+				Category: "done",
+			},
+			expected: &domain.BoardColumn{
+				ID:       1,
+				BoardID:  "382a14b1-46f0-4df4-975c-e0d62bd6c358",
+				Name:     "Done",
+				Color:    "color",
+				Category: "done",
+			},
+		},
 		{
 			name: "without color",
 			req: &BoardColumnRequest{
@@ -289,6 +336,156 @@ func Test_ToBoardColumn(t *testing.T) {
 	}
 }
 
+func Test_ToBoardResponse(t *testing.T) {
+	mapper := BoardMapper{}
+	now := time.Now()
+
+	assert.Nil(t, mapper.ToBoardResponse(nil))
+	assert.Equal(t, &BoardResponse{
+		ID:          "382a14b1-46f0-4df4-975c-e0d62bd6c358",
+		Key:         "TEST",
+		Name:        "test",
+		Description: "description",
+		Version:     4,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, mapper.ToBoardResponse(&domain.Board{
+		ID:          "382a14b1-46f0-4df4-975c-e0d62bd6c358",
+		Key:         "TEST",
+		Name:        "test",
+		Description: "description",
+		UserID:      1,
+		Version:     4,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}))
+}
+
+func Test_ToBoardColumnResponse(t *testing.T) {
+	mapper := BoardMapper{}
+	now := time.Now()
+
+	assert.Nil(t, mapper.ToBoardColumnResponse(nil))
+	assert.Equal(t, &BoardColumnResponse{
+		ID:        1,
+		Position:  2,
+		Rank:      "i",
+		BoardID:   "382a14b1-46f0-4df4-975c-e0d62bd6c358",
+		Name:      "test",
+		Color:     "#ffffff",
+		Category:  domain.ColumnCategoryDone,
+		Version:   7,
+		CreatedAt: now,
+	}, mapper.ToBoardColumnResponse(&domain.BoardColumn{
+		ID:        1,
+		Position:  2,
+		Rank:      "i",
+		BoardID:   "382a14b1-46f0-4df4-975c-e0d62bd6c358",
+		Name:      "test",
+		Color:     "#ffffff",
+		Category:  domain.ColumnCategoryDone,
+		Version:   7,
+		CreatedAt: now,
+	}))
+}
+
+func Test_ToCardListFilter(t *testing.T) {
+	mapper := BoardMapper{}
+	sprintID := uint64(1)
+	tests := []struct {
+		name     string
+		req      *BoardDetailsFilter
+		expected *cardDomain.CardListFilter
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name:     "without sprint",
+			req:      &BoardDetailsFilter{},
+			expected: &cardDomain.CardListFilter{},
+		},
+		{
+			name: "with sprint",
+			req: &BoardDetailsFilter{
+				SprintID: &sprintID,
+			},
+			expected: &cardDomain.CardListFilter{
+				SprintID: &sprintID,
+			},
+		},
+		{
+			name: "with archived",
+			req: &BoardDetailsFilter{
+				Archived: true,
+			},
+			expected: &cardDomain.CardListFilter{
+				Archived: true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			actual := mapper.ToCardListFilter(tc.req)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func Test_ToBoardDetailsFilter(t *testing.T) {
+	mapper := BoardMapper{}
+	sprintID := uint64(1)
+	viewID := uint64(2)
+	tests := []struct {
+		name     string
+		req      *BoardDetailsFilter
+		expected *domain.BoardDetailsFilter
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "with view and sprint",
+			req: &BoardDetailsFilter{
+				SprintID: &sprintID,
+				ViewID:   &viewID,
+			},
+			expected: &domain.BoardDetailsFilter{
+				ViewID: &viewID,
+				Cards: &cardDomain.CardListFilter{
+					SprintID: &sprintID,
+				},
+			},
+		},
+		{
+			name: "with archived",
+			req: &BoardDetailsFilter{
+				Archived: true,
+			},
+			expected: &domain.BoardDetailsFilter{
+				Archived: true,
+				Cards: &cardDomain.CardListFilter{
+					Archived: true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			actual := mapper.ToBoardDetailsFilter(tc.req)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
 func Test_ToBoardMoveCommand(t *testing.T) {
 	mapper := BoardMapper{}
 	tests := []struct {
@@ -529,6 +726,7 @@ func Test_ToSingleBoardResponse(t *testing.T) {
 					{
 						ID:        1,
 						Position:  1,
+						Rank:      "i",
 						BoardID:   "93a49b99-a029-4a18-bbbc-c10d91a8c267",
 						Name:      "Test name",
 						Color:     "Test color",
@@ -540,6 +738,7 @@ func Test_ToSingleBoardResponse(t *testing.T) {
 						ID:          1,
 						ColumnID:    1,
 						Position:    1,
+						Rank:        "i",
 						BoardID:     "93a49b99-a029-4a18-bbbc-c10d91a8c267",
 						Text:        testText,
 						Description: testText,
@@ -571,6 +770,7 @@ func Test_ToSingleBoardResponse(t *testing.T) {
 					{
 						ID:        1,
 						Position:  1,
+						Rank:      "i",
 						BoardID:   "93a49b99-a029-4a18-bbbc-c10d91a8c267",
 						Name:      "Test name",
 						Color:     "Test color",
@@ -582,6 +782,7 @@ func Test_ToSingleBoardResponse(t *testing.T) {
 						ID:          1,
 						ColumnID:    1,
 						Position:    1,
+						Rank:        "i",
 						BoardID:     "93a49b99-a029-4a18-bbbc-c10d91a8c267",
 						Text:        &testText,
 						Description: &testText,
@@ -719,3 +920,189 @@ func Test_ToSingleBoardResponse(t *testing.T) {
 		})
 	}
 }
+
+func Test_ToBoardColumnOrderCommand(t *testing.T) {
+	mapper := BoardMapper{}
+	tests := []struct {
+		name     string
+		req      *BoardColumnOrderRequest
+		expected *domain.BoardColumnOrderCommand
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "valid data",
+			req: &BoardColumnOrderRequest{
+				BoardID:   "382a14b1-46f0-4df4-975c-e0d62bd6c358",
+				ColumnIDs: []uint64{3, 1, 2},
+			},
+			expected: &domain.BoardColumnOrderCommand{
+				BoardID:   "382a14b1-46f0-4df4-975c-e0d62bd6c358",
+				ColumnIDs: []uint64{3, 1, 2},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToBoardColumnOrderCommand(tc.req))
+		})
+	}
+}
+
+func Test_ToBoardDuplicateCommand(t *testing.T) {
+	mapper := BoardMapper{}
+	tests := []struct {
+		name     string
+		req      *BoardDuplicateRequest
+		expected *domain.BoardDuplicateCommand
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "valid data",
+			req: &BoardDuplicateRequest{
+				UserID:       1,
+				BoardID:      "382a14b1-46f0-4df4-975c-e0d62bd6c358",
+				Name:         "copy",
+				Key:          "CPY",
+				Depth:        domain.DuplicateCards,
+				WithComments: true,
+			},
+			expected: &domain.BoardDuplicateCommand{
+				UserID:       1,
+				BoardID:      "382a14b1-46f0-4df4-975c-e0d62bd6c358",
+				Name:         "copy",
+				Key:          "CPY",
+				Depth:        domain.DuplicateCards,
+				WithComments: true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToBoardDuplicateCommand(tc.req))
+		})
+	}
+}
+
+func Test_ToBoardTemplateInstantiateCommand(t *testing.T) {
+	mapper := BoardMapper{}
+	tests := []struct {
+		name     string
+		req      *BoardTemplateInstantiateRequest
+		expected *domain.BoardTemplateInstantiateCommand
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "valid data",
+			req: &BoardTemplateInstantiateRequest{
+				UserID:     1,
+				TemplateID: "scrum",
+				Name:       "Team board",
+				Key:        "TEAM",
+				WithCards:  true,
+			},
+			expected: &domain.BoardTemplateInstantiateCommand{
+				UserID:     1,
+				TemplateID: "scrum",
+				Name:       "Team board",
+				Key:        "TEAM",
+				WithCards:  true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToBoardTemplateInstantiateCommand(tc.req))
+		})
+	}
+}
+
+func Test_ToBoardTemplateResponse(t *testing.T) {
+	mapper := BoardMapper{}
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		template *domain.BoardTemplate
+		expected *BoardTemplateResponse
+	}{
+		{
+			name:     "nil pointer",
+			template: nil,
+			expected: nil,
+		},
+		{
+			name: "builtin template without created_at",
+			template: &domain.BoardTemplate{
+				ID:      "kanban",
+				Name:    "Kanban",
+				Builtin: true,
+				Content: domain.BoardTemplateContent{Columns: []domain.TemplateColumn{
+					{Name: "To do", Color: "#2196F3", Category: domain.ColumnCategoryTodo},
+				}},
+				CreatedAt: createdAt,
+			},
+			expected: &BoardTemplateResponse{
+				ID:      "kanban",
+				Name:    "Kanban",
+				Builtin: true,
+				Columns: []*BoardTemplateColumnResponse{
+					{Name: "To do", Color: "#2196F3", Category: domain.ColumnCategoryTodo, Cards: []*BoardTemplateCardResponse{}},
+				},
+			},
+		},
+		{
+			name: "user template with cards",
+			template: &domain.BoardTemplate{
+				ID:          "382a14b1-46f0-4df4-975c-e0d62bd6c358",
+				UserID:      1,
+				Name:        "Release",
+				Description: "release checklist",
+				Content: domain.BoardTemplateContent{Columns: []domain.TemplateColumn{
+					{
+						Name:     "Done",
+						Category: domain.ColumnCategoryDone,
+						Cards:    []domain.TemplateCard{{Text: "Tag release", Tag: "ops"}},
+					},
+				}},
+				CreatedAt: createdAt,
+			},
+			expected: &BoardTemplateResponse{
+				ID:          "382a14b1-46f0-4df4-975c-e0d62bd6c358",
+				Name:        "Release",
+				Description: "release checklist",
+				Columns: []*BoardTemplateColumnResponse{
+					{
+						Name:     "Done",
+						Category: domain.ColumnCategoryDone,
+						Cards:    []*BoardTemplateCardResponse{{Text: "Tag release", Tag: "ops"}},
+					},
+				},
+				CreatedAt: &createdAt,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToBoardTemplateResponse(tc.template))
+		})
+	}
+}
@@ -1,18 +1,33 @@
 package transport
 
-import "time"
+import (
+	"backend/internal/shared/filter"
+	"time"
+)
 
 type BoardRequest struct {
 	UserID      uint64
 	ID          string
+	Key         string `json:"key,omitempty" validate:"omitempty,min=2,max=10,alphanum,uppercase"`
 	Name        string `json:"name" validate:"required,min=2,max=100"`
 	Description string `json:"description" validate:"required,min=2,max=1000"`
+	Version     uint64 `json:"-"`
+}
+
+type BoardDuplicateRequest struct {
+	UserID       uint64 `validate:"required,min=1"`
+	BoardID      string `validate:"required,uuid"`
+	Name         string `json:"name,omitempty" validate:"omitempty,min=2,max=100"`
+	Key          string `json:"key,omitempty" validate:"omitempty,min=2,max=10,alphanum,uppercase"`
+	Depth        string `json:"depth" validate:"required,oneof=columns cards"`
+	WithComments bool   `json:"with_comments"`
 }
 
 type BoardGetFilter struct {
 	UserID       uint64   `validate:"required,min=1"`
 	PerPage      uint64   `json:"per_page" validate:"required,min=1,max=200"`
 	Page         uint64   `json:"page" validate:"required,min=1"`
+	Archived     bool     `json:"archived"`
 	FilterFields *Filters `json:"filters,omitempty"`
 }
 
@@ -21,11 +36,20 @@ type Filters struct {
 	Description *string `json:"description,omitempty" validate:"omitempty,max=255"`
 }
 
+type BoardDetailsFilter struct {
+	SprintID *uint64
+	ViewID   *uint64
+	Query    *filter.Query
+	Archived bool
+}
+
 type BoardColumnRequest struct {
-	ID      uint64
-	BoardID string `json:"board_id" validate:"required,uuid"`
-	Name    string `json:"name" validate:"required,min=2"`
-	Color   string `json:"color" validate:"required,hexcolor"`
+	ID       uint64
+	BoardID  string `json:"board_id" validate:"required,uuid"`
+	Name     string `json:"name" validate:"required,min=2"`
+	Color    string `json:"color" validate:"required,hexcolor"`
+	Category string `json:"category,omitempty" validate:"omitempty,oneof=todo in_progress done"`
+	Version  uint64 `json:"-"`
 }
 
 type BoardColumnMoveRequest struct {
@@ -35,26 +59,58 @@ type BoardColumnMoveRequest struct {
 	ToPosition   uint64 `json:"to_position" validate:"required,min=1"`
 }
 
+type BoardColumnOrderRequest struct {
+	BoardID   string   `validate:"required,uuid"`
+	ColumnIDs []uint64 `json:"column_ids" validate:"required,min=1,max=200,dive,min=1"`
+}
+
 type CardProperties struct {
-	Color *string `json:"color,omitempty"`
-	Tag   *string `json:"tag,omitempty"`
+	Color    *string `json:"color,omitempty"`
+	Tag      *string `json:"tag,omitempty"`
+	Estimate *uint64 `json:"estimate,omitempty"`
 }
 
 type CardWithComments struct {
-	ID          uint64          `json:"id"`
-	ColumnID    uint64          `json:"column_id"`
-	Position    uint64          `json:"position"`
-	BoardID     string          `json:"board_id"`
-	Text        *string         `json:"text"`
-	Description *string         `json:"description"`
-	CreatedAt   time.Time       `json:"created_at"`
-	Properties  *CardProperties `json:"properties,omitempty"`
-	Comments    []*CardComment  `json:"comments"`
+	ID               uint64            `json:"id"`
+	Key              string            `json:"key"`
+	Number           uint64            `json:"number"`
+	ColumnID         uint64            `json:"column_id"`
+	Position         uint64            `json:"position"`
+	Rank             string            `json:"rank"`
+	BoardID          string            `json:"board_id"`
+	Text             *string           `json:"text"`
+	Description      *string           `json:"description"`
+	DueDate          *time.Time        `json:"due_date,omitempty"`
+	Blocked          bool              `json:"blocked"`
+	ParentID         *uint64           `json:"parent_card_id,omitempty"`
+	ChildrenByColumn map[uint64]uint64 `json:"children_by_column,omitempty"`
+	Version          uint64            `json:"version"`
+	CreatedAt        time.Time         `json:"created_at"`
+	ArchivedAt       *time.Time        `json:"archived_at,omitempty"`
+	Properties       *CardProperties   `json:"properties,omitempty"`
+	Comments         []*CardComment    `json:"comments"`
 }
 
 type CardComment struct {
 	ID        uint64    `json:"id"`
 	CardID    uint64    `json:"card_id"`
 	Text      string    `json:"text"`
+	Version   uint64    `json:"version"`
 	CreatedAt time.Time `json:"created_at"`
 }
+
+type BoardTemplatePublishRequest struct {
+	UserID      uint64 `validate:"required,min=1"`
+	BoardID     string `json:"board_id" validate:"required,uuid"`
+	Name        string `json:"name" validate:"required,min=2,max=100"`
+	Description string `json:"description" validate:"max=500"`
+	WithCards   bool   `json:"with_cards"`
+}
+
+type BoardTemplateInstantiateRequest struct {
+	UserID     uint64 `validate:"required,min=1"`
+	TemplateID string `validate:"required,max=64"`
+	Name       string `json:"name,omitempty" validate:"omitempty,min=2,max=100"`
+	Key        string `json:"key,omitempty" validate:"omitempty,min=2,max=10,alphanum,uppercase"`
+	WithCards  bool   `json:"with_cards"`
+}
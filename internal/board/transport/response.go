@@ -3,26 +3,44 @@ package transport
 import "time"
 
 type BoardResponse struct {
-	ID          string    `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          string     `json:"id"`
+	Key         string     `json:"key"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Version     uint64     `json:"version"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
 }
 
 type SingleBoardResponse[T any] struct {
 	*BoardResponse
 	Columns []*BoardColumnResponse `json:"columns"`
 	Cards   []*T                   `json:"cards"`
+	View    *BoardViewResponse     `json:"view,omitempty"`
+}
+
+type BoardViewResponse struct {
+	ID                 uint64   `json:"id"`
+	Name               string   `json:"name"`
+	Query              string   `json:"query"`
+	SortBy             string   `json:"sort_by"`
+	SortOrder          string   `json:"sort_order"`
+	CollapsedColumns   []uint64 `json:"collapsed_columns"`
+	CollapsedSwimlanes []string `json:"collapsed_swimlanes"`
 }
 
 type BoardColumnResponse struct {
-	ID        uint64    `json:"id"`
-	Position  uint64    `json:"position"`
-	BoardID   string    `json:"board_id"`
-	Name      string    `json:"name"`
-	Color     string    `json:"color"`
-	CreatedAt time.Time `json:"created_at"`
+	ID         uint64     `json:"id"`
+	Position   uint64     `json:"position"`
+	Rank       string     `json:"rank"`
+	BoardID    string     `json:"board_id"`
+	Name       string     `json:"name"`
+	Color      string     `json:"color"`
+	Category   string     `json:"category"`
+	Version    uint64     `json:"version"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
 }
 type BoardListResponse struct {
 	Data        []*BoardResponse `json:"data"`
@@ -34,3 +52,26 @@ type BoardListResponse struct {
 	HasNext     bool             `json:"has_next"`
 	HasPrev     bool             `json:"has_prev"`
 }
+
+type BoardTemplateResponse struct {
+	ID          string                         `json:"id"`
+	Name        string                         `json:"name"`
+	Description string                         `json:"description"`
+	Builtin     bool                           `json:"builtin"`
+	Columns     []*BoardTemplateColumnResponse `json:"columns"`
+	CreatedAt   *time.Time                     `json:"created_at,omitempty"`
+}
+
+type BoardTemplateColumnResponse struct {
+	Name     string                       `json:"name"`
+	Color    string                       `json:"color"`
+	Category string                       `json:"category"`
+	Cards    []*BoardTemplateCardResponse `json:"cards"`
+}
+
+type BoardTemplateCardResponse struct {
+	Text        string `json:"text"`
+	Description string `json:"description"`
+	Color       string `json:"color"`
+	Tag         string `json:"tag"`
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+const (
+	MaxRangeDays   = 366
+	FeedPastDays   = 90
+	FeedFutureDays = 365
+)
+
+// CalendarEntry — карточка со сроком в календаре пользователя
+type CalendarEntry struct {
+	CardID         uint64
+	BoardID        string
+	BoardName      string
+	ColumnName     string
+	ColumnCategory string
+	Text           string
+	Description    string
+	DueDate        time.Time
+	UpdatedAt      time.Time
+}
+
+// CalendarQuery — период [From, To] по дням в часовом поясе Location
+type CalendarQuery struct {
+	UserID   uint64
+	From     time.Time
+	To       time.Time
+	Location *time.Location
+}
+
+type CalendarDay struct {
+	Date    string
+	Entries []*CalendarEntry
+}
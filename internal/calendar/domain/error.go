@@ -0,0 +1,10 @@
+package domain
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidRange = errors.New("invalid calendar range")
+	ErrFeedNotFound = errors.New("calendar feed not found")
+)
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+type CalendarRepo interface {
+	GetEntries(ctx context.Context, userID uint64, from, to time.Time) ([]*CalendarEntry, error)
+	GetFeedUserID(ctx context.Context, tokenHash string) (uint64, error)
+	SaveFeedToken(ctx context.Context, userID uint64, tokenHash string) error
+	DeleteFeedToken(ctx context.Context, userID uint64) error
+}
+
+type CalendarService struct {
+	repo CalendarRepo
+}
+
+func NewCalendarService(repo CalendarRepo) *CalendarService {
+	return &CalendarService{
+		repo: repo,
+	}
+}
+
+// GetCalendar возвращает дни периода, на которые приходятся сроки карточек
+func (s *CalendarService) GetCalendar(ctx context.Context, req *CalendarQuery) ([]*CalendarDay, error) {
+	const op = "calendar.service.GetCalendar"
+	location := req.Location
+	if location == nil {
+		location = time.UTC
+	}
+	from := time.Date(req.From.Year(), req.From.Month(), req.From.Day(), 0, 0, 0, 0, location)
+	to := time.Date(req.To.Year(), req.To.Month(), req.To.Day(), 0, 0, 0, 0, location).AddDate(0, 0, 1)
+	if !from.Before(to) || to.Sub(from) > MaxRangeDays*24*time.Hour {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidRange)
+	}
+
+	entries, err := s.repo.GetEntries(ctx, req.UserID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	days := []*CalendarDay{}
+	for _, entry := range entries {
+		date := entry.DueDate.In(location).Format(time.DateOnly)
+		if len(days) == 0 || days[len(days)-1].Date != date {
+			days = append(days, &CalendarDay{Date: date, Entries: []*CalendarEntry{}})
+		}
+		days[len(days)-1].Entries = append(days[len(days)-1].Entries, entry)
+	}
+	return days, nil
+}
+
+// GetFeed возвращает сроки для ICS-ленты по секретному токену
+func (s *CalendarService) GetFeed(ctx context.Context, token string) ([]*CalendarEntry, error) {
+	const op = "calendar.service.GetFeed"
+	userID, err := s.repo.GetFeedUserID(ctx, hashToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := time.Now()
+	entries, err := s.repo.GetEntries(ctx, userID, now.AddDate(0, 0, -FeedPastDays), now.AddDate(0, 0, FeedFutureDays))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return entries, nil
+}
+
+// RotateFeedToken выпускает новый токен ленты; прежний URL перестаёт работать.
+// В базе хранится только хеш, сам токен возвращается один раз.
+func (s *CalendarService) RotateFeedToken(ctx context.Context, userID uint64) (string, error) {
+	const op = "calendar.service.RotateFeedToken"
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	token := hex.EncodeToString(raw)
+	if err := s.repo.SaveFeedToken(ctx, userID, hashToken(token)); err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	return token, nil
+}
+
+func (s *CalendarService) RevokeFeedToken(ctx context.Context, userID uint64) error {
+	const op = "calendar.service.RevokeFeedToken"
+	if err := s.repo.DeleteFeedToken(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
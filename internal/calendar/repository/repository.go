@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"backend/internal/calendar/domain"
+	"backend/internal/shared/utils"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type Storage interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+	Begin() (*sql.Tx, error)
+
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+	GetDB() *sql.DB
+	Close() error
+}
+
+type CalendarRepository struct {
+	storage Storage
+}
+
+func NewCalendarRepository(storage Storage) *CalendarRepository {
+	return &CalendarRepository{
+		storage: storage,
+	}
+}
+
+// GetEntries отдаёт карточки досок пользователя со сроком в [from, to); архивные карточки, колонки и доски не попадают
+func (r *CalendarRepository) GetEntries(
+	ctx context.Context, userID uint64, from, to time.Time,
+) ([]*domain.CalendarEntry, error) {
+	const op = "calendar.repository.GetEntries"
+	query := `
+		SELECT
+				cards.id,
+				cards.board_id,
+				b.name,
+				bc.name,
+				bc.category,
+				cards.text,
+				COALESCE(cards.description, ''),
+				cards.due_date,
+				cards.updated_at
+		FROM cards
+		JOIN board_columns bc ON bc.id = cards.column_id AND bc.deleted_at IS NULL AND bc.archived_at IS NULL
+		JOIN boards b ON b.id = cards.board_id AND b.deleted_at IS NULL AND b.archived_at IS NULL
+		WHERE cards.deleted_at IS NULL AND cards.archived_at IS NULL
+			AND b.user_id = $1
+			AND cards.due_date >= $2 AND cards.due_date < $3
+		ORDER BY cards.due_date, cards.id
+	`
+	rows, err := r.storage.QueryContext(ctx, query, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	entries := []*domain.CalendarEntry{}
+	for rows.Next() {
+		entry := &domain.CalendarEntry{}
+		if err := rows.Scan(
+			&entry.CardID,
+			&entry.BoardID,
+			&entry.BoardName,
+			&entry.ColumnName,
+			&entry.ColumnCategory,
+			&entry.Text,
+			&entry.Description,
+			&entry.DueDate,
+			&entry.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return entries, nil
+}
+
+func (r *CalendarRepository) GetFeedUserID(ctx context.Context, tokenHash string) (uint64, error) {
+	const op = "calendar.repository.GetFeedUserID"
+	var userID uint64
+	query := "SELECT user_id FROM calendar_feed_tokens WHERE token_hash = $1"
+	if err := r.storage.QueryRowContext(ctx, query, tokenHash).Scan(&userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, fmt.Errorf("%s: %w", op, domain.ErrFeedNotFound)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return userID, nil
+}
+
+// SaveFeedToken заменяет токен пользователя, поэтому у него всегда не больше одной ленты
+func (r *CalendarRepository) SaveFeedToken(ctx context.Context, userID uint64, tokenHash string) error {
+	const op = "calendar.repository.SaveFeedToken"
+	query := `
+		INSERT INTO calendar_feed_tokens (user_id, token_hash) VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET token_hash = EXCLUDED.token_hash, created_at = NOW()
+	`
+	return utils.OpExec(ctx, r.storage.ExecContext, op, query, domain.ErrFeedNotFound, userID, tokenHash)
+}
+
+func (r *CalendarRepository) DeleteFeedToken(ctx context.Context, userID uint64) error {
+	const op = "calendar.repository.DeleteFeedToken"
+	query := "DELETE FROM calendar_feed_tokens WHERE user_id = $1"
+	return utils.OpExec(ctx, r.storage.ExecContext, op, query, domain.ErrFeedNotFound, userID)
+}
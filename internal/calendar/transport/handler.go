@@ -0,0 +1,156 @@
+package transport
+
+import (
+	"backend/internal/calendar/domain"
+	"backend/internal/shared/ports/http"
+	"backend/internal/shared/utils"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	UserIDKey   = "userID"
+	TokenKey    = "token"
+	FeedKindKey = "type"
+	FeedPath    = "/api/v1/feeds/calendar/"
+	FeedSuffix  = ".ics"
+)
+
+type CalendarService interface {
+	GetCalendar(ctx context.Context, req *domain.CalendarQuery) ([]*domain.CalendarDay, error)
+	GetFeed(ctx context.Context, token string) ([]*domain.CalendarEntry, error)
+	RotateFeedToken(ctx context.Context, userID uint64) (string, error)
+	RevokeFeedToken(ctx context.Context, userID uint64) error
+}
+
+type CalendarHandler struct {
+	validator      http.Validator
+	service        CalendarService
+	calendarMapper *CalendarMapper
+}
+
+func NewCalendarHandler(validator http.Validator, service CalendarService) *CalendarHandler {
+	return &CalendarHandler{
+		validator:      validator,
+		service:        service,
+		calendarMapper: &CalendarMapper{},
+	}
+}
+
+func (h *CalendarHandler) Get(c *fiber.Ctx) error {
+	const op = "calendar.transport.handler.Get"
+	query, err := utils.ParseQuery[CalendarRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid query parameters"})
+	}
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, query); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	days, err := h.service.GetCalendar(c.Context(), h.calendarMapper.ToCalendarQuery(query))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidRange):
+			return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{"errors": domain.ErrInvalidRange.Error()})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+
+	return c.JSON(h.calendarMapper.ToCalendarResponse(days))
+}
+
+// RotateFeedToken выдаёт новую ссылку на ICS-ленту; токен показывается только в этом ответе
+func (h *CalendarHandler) RotateFeedToken(c *fiber.Ctx) error {
+	const op = "calendar.transport.handler.RotateFeedToken"
+	userID, ok := c.Locals(UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+
+	token, err := h.service.RotateFeedToken(c.Context(), userID)
+	if err != nil {
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(&FeedTokenResponse{
+		Token: token,
+		URL:   c.BaseURL() + FeedPath + token + FeedSuffix,
+	})
+}
+
+func (h *CalendarHandler) RevokeFeedToken(c *fiber.Ctx) error {
+	const op = "calendar.transport.handler.RevokeFeedToken"
+	userID, ok := c.Locals(UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+
+	if err := h.service.RevokeFeedToken(c.Context(), userID); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrFeedNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Calendar feed not found"})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Feed — публичная лента без bearer-токена: доступ определяется секретом в URL
+func (h *CalendarHandler) Feed(c *fiber.Ctx) error {
+	const op = "calendar.transport.handler.Feed"
+	token := strings.TrimSuffix(c.Params(TokenKey), FeedSuffix)
+	if token == "" {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Calendar feed not found"})
+	}
+
+	entries, err := h.service.GetFeed(c.Context(), token)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrFeedNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Calendar feed not found"})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+
+	kind := FeedKindEvent
+	if c.Query(FeedKindKey) == FeedKindTodo {
+		kind = FeedKindTodo
+	}
+	c.Set(fiber.HeaderContentType, "text/calendar; charset=utf-8")
+	c.Set(fiber.HeaderCacheControl, "private, max-age=300")
+	return c.SendString(h.calendarMapper.ToICS(entries, kind, c.Hostname(), time.Now()))
+}
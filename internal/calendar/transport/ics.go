@@ -0,0 +1,84 @@
+package transport
+
+import (
+	"backend/internal/calendar/domain"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	FeedKindEvent = "event"
+	FeedKindTodo  = "todo"
+
+	icsTimeLayout = "20060102T150405Z"
+	icsLineLimit  = 75
+	doneCategory  = "done"
+)
+
+var icsEscaper = strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// ToICS собирает iCalendar (RFC 5545): по VEVENT или VTODO на каждую карточку со сроком
+func (m *CalendarMapper) ToICS(entries []*domain.CalendarEntry, kind, host string, now time.Time) string {
+	var b strings.Builder
+	writeICSLine(&b, "BEGIN:VCALENDAR")
+	writeICSLine(&b, "VERSION:2.0")
+	writeICSLine(&b, "PRODID:-//Kanban Board//Card Deadlines//EN")
+	writeICSLine(&b, "CALSCALE:GREGORIAN")
+	writeICSLine(&b, "METHOD:PUBLISH")
+	writeICSLine(&b, "X-WR-CALNAME:Card deadlines")
+
+	stamp := now.UTC().Format(icsTimeLayout)
+	for _, entry := range entries {
+		component := "VEVENT"
+		if kind == FeedKindTodo {
+			component = "VTODO"
+		}
+		writeICSLine(&b, "BEGIN:"+component)
+		writeICSLine(&b, fmt.Sprintf("UID:card-%d@%s", entry.CardID, host))
+		writeICSLine(&b, "DTSTAMP:"+stamp)
+		writeICSLine(&b, "LAST-MODIFIED:"+entry.UpdatedAt.UTC().Format(icsTimeLayout))
+		due := entry.DueDate.UTC().Format(icsTimeLayout)
+		if kind == FeedKindTodo {
+			writeICSLine(&b, "DUE:"+due)
+			if entry.ColumnCategory == doneCategory {
+				writeICSLine(&b, "STATUS:COMPLETED")
+			} else {
+				writeICSLine(&b, "STATUS:NEEDS-ACTION")
+			}
+		} else {
+			writeICSLine(&b, "DTSTART:"+due)
+			writeICSLine(&b, "TRANSP:TRANSPARENT")
+		}
+		writeICSLine(&b, "SUMMARY:"+icsEscaper.Replace(entry.Text))
+		description := entry.BoardName + " / " + entry.ColumnName
+		if entry.Description != "" {
+			description += "\n\n" + entry.Description
+		}
+		writeICSLine(&b, "DESCRIPTION:"+icsEscaper.Replace(description))
+		writeICSLine(&b, "CATEGORIES:"+icsEscaper.Replace(entry.BoardName))
+		writeICSLine(&b, "END:"+component)
+	}
+
+	writeICSLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+// writeICSLine завершает строку CRLF и переносит её по 75 октетов, не разрывая UTF-8 символы
+func writeICSLine(b *strings.Builder, line string) {
+	limit := icsLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// строка продолжения начинается с пробела, он входит в лимит
+		limit = icsLineLimit - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
@@ -0,0 +1,54 @@
+package transport
+
+import (
+	"backend/internal/calendar/domain"
+	"time"
+)
+
+type CalendarMapper struct{}
+
+// ToCalendarQuery ожидает уже провалидированный запрос
+func (m *CalendarMapper) ToCalendarQuery(req *CalendarRequest) *domain.CalendarQuery {
+	if req == nil {
+		return nil
+	}
+
+	query := &domain.CalendarQuery{
+		UserID:   req.UserID,
+		Location: time.UTC,
+	}
+	if req.TZ != "" {
+		if location, err := time.LoadLocation(req.TZ); err == nil {
+			query.Location = location
+		}
+	}
+	query.From, _ = time.ParseInLocation(time.DateOnly, req.From, query.Location)
+	query.To, _ = time.ParseInLocation(time.DateOnly, req.To, query.Location)
+	return query
+}
+
+func (m *CalendarMapper) ToCalendarResponse(days []*domain.CalendarDay) *CalendarResponse {
+	response := &CalendarResponse{
+		Days: make([]*CalendarDayResponse, 0, len(days)),
+	}
+	for _, day := range days {
+		dayResponse := &CalendarDayResponse{
+			Date:  day.Date,
+			Cards: make([]*CalendarCardResponse, 0, len(day.Entries)),
+		}
+		for _, entry := range day.Entries {
+			dayResponse.Cards = append(dayResponse.Cards, &CalendarCardResponse{
+				ID:             entry.CardID,
+				BoardID:        entry.BoardID,
+				BoardName:      entry.BoardName,
+				ColumnName:     entry.ColumnName,
+				ColumnCategory: entry.ColumnCategory,
+				Text:           entry.Text,
+				Description:    entry.Description,
+				DueDate:        entry.DueDate,
+			})
+		}
+		response.Days = append(response.Days, dayResponse)
+	}
+	return response
+}
@@ -0,0 +1,118 @@
+package transport
+
+import (
+	"backend/internal/calendar/domain"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ToCalendarQuery(t *testing.T) {
+	mapper := CalendarMapper{}
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	tests := []struct {
+		name     string
+		req      *CalendarRequest
+		expected *domain.CalendarQuery
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "default utc",
+			req:  &CalendarRequest{UserID: 1, From: "2025-03-01", To: "2025-03-31"},
+			expected: &domain.CalendarQuery{
+				UserID:   1,
+				From:     time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+				To:       time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+				Location: time.UTC,
+			},
+		},
+		{
+			name: "with time zone",
+			req:  &CalendarRequest{UserID: 1, From: "2025-03-01", To: "2025-03-02", TZ: "Europe/Berlin"},
+			expected: &domain.CalendarQuery{
+				UserID:   1,
+				From:     time.Date(2025, 3, 1, 0, 0, 0, 0, berlin),
+				To:       time.Date(2025, 3, 2, 0, 0, 0, 0, berlin),
+				Location: berlin,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToCalendarQuery(tc.req))
+		})
+	}
+}
+
+func Test_ToCalendarResponse(t *testing.T) {
+	mapper := CalendarMapper{}
+	due := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	days := []*domain.CalendarDay{
+		{
+			Date: "2025-03-01",
+			Entries: []*domain.CalendarEntry{
+				{CardID: 1, BoardID: "b", BoardName: "Board", ColumnName: "Todo", ColumnCategory: "todo", Text: "Release", DueDate: due},
+			},
+		},
+	}
+	expected := &CalendarResponse{
+		Days: []*CalendarDayResponse{
+			{
+				Date: "2025-03-01",
+				Cards: []*CalendarCardResponse{
+					{ID: 1, BoardID: "b", BoardName: "Board", ColumnName: "Todo", ColumnCategory: "todo", Text: "Release", DueDate: due},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, expected, mapper.ToCalendarResponse(days))
+	assert.Equal(t, &CalendarResponse{Days: []*CalendarDayResponse{}}, mapper.ToCalendarResponse(nil))
+}
+
+func Test_ToICS(t *testing.T) {
+	mapper := CalendarMapper{}
+	now := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	entries := []*domain.CalendarEntry{
+		{
+			CardID:         7,
+			BoardName:      "Web",
+			ColumnName:     "Done",
+			ColumnCategory: "done",
+			Text:           "Ship, test; review",
+			Description:    strings.Repeat("очень длинное описание ", 5),
+			DueDate:        time.Date(2025, 3, 2, 9, 30, 0, 0, time.UTC),
+			UpdatedAt:      now,
+		},
+	}
+
+	t.Run("event", func(t *testing.T) {
+		ics := mapper.ToICS(entries, FeedKindEvent, "example.com", now)
+		assert.True(t, strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+		assert.True(t, strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+		assert.Contains(t, ics, "BEGIN:VEVENT\r\n")
+		assert.Contains(t, ics, "UID:card-7@example.com\r\n")
+		assert.Contains(t, ics, "DTSTART:20250302T093000Z\r\n")
+		assert.Contains(t, ics, `SUMMARY:Ship\, test\; review`)
+		for _, line := range strings.Split(strings.TrimSuffix(ics, "\r\n"), "\r\n") {
+			assert.LessOrEqual(t, len(line), icsLineLimit)
+		}
+	})
+
+	t.Run("todo", func(t *testing.T) {
+		ics := mapper.ToICS(entries, FeedKindTodo, "example.com", now)
+		assert.Contains(t, ics, "BEGIN:VTODO\r\n")
+		assert.Contains(t, ics, "DUE:20250302T093000Z\r\n")
+		assert.Contains(t, ics, "STATUS:COMPLETED\r\n")
+		assert.NotContains(t, ics, "VEVENT")
+	})
+}
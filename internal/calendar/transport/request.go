@@ -0,0 +1,8 @@
+package transport
+
+type CalendarRequest struct {
+	UserID uint64 `validate:"required,min=1"`
+	From   string `query:"from" validate:"required,datetime=2006-01-02"`
+	To     string `query:"to" validate:"required,datetime=2006-01-02"`
+	TZ     string `query:"tz" validate:"omitempty,timezone"`
+}
@@ -0,0 +1,28 @@
+package transport
+
+import "time"
+
+type CalendarResponse struct {
+	Days []*CalendarDayResponse `json:"days"`
+}
+
+type CalendarDayResponse struct {
+	Date  string                  `json:"date"`
+	Cards []*CalendarCardResponse `json:"cards"`
+}
+
+type CalendarCardResponse struct {
+	ID             uint64    `json:"id"`
+	BoardID        string    `json:"board_id"`
+	BoardName      string    `json:"board_name"`
+	ColumnName     string    `json:"column_name"`
+	ColumnCategory string    `json:"column_category"`
+	Text           string    `json:"text"`
+	Description    string    `json:"description"`
+	DueDate        time.Time `json:"due_date"`
+}
+
+type FeedTokenResponse struct {
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
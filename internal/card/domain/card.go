@@ -1,44 +1,101 @@
 package domain
 
 import (
+	"backend/internal/shared/filter"
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
 type Card struct {
 	ID          uint64
+	Number      uint64
 	ColumnID    uint64
 	Position    uint64
+	Rank        string
 	BoardID     string
 	Text        string
 	Description string
+	CreatedBy   uint64
+	DueDate     *time.Time
+	ParentID    *uint64
+	// Version — версия записи; в командах обновления это ожидаемая версия из If-Match, 0 — без проверки
+	Version uint64
 	CardProperties
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	ArchivedAt *time.Time
+	DeletedAt  *time.Time
 }
 
 type CardWithComments struct {
 	ID          uint64
+	Number      uint64
 	ColumnID    uint64
 	Position    uint64
+	Rank        string
 	BoardID     string
 	Text        string
 	Description string
+	DueDate     *time.Time
+	Blocked     bool
+	ParentID    *uint64
+	// ChildCounts — количество живых дочерних карточек по колонкам
+	ChildCounts map[uint64]uint64
+	Version     uint64
 	CardProperties
-	Comments  []CardComment
-	CreatedAt time.Time
+	Comments   []CardComment
+	CreatedAt  time.Time
+	ArchivedAt *time.Time
 }
 
 type CardComment struct {
 	ID        uint64
 	CardID    uint64
 	Text      string
+	Version   uint64
 	CreatedAt time.Time
 }
 
+// CardKey собирает человекочитаемый ключ карточки из ключа доски и номера: WEB-42
+func CardKey(boardKey string, number uint64) string {
+	if boardKey == "" || number == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s-%d", boardKey, number)
+}
+
+// ParseCardKey разбирает ключ вида WEB-42; ключ доски приводится к верхнему регистру
+func ParseCardKey(key string) (string, uint64, error) {
+	separator := strings.LastIndex(key, "-")
+	if separator < 1 {
+		return "", 0, ErrInvalidCardKey
+	}
+	number, err := strconv.ParseUint(key[separator+1:], 10, 64)
+	if err != nil || number == 0 {
+		return "", 0, ErrInvalidCardKey
+	}
+	return strings.ToUpper(key[:separator]), number, nil
+}
+
+// MaxCardDepth — предельная глубина вложенности подзадач, корневая карточка имеет глубину 0
+const MaxCardDepth = 3
+
+type CardParentCommand struct {
+	BoardID  string
+	ParentID uint64
+	ChildID  uint64
+}
+
+// CardTreeNode — карточка поддерева подзадач
+type CardTreeNode struct {
+	Card
+	Children []*CardTreeNode
+}
+
 type CardMoveCommand struct {
 	ID           uint64
 	FromColumnID uint64
@@ -48,9 +105,195 @@ type CardMoveCommand struct {
 	BoardID      string
 }
 
+const (
+	TransferMove = "move"
+	TransferCopy = "copy"
+)
+
+// Действия журнала доски для переноса и копирования карточек между досками
+const (
+	AuditCardMovedOut  = "card_moved_out"
+	AuditCardMovedIn   = "card_moved_in"
+	AuditCardCopiedOut = "card_copied_out"
+	AuditCardCopiedIn  = "card_copied_in"
+)
+
+// CardTransferCommand — перенос (move) или копия (copy) карточки в колонку другой доски пользователя
+type CardTransferCommand struct {
+	UserID         uint64
+	BoardID        string
+	CardID         uint64
+	Mode           string
+	TargetBoardID  string
+	TargetColumnID uint64
+	// WithComments учитывается только при копировании: при переносе комментарии всегда остаются у карточки
+	WithComments bool
+}
+
+const (
+	BulkOpMove          = "move"
+	BulkOpSetProperties = "set_properties"
+	BulkOpDelete        = "delete"
+)
+
+// CardBulkCommand — пакет операций над карточками доски; DryRun проверяет пакет без сохранения
+type CardBulkCommand struct {
+	BoardID    string
+	DryRun     bool
+	Operations []*CardBulkOperation
+}
+
+// CardBulkOperation — операция пакета: move ставит карточку в конец колонки ColumnID,
+// set_properties меняет заданные поля Properties, delete удаляет карточку
+type CardBulkOperation struct {
+	Op         string
+	CardID     uint64
+	ColumnID   uint64
+	Properties *CardPropertiesPatch
+}
+
+// CardPropertiesPatch — свойства карточки, nil оставляет поле без изменений
+type CardPropertiesPatch struct {
+	Color    *string `json:"color,omitempty"`
+	Tag      *string `json:"tag,omitempty"`
+	Estimate *uint64 `json:"estimate,omitempty"`
+}
+
+// CardBulkItemResult — результат операции пакета; FromColumnID и Changed заполняются
+// у применённых move и set_properties для событий после сохранения
+type CardBulkItemResult struct {
+	CardID       uint64
+	Op           string
+	Err          error
+	FromColumnID uint64
+	Changed      []string
+}
+
+// CardBulkResult — результаты по операциям в порядке пакета; Applied — пакет сохранён
+type CardBulkResult struct {
+	DryRun  bool
+	Applied bool
+	Items   []*CardBulkItemResult
+}
+
+func (r *CardBulkResult) Failed() bool {
+	for _, item := range r.Items {
+		if item.Err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	SortByPosition  = "position"
+	SortByCreatedAt = "created_at"
+	SortByUpdatedAt = "updated_at"
+	SortByEstimate  = "estimate"
+
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+// CardListFilter — Archived добавляет архивные карточки и карточки архивных колонок
+type CardListFilter struct {
+	SprintID  *uint64
+	Query     *filter.Query
+	SortBy    string
+	SortOrder string
+	Archived  bool
+}
+
+// CardSearchQuery — Archived добавляет архивные карточки и карточки архивных колонок и досок
+type CardSearchQuery struct {
+	UserID   uint64
+	Query    *filter.Query
+	Page     uint64
+	PerPage  uint64
+	Archived bool
+}
+
+// CardListItem — карточка вместе с названиями доски и колонки для списков вне доски
+type CardListItem struct {
+	Card
+	BoardKey       string
+	BoardName      string
+	ColumnName     string
+	ColumnCategory string
+}
+
+type CardSearchResult struct {
+	Data        []*CardListItem
+	TotalCount  uint64
+	TotalPages  uint64
+	CurrentPage uint64
+	PerPage     uint64
+	HasNext     bool
+	HasPrev     bool
+}
+
+const (
+	GroupByBoard   = "board"
+	GroupByDueDate = "due_date"
+)
+
+// CardCursor — позиция keyset-пагинации: значение поля сортировки и id последней карточки
+type CardCursor struct {
+	Value time.Time
+	ID    uint64
+}
+
+// MyCardsQuery — карточки, созданные пользователем или прокомментированные им;
+// Archived добавляет архивные карточки и карточки архивных колонок и досок
+type MyCardsQuery struct {
+	UserID    uint64
+	SortBy    string
+	SortOrder string
+	Cursor    *CardCursor
+	Limit     uint64
+	Archived  bool
+}
+
+type MyCardsResult struct {
+	Data       []*CardListItem
+	NextCursor *CardCursor
+}
+
+// CardFilterFields — поля языка фильтрации карточек, см. filter.Parse
+var CardFilterFields = filter.Fields{
+	"text":        {Kind: filter.KindText},
+	"description": {Kind: filter.KindText},
+	"column":      {Kind: filter.KindExact},
+	"board":       {Kind: filter.KindExact},
+	"category":    {Kind: filter.KindEnum, Values: []string{"todo", "in_progress", "done"}},
+	"tag":         {Kind: filter.KindExact},
+	"color":       {Kind: filter.KindColor},
+	"estimate":    {Kind: filter.KindNumber},
+	"sprint":      {Kind: filter.KindNumber, MatchOnly: true},
+	"created":     {Kind: filter.KindDate},
+	"updated":     {Kind: filter.KindDate},
+	"due":         {Kind: filter.KindDate},
+}
+
 type CardProperties struct {
-	Color string `json:"color,omitempty"`
-	Tag   string `json:"tag,omitempty"`
+	Color    string `json:"color,omitempty"`
+	Tag      string `json:"tag,omitempty"`
+	Estimate uint64 `json:"estimate,omitempty"`
+}
+
+// ChangedProperties возвращает имена свойств, которые отличаются в after
+func ChangedProperties(before, after CardProperties) []string {
+	var changed []string
+	if before.Color != after.Color {
+		changed = append(changed, "color")
+	}
+	if before.Tag != after.Tag {
+		changed = append(changed, "tag")
+	}
+	if before.Estimate != after.Estimate {
+		changed = append(changed, "estimate")
+	}
+	return changed
 }
 
 func (cp *CardProperties) Scan(value interface{}) error {
@@ -71,7 +314,7 @@ func (cp *CardProperties) Scan(value interface{}) error {
 }
 
 func (cp CardProperties) Value() (driver.Value, error) {
-	if cp.Color == "" && cp.Tag == "" {
+	if cp.Color == "" && cp.Tag == "" && cp.Estimate == 0 {
 		return "{}", nil
 	}
 	return json.Marshal(cp)
@@ -8,4 +8,21 @@ var (
 	ErrCardAlreadyExists = errors.New("card already exists")
 	ErrCardNotFound      = errors.New("card not found")
 	ErrColumnNotExist    = errors.New("column not exists")
+	ErrCardBlocked       = errors.New("card has open blockers")
+	ErrInvalidParent     = errors.New("card cannot be its own parent")
+	ErrParentCycle       = errors.New("card cannot be attached to its own descendant")
+	ErrMaxDepthExceeded  = errors.New("subtask depth limit exceeded")
+	ErrInvalidCardKey    = errors.New("invalid card key")
+	ErrBoardNotEditable  = errors.New("board not found or not editable")
+	ErrSameBoardMove     = errors.New("card is already on this board")
+	ErrCardArchived      = errors.New("card is archived")
+	ErrCardNotArchived   = errors.New("card is not archived")
+
+	ErrCardTemplateNotFound  = errors.New("card template not found")
+	ErrCardTemplateNameTaken = errors.New("card template name is already taken")
+
+	ErrRecurrenceNotFound    = errors.New("recurrence not found")
+	ErrInvalidRecurrenceRule = errors.New("unsupported recurrence rule")
+
+	ErrInvalidBulkOperation = errors.New("invalid bulk operation")
 )
@@ -19,6 +19,9 @@ func NewCardBoardEventHandler(repo CardRepo) *CardBoardEventHandler {
 func (h *CardBoardEventHandler) Handle(ctx context.Context, event events.Event) error {
 	switch e := event.(type) {
 	case events.BoardDeletedEvent:
+		if e.Cascade {
+			return nil
+		}
 		exists, err := h.repo.CardExistsInBoard(ctx, e.BoardID)
 		if err != nil {
 			return err
@@ -27,6 +30,9 @@ func (h *CardBoardEventHandler) Handle(ctx context.Context, event events.Event)
 			return errors.ErrBoardHasCards
 		}
 	case events.ColumnDeletedEvent:
+		if e.Cascade {
+			return nil
+		}
 		exists, err := h.repo.CardExistsInColumn(ctx, e.ColumnID)
 		if err != nil {
 			return err
@@ -34,6 +40,14 @@ func (h *CardBoardEventHandler) Handle(ctx context.Context, event events.Event)
 		if exists {
 			return errors.ErrColumnHasCards
 		}
+	case events.CardDeletedEvent:
+		exists, err := h.repo.CardHasChildren(ctx, e.CardID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return errors.ErrCardHasChildren
+		}
 	}
 	return nil
 }
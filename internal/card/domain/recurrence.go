@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FrequencyDaily   = "DAILY"
+	FrequencyWeekly  = "WEEKLY"
+	FrequencyMonthly = "MONTHLY"
+
+	// maxRecurrenceScanDays ограничивает поиск следующего повторения:
+	// самый редкий случай — BYMONTHDAY=31, между такими днями не больше двух месяцев
+	maxRecurrenceScanDays = 400
+	// recurrenceBatchSize — сколько наступивших правил обрабатывается за один проход воркера
+	recurrenceBatchSize = 100
+	// maxRecurrenceCatchUp — сколько пропущенных повторений одного правила догоняется за раз
+	maxRecurrenceCatchUp = 100
+)
+
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// CardRecurrence — заготовка карточки, которая создаётся в колонке ColumnID при каждом повторении Rule.
+// Время повторений берётся из StartsAt, NextRunAt — ближайшее ещё не созданное повторение.
+type CardRecurrence struct {
+	ID          uint64
+	BoardID     string
+	ColumnID    uint64
+	CreatedBy   uint64
+	Text        string
+	Description string
+	CardProperties
+	Rule      string
+	StartsAt  time.Time
+	NextRunAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// RecurrenceRule — поддерживаемое подмножество RRULE:
+// FREQ=DAILY, FREQ=WEEKLY;BYDAY=MO,WE и FREQ=MONTHLY;BYMONTHDAY=15
+type RecurrenceRule struct {
+	Frequency string
+	Weekdays  []time.Weekday
+	MonthDay  int
+}
+
+// ParseRecurrenceRule разбирает правило; без BYDAY и BYMONTHDAY день берётся из начала повторений
+func ParseRecurrenceRule(value string, start time.Time) (*RecurrenceRule, error) {
+	rule := &RecurrenceRule{}
+	start = start.UTC()
+	value = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(value)), "RRULE:")
+	for _, part := range strings.Split(value, ";") {
+		name, param, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, ErrInvalidRecurrenceRule
+		}
+		switch name {
+		case "FREQ":
+			if param != FrequencyDaily && param != FrequencyWeekly && param != FrequencyMonthly {
+				return nil, ErrInvalidRecurrenceRule
+			}
+			rule.Frequency = param
+		case "BYDAY":
+			for _, code := range strings.Split(param, ",") {
+				weekday, ok := weekdayCodes[code]
+				if !ok {
+					return nil, ErrInvalidRecurrenceRule
+				}
+				if !slices.Contains(rule.Weekdays, weekday) {
+					rule.Weekdays = append(rule.Weekdays, weekday)
+				}
+			}
+		case "BYMONTHDAY":
+			day, err := strconv.Atoi(param)
+			if err != nil || day < 1 || day > 31 {
+				return nil, ErrInvalidRecurrenceRule
+			}
+			rule.MonthDay = day
+		default:
+			return nil, ErrInvalidRecurrenceRule
+		}
+	}
+
+	switch rule.Frequency {
+	case FrequencyDaily:
+		if len(rule.Weekdays) > 0 || rule.MonthDay != 0 {
+			return nil, ErrInvalidRecurrenceRule
+		}
+	case FrequencyWeekly:
+		if rule.MonthDay != 0 {
+			return nil, ErrInvalidRecurrenceRule
+		}
+		if len(rule.Weekdays) == 0 {
+			rule.Weekdays = []time.Weekday{start.Weekday()}
+		}
+		slices.Sort(rule.Weekdays)
+	case FrequencyMonthly:
+		if len(rule.Weekdays) > 0 {
+			return nil, ErrInvalidRecurrenceRule
+		}
+		if rule.MonthDay == 0 {
+			rule.MonthDay = start.Day()
+		}
+	default:
+		return nil, ErrInvalidRecurrenceRule
+	}
+	return rule, nil
+}
+
+// String возвращает правило в каноническом виде, в котором оно хранится
+func (r *RecurrenceRule) String() string {
+	switch r.Frequency {
+	case FrequencyWeekly:
+		codes := make([]string, 0, len(r.Weekdays))
+		for _, weekday := range r.Weekdays {
+			for code, day := range weekdayCodes {
+				if day == weekday {
+					codes = append(codes, code)
+				}
+			}
+		}
+		return "FREQ=WEEKLY;BYDAY=" + strings.Join(codes, ",")
+	case FrequencyMonthly:
+		return "FREQ=MONTHLY;BYMONTHDAY=" + strconv.Itoa(r.MonthDay)
+	}
+	return "FREQ=" + r.Frequency
+}
+
+// Next возвращает первое повторение строго после after, но не раньше start.
+// Повторения приходятся на время суток start по UTC, чтобы не зависеть от пояса сервера.
+func (r *RecurrenceRule) Next(start, after time.Time) time.Time {
+	start = start.UTC()
+	from := after.UTC()
+	if from.Before(start) {
+		from = start
+	}
+	day := time.Date(from.Year(), from.Month(), from.Day(), start.Hour(), start.Minute(), start.Second(), 0, time.UTC)
+	for i := range maxRecurrenceScanDays {
+		candidate := day.AddDate(0, 0, i)
+		if candidate.Before(start) || !candidate.After(after) {
+			continue
+		}
+		if r.matches(candidate) {
+			return candidate
+		}
+	}
+	return time.Time{}
+}
+
+// Due возвращает повторения от next до now включительно, не больше limit самых ранних
+func (r *RecurrenceRule) Due(start, next, now time.Time, limit int) []time.Time {
+	var due []time.Time
+	for at := next; !at.IsZero() && !at.After(now) && len(due) < limit; at = r.Next(start, at) {
+		due = append(due, at)
+	}
+	return due
+}
+
+func (r *RecurrenceRule) matches(day time.Time) bool {
+	switch r.Frequency {
+	case FrequencyWeekly:
+		return slices.Contains(r.Weekdays, day.Weekday())
+	case FrequencyMonthly:
+		return day.Day() == r.MonthDay
+	}
+	return true
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseRecurrenceRule(t *testing.T) {
+	// понедельник, 15-е число
+	start := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		value     string
+		canonical string
+		err       error
+	}{
+		{name: "daily", value: "FREQ=DAILY", canonical: "FREQ=DAILY"},
+		{name: "rrule prefix and lower case", value: "rrule:freq=daily", canonical: "FREQ=DAILY"},
+		{name: "weekly on given days", value: "FREQ=WEEKLY;BYDAY=FR,MO,FR", canonical: "FREQ=WEEKLY;BYDAY=MO,FR"},
+		{name: "weekly defaults to start weekday", value: "FREQ=WEEKLY", canonical: "FREQ=WEEKLY;BYDAY=MO"},
+		{name: "monthly on given day", value: "FREQ=MONTHLY;BYMONTHDAY=31", canonical: "FREQ=MONTHLY;BYMONTHDAY=31"},
+		{name: "monthly defaults to start day", value: "FREQ=MONTHLY", canonical: "FREQ=MONTHLY;BYMONTHDAY=15"},
+		{name: "yearly", value: "FREQ=YEARLY", err: ErrInvalidRecurrenceRule},
+		{name: "interval", value: "FREQ=DAILY;INTERVAL=2", err: ErrInvalidRecurrenceRule},
+		{name: "unknown weekday", value: "FREQ=WEEKLY;BYDAY=XX", err: ErrInvalidRecurrenceRule},
+		{name: "month day out of range", value: "FREQ=MONTHLY;BYMONTHDAY=32", err: ErrInvalidRecurrenceRule},
+		{name: "weekday on monthly", value: "FREQ=MONTHLY;BYDAY=MO", err: ErrInvalidRecurrenceRule},
+		{name: "missing frequency", value: "BYDAY=MO", err: ErrInvalidRecurrenceRule},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseRecurrenceRule(tt.value, start)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.canonical, rule.String())
+		})
+	}
+}
+
+func Test_RecurrenceRuleNext(t *testing.T) {
+	start := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		rule     string
+		after    time.Time
+		expected time.Time
+	}{
+		{
+			name:     "first occurrence is start itself",
+			rule:     "FREQ=DAILY",
+			after:    start.Add(-time.Second),
+			expected: start,
+		},
+		{
+			name:     "daily later the same day moves to tomorrow",
+			rule:     "FREQ=DAILY",
+			after:    time.Date(2024, 1, 20, 10, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, 1, 21, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "weekly picks the next listed weekday",
+			rule:     "FREQ=WEEKLY;BYDAY=MO,TH",
+			after:    start,
+			expected: time.Date(2024, 1, 18, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "monthly skips months without the day",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=31",
+			after:    time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, 3, 31, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "other time zone of after does not shift the time of day",
+			rule:     "FREQ=DAILY",
+			after:    time.Date(2024, 1, 20, 8, 0, 0, 0, time.FixedZone("UTC+3", 3*60*60)),
+			expected: time.Date(2024, 1, 20, 9, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseRecurrenceRule(tt.rule, start)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rule.Next(start, tt.after))
+		})
+	}
+}
+
+func Test_RecurrenceRuleDue(t *testing.T) {
+	start := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	rule, err := ParseRecurrenceRule("FREQ=DAILY", start)
+	require.NoError(t, err)
+	now := time.Date(2024, 1, 18, 12, 0, 0, 0, time.UTC)
+
+	due := rule.Due(start, start, now, 10)
+	require.Len(t, due, 4)
+	assert.Equal(t, start, due[0])
+	assert.Equal(t, time.Date(2024, 1, 18, 9, 0, 0, 0, time.UTC), due[3])
+
+	assert.Len(t, rule.Due(start, start, now, 2), 2)
+	assert.Empty(t, rule.Due(start, rule.Next(start, now), now, 10))
+}
@@ -2,37 +2,81 @@ package domain
 
 import (
 	"backend/internal/shared/domain/events"
+	sharedErrors "backend/internal/shared/errors"
+	queryFilter "backend/internal/shared/filter"
+	"backend/internal/shared/rank"
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"time"
 )
 
 type CardGetter interface {
-	GetListWithComments(ctx context.Context, boardID string) ([]*CardWithComments, error)
+	GetListWithComments(ctx context.Context, boardID string, filter *CardListFilter) ([]*CardWithComments, error)
 	GetMaxColumnPosition(ctx context.Context, boardUUID string, columnID uint64) (uint64, error)
 	GetById(ctx context.Context, card *Card) (*Card, error)
+	Search(ctx context.Context, query *CardSearchQuery) ([]*CardListItem, uint64, error)
+	GetMyCards(ctx context.Context, query *MyCardsQuery) ([]*CardListItem, error)
+	GetAncestorIDs(ctx context.Context, cardID uint64) ([]uint64, error)
+	GetSubtree(ctx context.Context, boardID string, cardID uint64) ([]*Card, error)
+	GetByKey(ctx context.Context, userID uint64, boardKey string, number uint64, archived bool) (*CardListItem, error)
+	GetListItem(ctx context.Context, userID uint64, boardID string, cardID uint64, archived bool) (*CardListItem, error)
+	GetColumnsToRebalance(ctx context.Context, maxLength int) ([]uint64, error)
 }
 
 type CardCreator interface {
 	Create(context.Context, *Card) error
 	Exists(ctx context.Context, card *Card) (bool, error)
+	IsBlockedForColumn(ctx context.Context, cardID, columnID uint64) (bool, error)
+	IsBoardEditable(ctx context.Context, userID uint64, boardID string) (bool, error)
 	CardExistsInBoard(ctx context.Context, boardID string) (bool, error)
 	CardExistsInColumn(ctx context.Context, columnID uint64) (bool, error)
+	CardHasChildren(ctx context.Context, cardID uint64) (bool, error)
 }
 
 type CardUpdater interface {
 	Update(context.Context, *Card) error
-	MoveToNewPosition(ctx context.Context, boardID string, cardID, fromColumnID, toColumnID, cardFromPosition, cardToPosition uint64) error
+	MoveToNewPosition(ctx context.Context, boardID string, cardID, toColumnID, position uint64) error
+	Transfer(ctx context.Context, cmd *CardTransferCommand) (uint64, error)
+	ApplyBulk(ctx context.Context, boardID string, ops []*CardBulkOperation, dryRun bool) ([]*CardBulkItemResult, error)
+	RebalanceColumn(ctx context.Context, columnID uint64) error
+	SetParent(ctx context.Context, boardID string, cardID, parentID uint64) error
+	RemoveParent(ctx context.Context, boardID string, cardID, parentID uint64) error
+	Archive(ctx context.Context, card *Card) error
+	Restore(ctx context.Context, card *Card) error
 }
 
 type CardDeleter interface {
 	Delete(context.Context, *Card) error
 }
 
+type CardTemplateRepo interface {
+	GetTemplateList(ctx context.Context, boardID string) ([]*CardTemplate, error)
+	GetTemplate(ctx context.Context, boardID string, id uint64) (*CardTemplate, error)
+	CreateTemplate(ctx context.Context, template *CardTemplate) error
+	UpdateTemplate(ctx context.Context, template *CardTemplate) error
+	DeleteTemplate(ctx context.Context, boardID string, id uint64) error
+}
+
+type CardRecurrenceRepo interface {
+	GetRecurrenceList(ctx context.Context, boardID string) ([]*CardRecurrence, error)
+	GetDueRecurrences(ctx context.Context, now time.Time, limit int) ([]*CardRecurrence, error)
+	CreateRecurrence(ctx context.Context, recurrence *CardRecurrence) error
+	UpdateRecurrence(ctx context.Context, recurrence *CardRecurrence) error
+	DeleteRecurrence(ctx context.Context, boardID string, id uint64) error
+	MaterializeRecurrence(ctx context.Context, recurrence *CardRecurrence, next time.Time, cards []*Card) (bool, error)
+}
+
 type CardRepo interface {
 	CardGetter
 	CardCreator
 	CardUpdater
 	CardDeleter
+	CardTemplateRepo
+	CardRecurrenceRepo
 }
 
 type BoardRepo interface {
@@ -53,10 +97,18 @@ func NewCardService(repo CardRepo, boardRepo BoardRepo, bus events.EventDispatch
 	}
 }
 
-func (s *CardService) GetListWithComments(ctx context.Context, boardID string) ([]*CardWithComments, error) {
+func (s *CardService) GetListWithComments(
+	ctx context.Context, boardID string, filter *CardListFilter,
+) ([]*CardWithComments, error) {
 	const op = "card.service.GetListWithComments"
 
-	raws, err := s.repo.GetListWithComments(ctx, boardID)
+	if filter != nil {
+		if err := queryFilter.Validate(filter.Query, CardFilterFields, time.Now()); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	raws, err := s.repo.GetListWithComments(ctx, boardID, filter)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -64,29 +116,113 @@ func (s *CardService) GetListWithComments(ctx context.Context, boardID string) (
 	return raws, nil
 }
 
-func (s *CardService) Create(ctx context.Context, req *Card) error {
-	const op = "card.service.Create"
+func (s *CardService) Search(ctx context.Context, query *CardSearchQuery) (*CardSearchResult, error) {
+	const op = "card.service.Search"
 
-	maxPosition, err := s.repo.GetMaxColumnPosition(ctx, req.BoardID, req.ColumnID)
+	if err := queryFilter.Validate(query.Query, CardFilterFields, time.Now()); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cards, totalCount, err := s.repo.Search(ctx, query)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	totalPages := uint64(math.Ceil(float64(totalCount) / float64(query.PerPage)))
+	return &CardSearchResult{
+		Data:        cards,
+		TotalCount:  totalCount,
+		TotalPages:  totalPages,
+		CurrentPage: query.Page,
+		PerPage:     query.PerPage,
+		HasNext:     query.Page < totalPages,
+		HasPrev:     query.Page > 1,
+	}, nil
+}
+
+func (s *CardService) GetMyCards(ctx context.Context, req *MyCardsQuery) (*MyCardsResult, error) {
+	const op = "card.service.GetMyCards"
+	query := &MyCardsQuery{
+		UserID:    req.UserID,
+		SortBy:    req.SortBy,
+		SortOrder: req.SortOrder,
+		Cursor:    req.Cursor,
+		Limit:     req.Limit + 1,
+		Archived:  req.Archived,
+	}
+	if query.SortBy == "" {
+		query.SortBy = SortByUpdatedAt
+	}
+	if query.SortOrder == "" {
+		query.SortOrder = SortOrderDesc
+	}
+
+	cards, err := s.repo.GetMyCards(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := &MyCardsResult{Data: cards}
+	if uint64(len(cards)) > req.Limit {
+		result.Data = cards[:req.Limit]
+		last := result.Data[len(result.Data)-1]
+		result.NextCursor = &CardCursor{ID: last.ID, Value: last.UpdatedAt}
+		if query.SortBy == SortByCreatedAt {
+			result.NextCursor.Value = last.CreatedAt
+		}
+	}
+	return result, nil
+}
+
+func (s *CardService) GetByKey(ctx context.Context, userID uint64, key string, archived bool) (*CardListItem, error) {
+	const op = "card.service.GetByKey"
+	boardKey, number, err := ParseCardKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	card, err := s.repo.GetByKey(ctx, userID, boardKey, number, archived)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return card, nil
+}
+
+// Get отдаёт карточку доски; архивные карточки и карточки архивных колонок и досок — только при archived
+func (s *CardService) Get(ctx context.Context, userID uint64, req *Card, archived bool) (*CardListItem, error) {
+	const op = "card.service.Get"
+	card, err := s.repo.GetListItem(ctx, userID, req.BoardID, req.ID, archived)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return card, nil
+}
+
+func (s *CardService) Create(ctx context.Context, req *Card) error {
+	const op = "card.service.Create"
+
 	card := &Card{
 		BoardID:     req.BoardID,
 		ColumnID:    req.ColumnID,
 		Text:        req.Text,
-		Position:    maxPosition + 1,
 		Description: req.Description,
+		CreatedBy:   req.CreatedBy,
+		DueDate:     req.DueDate,
 		CardProperties: CardProperties{
-			Color: req.Color,
-			Tag:   req.Tag,
+			Color:    req.Color,
+			Tag:      req.Tag,
+			Estimate: req.Estimate,
 		},
 	}
 
 	if err := s.repo.Create(ctx, card); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
+	req.ID = card.ID
+	s.publish(ctx, op, events.CardCreatedEvent{
+		CardID:   card.ID,
+		BoardID:  card.BoardID,
+		ColumnID: card.ColumnID,
+	})
 
 	return nil
 }
@@ -99,22 +235,32 @@ func (s *CardService) Update(ctx context.Context, req *Card) error {
 		BoardID:     req.BoardID,
 		Text:        req.Text,
 		Description: req.Description,
+		DueDate:     req.DueDate,
+		Version:     req.Version,
 		CardProperties: CardProperties{
-			Color: req.Color,
-			Tag:   req.Tag,
+			Color:    req.Color,
+			Tag:      req.Tag,
+			Estimate: req.Estimate,
 		},
 	}
 
-	exists, err := s.repo.Exists(ctx, card)
+	current, err := s.repo.GetById(ctx, card)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
-	if !exists {
+	if current.BoardID != card.BoardID {
 		return fmt.Errorf("%s: %w", op, ErrCardNotFound)
 	}
 	if err := s.repo.Update(ctx, card); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
+	if changed := ChangedProperties(current.CardProperties, card.CardProperties); len(changed) > 0 {
+		s.publish(ctx, op, events.CardPropertiesChangedEvent{
+			CardID:  card.ID,
+			BoardID: card.BoardID,
+			Changed: changed,
+		})
+	}
 
 	return nil
 }
@@ -149,17 +295,59 @@ func (s *CardService) Delete(ctx context.Context, req *Card) error {
 	return nil
 }
 
+// Archive убирает карточку с доски в архив; в отличие от удаления комментарии карточке не мешают
+func (s *CardService) Archive(ctx context.Context, userID uint64, req *Card) error {
+	const op = "card.service.Archive"
+	card, err := s.archivedCard(ctx, userID, req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if card.ArchivedAt != nil {
+		return fmt.Errorf("%s: %w", op, ErrCardArchived)
+	}
+	if err := s.repo.Archive(ctx, card); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *CardService) Restore(ctx context.Context, userID uint64, req *Card) error {
+	const op = "card.service.Restore"
+	card, err := s.archivedCard(ctx, userID, req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if card.ArchivedAt == nil {
+		return fmt.Errorf("%s: %w", op, ErrCardNotArchived)
+	}
+	if err := s.repo.Restore(ctx, card); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// archivedCard загружает карточку доски владельца для перемещения в архив и обратно
+func (s *CardService) archivedCard(ctx context.Context, userID uint64, req *Card) (*Card, error) {
+	if err := s.checkBoardEditable(ctx, userID, req.BoardID); err != nil {
+		return nil, err
+	}
+	card, err := s.repo.GetById(ctx, &Card{ID: req.ID})
+	if err != nil {
+		return nil, err
+	}
+	if card.BoardID != req.BoardID {
+		return nil, ErrCardNotFound
+	}
+	return card, nil
+}
+
 func (s *CardService) MoveToNewPosition(ctx context.Context, req *CardMoveCommand) error {
 	const op = "card.service.MoveToNewPosition"
-	exists, err := s.repo.Exists(ctx, &Card{
-		ID:      req.ID,
-		BoardID: req.BoardID,
-	})
-
+	current, err := s.repo.GetById(ctx, &Card{ID: req.ID})
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
-	if !exists {
+	if current.BoardID != req.BoardID {
 		return fmt.Errorf("%s: %w", op, ErrCardNotFound)
 	}
 
@@ -171,6 +359,15 @@ func (s *CardService) MoveToNewPosition(ctx context.Context, req *CardMoveComman
 	if req.FromColumnID == req.ToColumnID && req.FromPosition == req.ToPosition {
 		return nil
 	}
+	if req.FromColumnID != req.ToColumnID {
+		blocked, err := s.repo.IsBlockedForColumn(ctx, req.ID, req.ToColumnID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if blocked {
+			return fmt.Errorf("%s: %w", op, ErrCardBlocked)
+		}
+	}
 
 	maxValue, err := s.repo.GetMaxColumnPosition(ctx, req.BoardID, req.ToColumnID)
 	if err != nil {
@@ -179,17 +376,458 @@ func (s *CardService) MoveToNewPosition(ctx context.Context, req *CardMoveComman
 	if req.ToPosition < 1 || req.ToPosition > maxValue {
 		req.ToPosition = 1
 	}
-	if err := s.repo.MoveToNewPosition(
-		ctx,
-		req.BoardID,
-		req.ID,
-		req.FromColumnID,
-		req.ToColumnID,
-		req.FromPosition,
-		req.ToPosition,
-	); err != nil {
+	if err := s.repo.MoveToNewPosition(ctx, req.BoardID, req.ID, req.ToColumnID, req.ToPosition); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
+	if current.ColumnID != req.ToColumnID {
+		s.publish(ctx, op, events.CardMovedEvent{
+			CardID:       req.ID,
+			BoardID:      req.BoardID,
+			FromColumnID: current.ColumnID,
+			ToColumnID:   req.ToColumnID,
+		})
+	}
 
 	return nil
 }
+
+// publish рассылает событие об уже выполненном изменении: ошибка подписчика
+// только логируется, потому что откатывать сохранённую карточку уже поздно
+func (s *CardService) publish(ctx context.Context, op string, event events.Event) {
+	if err := s.bus.Dispatch(ctx, event); err != nil {
+		slog.Error("dispatch event", slog.String("op", op), slog.String("event", event.Name()), slog.Any("err", err))
+	}
+}
+
+// Transfer переносит или копирует карточку на другую доску и возвращает карточку на новом месте.
+// Пользователь должен владеть обеими досками.
+func (s *CardService) Transfer(ctx context.Context, cmd *CardTransferCommand) (*CardListItem, error) {
+	const op = "card.service.Transfer"
+	if cmd.Mode == TransferMove && cmd.BoardID == cmd.TargetBoardID {
+		return nil, fmt.Errorf("%s: %w", op, ErrSameBoardMove)
+	}
+	for _, boardID := range []string{cmd.BoardID, cmd.TargetBoardID} {
+		editable, err := s.repo.IsBoardEditable(ctx, cmd.UserID, boardID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if !editable {
+			return nil, fmt.Errorf("%s: %w", op, ErrBoardNotEditable)
+		}
+	}
+
+	cardID, err := s.repo.Transfer(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	card, err := s.repo.GetListItem(ctx, cmd.UserID, cmd.TargetBoardID, cardID, false)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return card, nil
+}
+
+// Bulk выполняет пакет операций атомарно: либо сохраняются все, либо ни одна.
+// Проверки удаления через шину событий идут до транзакции; отказ любой из них откатывает пакет.
+func (s *CardService) Bulk(ctx context.Context, cmd *CardBulkCommand) (*CardBulkResult, error) {
+	const op = "card.service.Bulk"
+	guards := make(map[int]error)
+	for i, item := range cmd.Operations {
+		if item.Op != BulkOpDelete {
+			continue
+		}
+		err := s.bus.Dispatch(ctx, events.CardDeletedEvent{CardID: item.CardID})
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, sharedErrors.ErrCardHasChildren) && !errors.Is(err, sharedErrors.ErrCardHasComments) {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		guards[i] = err
+	}
+
+	items, err := s.repo.ApplyBulk(ctx, cmd.BoardID, cmd.Operations, cmd.DryRun || len(guards) > 0)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	for i, guardErr := range guards {
+		if items[i].Err == nil {
+			items[i].Err = guardErr
+		}
+	}
+
+	result := &CardBulkResult{
+		DryRun: cmd.DryRun,
+		Items:  items,
+	}
+	result.Applied = !cmd.DryRun && !result.Failed()
+	if result.Applied {
+		s.publishBulk(ctx, op, cmd, items)
+	}
+	return result, nil
+}
+
+// publishBulk рассылает события по применённым операциям пакета, как при одиночных изменениях
+func (s *CardService) publishBulk(ctx context.Context, op string, cmd *CardBulkCommand, items []*CardBulkItemResult) {
+	for i, item := range items {
+		switch item.Op {
+		case BulkOpMove:
+			toColumnID := cmd.Operations[i].ColumnID
+			if item.FromColumnID == toColumnID {
+				continue
+			}
+			s.publish(ctx, op, events.CardMovedEvent{
+				CardID:       item.CardID,
+				BoardID:      cmd.BoardID,
+				FromColumnID: item.FromColumnID,
+				ToColumnID:   toColumnID,
+			})
+		case BulkOpSetProperties:
+			if len(item.Changed) == 0 {
+				continue
+			}
+			s.publish(ctx, op, events.CardPropertiesChangedEvent{
+				CardID:  item.CardID,
+				BoardID: cmd.BoardID,
+				Changed: item.Changed,
+			})
+		}
+	}
+}
+
+// RebalanceRanks перестраивает ключи колонок, где ключи стали слишком длинными или совпали
+func (s *CardService) RebalanceRanks(ctx context.Context) error {
+	const op = "card.service.RebalanceRanks"
+	columnIDs, err := s.repo.GetColumnsToRebalance(ctx, rank.MaxLength)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	for _, columnID := range columnIDs {
+		if err := s.repo.RebalanceColumn(ctx, columnID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return nil
+}
+
+func (s *CardService) GetSubtree(ctx context.Context, req *Card) (*CardTreeNode, error) {
+	const op = "card.service.GetSubtree"
+	cards, err := s.repo.GetSubtree(ctx, req.BoardID, req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(cards) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrCardNotFound)
+	}
+	return BuildCardTree(cards), nil
+}
+
+// AttachChild делает карточку подзадачей другой карточки той же доски.
+// Карточку с родителем можно перевесить: старая связь заменяется новой.
+func (s *CardService) AttachChild(ctx context.Context, req *CardParentCommand) error {
+	const op = "card.service.AttachChild"
+	if req.ParentID == req.ChildID {
+		return fmt.Errorf("%s: %w", op, ErrInvalidParent)
+	}
+	exists, err := s.repo.Exists(ctx, &Card{ID: req.ParentID, BoardID: req.BoardID})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !exists {
+		return fmt.Errorf("%s: %w", op, ErrCardNotFound)
+	}
+	subtree, err := s.repo.GetSubtree(ctx, req.BoardID, req.ChildID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if len(subtree) == 0 {
+		return fmt.Errorf("%s: %w", op, ErrCardNotFound)
+	}
+	ancestors, err := s.repo.GetAncestorIDs(ctx, req.ParentID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if slices.Contains(ancestors, req.ChildID) {
+		return fmt.Errorf("%s: %w", op, ErrParentCycle)
+	}
+	if len(ancestors)+1+TreeHeight(BuildCardTree(subtree)) > MaxCardDepth {
+		return fmt.Errorf("%s: %w", op, ErrMaxDepthExceeded)
+	}
+	if err := s.repo.SetParent(ctx, req.BoardID, req.ChildID, req.ParentID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *CardService) DetachChild(ctx context.Context, req *CardParentCommand) error {
+	const op = "card.service.DetachChild"
+	if err := s.repo.RemoveParent(ctx, req.BoardID, req.ChildID, req.ParentID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// BuildCardTree собирает дерево из плоского списка, первая карточка списка — корень
+func BuildCardTree(cards []*Card) *CardTreeNode {
+	if len(cards) == 0 {
+		return nil
+	}
+	nodes := make(map[uint64]*CardTreeNode, len(cards))
+	for _, card := range cards {
+		nodes[card.ID] = &CardTreeNode{Card: *card, Children: []*CardTreeNode{}}
+	}
+	root := nodes[cards[0].ID]
+	for _, card := range cards[1:] {
+		if card.ParentID == nil {
+			continue
+		}
+		if parent, ok := nodes[*card.ParentID]; ok {
+			parent.Children = append(parent.Children, nodes[card.ID])
+		}
+	}
+	return root
+}
+
+// TreeHeight — число уровней под корнем
+func TreeHeight(node *CardTreeNode) int {
+	if node == nil {
+		return 0
+	}
+	height := 0
+	for _, child := range node.Children {
+		height = max(height, TreeHeight(child)+1)
+	}
+	return height
+}
+
+func (s *CardService) GetTemplateList(ctx context.Context, userID uint64, boardID string) ([]*CardTemplate, error) {
+	const op = "card.service.GetTemplateList"
+	if err := s.checkBoardEditable(ctx, userID, boardID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	templates, err := s.repo.GetTemplateList(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return templates, nil
+}
+
+func (s *CardService) GetTemplate(ctx context.Context, userID uint64, req *CardTemplate) (*CardTemplate, error) {
+	const op = "card.service.GetTemplate"
+	if err := s.checkBoardEditable(ctx, userID, req.BoardID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	template, err := s.repo.GetTemplate(ctx, req.BoardID, req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return template, nil
+}
+
+func (s *CardService) CreateTemplate(ctx context.Context, userID uint64, req *CardTemplate) (*CardTemplate, error) {
+	const op = "card.service.CreateTemplate"
+	if err := s.checkBoardEditable(ctx, userID, req.BoardID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	template := &CardTemplate{
+		BoardID:        req.BoardID,
+		Name:           req.Name,
+		Text:           req.Text,
+		Description:    req.Description,
+		CardProperties: req.CardProperties,
+	}
+	if err := s.repo.CreateTemplate(ctx, template); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return template, nil
+}
+
+func (s *CardService) UpdateTemplate(ctx context.Context, userID uint64, req *CardTemplate) (*CardTemplate, error) {
+	const op = "card.service.UpdateTemplate"
+	if err := s.checkBoardEditable(ctx, userID, req.BoardID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	template := &CardTemplate{
+		ID:             req.ID,
+		BoardID:        req.BoardID,
+		Name:           req.Name,
+		Text:           req.Text,
+		Description:    req.Description,
+		CardProperties: req.CardProperties,
+	}
+	if err := s.repo.UpdateTemplate(ctx, template); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return template, nil
+}
+
+func (s *CardService) DeleteTemplate(ctx context.Context, userID uint64, req *CardTemplate) error {
+	const op = "card.service.DeleteTemplate"
+	if err := s.checkBoardEditable(ctx, userID, req.BoardID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.repo.DeleteTemplate(ctx, req.BoardID, req.ID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// checkBoardEditable пускает к шаблонам карточек только владельца доски
+func (s *CardService) checkBoardEditable(ctx context.Context, userID uint64, boardID string) error {
+	editable, err := s.repo.IsBoardEditable(ctx, userID, boardID)
+	if err != nil {
+		return err
+	}
+	if !editable {
+		return ErrBoardNotEditable
+	}
+	return nil
+}
+
+func (s *CardService) GetRecurrenceList(ctx context.Context, userID uint64, boardID string) ([]*CardRecurrence, error) {
+	const op = "card.service.GetRecurrenceList"
+	if err := s.checkBoardEditable(ctx, userID, boardID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	recurrences, err := s.repo.GetRecurrenceList(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return recurrences, nil
+}
+
+func (s *CardService) CreateRecurrence(ctx context.Context, userID uint64, req *CardRecurrence) (*CardRecurrence, error) {
+	const op = "card.service.CreateRecurrence"
+	recurrence, err := s.prepareRecurrence(ctx, userID, req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	recurrence.CreatedBy = userID
+	if err := s.repo.CreateRecurrence(ctx, recurrence); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return recurrence, nil
+}
+
+func (s *CardService) UpdateRecurrence(ctx context.Context, userID uint64, req *CardRecurrence) (*CardRecurrence, error) {
+	const op = "card.service.UpdateRecurrence"
+	recurrence, err := s.prepareRecurrence(ctx, userID, req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	recurrence.ID = req.ID
+	if err := s.repo.UpdateRecurrence(ctx, recurrence); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return recurrence, nil
+}
+
+func (s *CardService) DeleteRecurrence(ctx context.Context, userID uint64, req *CardRecurrence) error {
+	const op = "card.service.DeleteRecurrence"
+	if err := s.checkBoardEditable(ctx, userID, req.BoardID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.repo.DeleteRecurrence(ctx, req.BoardID, req.ID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// MaterializeRecurrences создаёт карточки для наступивших повторений.
+// Каждое повторение сначала забирается сдвигом next_run_at с проверкой старого значения,
+// поэтому при нескольких экземплярах сервера карточку создаёт только один из них.
+// Без catchUp из пропущенных повторений создаётся только последнее.
+func (s *CardService) MaterializeRecurrences(ctx context.Context, now time.Time, catchUp bool) error {
+	const op = "card.service.MaterializeRecurrences"
+	recurrences, err := s.repo.GetDueRecurrences(ctx, now, recurrenceBatchSize)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	// ошибка одного правила не мешает остальным: оно останется наступившим и повторится на следующем проходе
+	for _, recurrence := range recurrences {
+		if err := s.materializeRecurrence(ctx, recurrence, now, catchUp); err != nil {
+			slog.Error("materialize card recurrence",
+				slog.String("op", op), slog.Uint64("recurrence_id", recurrence.ID), slog.Any("err", err))
+		}
+	}
+	return nil
+}
+
+func (s *CardService) materializeRecurrence(ctx context.Context, recurrence *CardRecurrence, now time.Time, catchUp bool) error {
+	const op = "card.service.materializeRecurrence"
+	rule, err := ParseRecurrenceRule(recurrence.Rule, recurrence.StartsAt)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	limit := maxRecurrenceCatchUp
+	if !catchUp {
+		limit = math.MaxInt
+	}
+	due := rule.Due(recurrence.StartsAt, recurrence.NextRunAt, now, limit)
+	if !catchUp && len(due) > 1 {
+		due = due[len(due)-1:]
+	}
+
+	cards := make([]*Card, 0, len(due))
+	for range due {
+		cards = append(cards, &Card{
+			BoardID:        recurrence.BoardID,
+			ColumnID:       recurrence.ColumnID,
+			Text:           recurrence.Text,
+			Description:    recurrence.Description,
+			CreatedBy:      recurrence.CreatedBy,
+			CardProperties: recurrence.CardProperties,
+		})
+	}
+	claimed, err := s.repo.MaterializeRecurrence(ctx, recurrence, rule.Next(recurrence.StartsAt, now), cards)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !claimed {
+		return nil
+	}
+	for _, card := range cards {
+		s.publish(ctx, op, events.CardCreatedEvent{
+			CardID:   card.ID,
+			BoardID:  card.BoardID,
+			ColumnID: card.ColumnID,
+		})
+	}
+	return nil
+}
+
+// prepareRecurrence проверяет доступ, колонку и правило и считает первое повторение после текущего момента
+func (s *CardService) prepareRecurrence(ctx context.Context, userID uint64, req *CardRecurrence) (*CardRecurrence, error) {
+	if err := s.checkBoardEditable(ctx, userID, req.BoardID); err != nil {
+		return nil, err
+	}
+	exists, err := s.boardRepo.ExistsColumn(ctx, req.BoardID, req.ColumnID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrColumnNotExist
+	}
+	start := req.StartsAt.UTC().Truncate(time.Second)
+	rule, err := ParseRecurrenceRule(req.Rule, start)
+	if err != nil {
+		return nil, err
+	}
+	return &CardRecurrence{
+		BoardID:        req.BoardID,
+		ColumnID:       req.ColumnID,
+		Text:           req.Text,
+		Description:    req.Description,
+		CardProperties: req.CardProperties,
+		Rule:           rule.String(),
+		StartsAt:       start,
+		NextRunAt:      rule.Next(start, maxTime(start.Add(-time.Second), time.Now())),
+	}, nil
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// CardTemplate — именованная заготовка карточки доски: текст, описание и свойства
+type CardTemplate struct {
+	ID          uint64
+	BoardID     string
+	Name        string
+	Text        string
+	Description string
+	CardProperties
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
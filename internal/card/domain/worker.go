@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type RecurrenceMaterializer interface {
+	MaterializeRecurrences(ctx context.Context, now time.Time, catchUp bool) error
+}
+
+// RecurrenceWorker периодически создаёт карточки по наступившим правилам повторения.
+// catchUp решает, догонять ли повторения, пропущенные пока сервер не работал.
+type RecurrenceWorker struct {
+	materializer RecurrenceMaterializer
+	interval     time.Duration
+	catchUp      bool
+}
+
+func NewRecurrenceWorker(materializer RecurrenceMaterializer, interval time.Duration, catchUp bool) *RecurrenceWorker {
+	return &RecurrenceWorker{
+		materializer: materializer,
+		interval:     interval,
+		catchUp:      catchUp,
+	}
+}
+
+func (w *RecurrenceWorker) Run(ctx context.Context) {
+	const op = "card.worker.Run"
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		if err := w.materializer.MaterializeRecurrences(ctx, time.Now(), w.catchUp); err != nil {
+			slog.Error("materialize card recurrences", slog.String("op", op), slog.Any("err", err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
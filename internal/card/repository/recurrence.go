@@ -0,0 +1,188 @@
+package card
+
+import (
+	"backend/internal/card/domain"
+	"backend/internal/shared/utils"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const selectCardRecurrenceQuery = `
+	SELECT id, board_id, column_id, COALESCE(created_by, 0), text, description, properties, rule,
+		starts_at, next_run_at, created_at, updated_at
+	FROM card_recurrences
+	WHERE deleted_at IS NULL
+`
+
+func (r *CardRepository) GetRecurrenceList(ctx context.Context, boardID string) ([]*domain.CardRecurrence, error) {
+	const op = "card.repository.GetRecurrenceList"
+	recurrences, err := r.queryRecurrences(ctx, selectCardRecurrenceQuery+" AND board_id = $1 ORDER BY next_run_at, id", boardID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return recurrences, nil
+}
+
+// GetDueRecurrences возвращает правила, у которых наступило очередное повторение.
+// Удалённые и архивные доски и колонки пропускаются: карточку некуда создавать,
+// а созданную в архиве никто бы не увидел.
+func (r *CardRepository) GetDueRecurrences(ctx context.Context, now time.Time, limit int) ([]*domain.CardRecurrence, error) {
+	const op = "card.repository.GetDueRecurrences"
+	query := selectCardRecurrenceQuery + `
+			AND next_run_at <= $1
+			AND EXISTS (
+				SELECT 1 FROM board_columns bc
+				JOIN boards b ON b.id = bc.board_id AND b.deleted_at IS NULL
+				WHERE bc.id = card_recurrences.column_id AND bc.deleted_at IS NULL
+					AND bc.archived_at IS NULL AND b.archived_at IS NULL
+			)
+		ORDER BY next_run_at, id
+		LIMIT $2
+	`
+	recurrences, err := r.queryRecurrences(ctx, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return recurrences, nil
+}
+
+func (r *CardRepository) CreateRecurrence(ctx context.Context, recurrence *domain.CardRecurrence) error {
+	const op = "card.repository.CreateRecurrence"
+	query := `
+		INSERT INTO card_recurrences (
+			board_id, column_id, created_by, text, description, properties, rule, starts_at, next_run_at
+		)
+		VALUES ($1, $2, NULLIF($3, 0), $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at, updated_at
+	`
+	if err := r.storage.QueryRowContext(
+		ctx,
+		query,
+		recurrence.BoardID,
+		recurrence.ColumnID,
+		recurrence.CreatedBy,
+		recurrence.Text,
+		recurrence.Description,
+		recurrence.CardProperties,
+		recurrence.Rule,
+		recurrence.StartsAt,
+		recurrence.NextRunAt,
+	).Scan(&recurrence.ID, &recurrence.CreatedAt, &recurrence.UpdatedAt); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (r *CardRepository) UpdateRecurrence(ctx context.Context, recurrence *domain.CardRecurrence) error {
+	const op = "card.repository.UpdateRecurrence"
+	query := `
+		UPDATE card_recurrences
+		SET column_id = $1, text = $2, description = $3, properties = $4, rule = $5, starts_at = $6,
+			next_run_at = $7, updated_at = NOW()
+		WHERE id = $8 AND board_id = $9 AND deleted_at IS NULL
+		RETURNING COALESCE(created_by, 0), created_at, updated_at
+	`
+	err := r.storage.QueryRowContext(
+		ctx,
+		query,
+		recurrence.ColumnID,
+		recurrence.Text,
+		recurrence.Description,
+		recurrence.CardProperties,
+		recurrence.Rule,
+		recurrence.StartsAt,
+		recurrence.NextRunAt,
+		recurrence.ID,
+		recurrence.BoardID,
+	).Scan(&recurrence.CreatedBy, &recurrence.CreatedAt, &recurrence.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%s: %w", op, domain.ErrRecurrenceNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (r *CardRepository) DeleteRecurrence(ctx context.Context, boardID string, id uint64) error {
+	const op = "card.repository.DeleteRecurrence"
+	query := "UPDATE card_recurrences SET deleted_at = NOW() WHERE id = $1 AND board_id = $2 AND deleted_at IS NULL"
+	return utils.OpExec(ctx, r.storage.ExecContext, op, query, domain.ErrRecurrenceNotFound, id, boardID)
+}
+
+// errRecurrenceClaimed откатывает транзакцию, если повторение уже забрал другой экземпляр сервера
+var errRecurrenceClaimed = errors.New("recurrence already claimed")
+
+// MaterializeRecurrence сдвигает next_run_at на next и создаёт карточки cards в одной транзакции:
+// если вставка не удалась, сдвиг откатывается и повторение будет создано на следующем проходе.
+// Сдвиг выполняется, только если next_run_at ещё никто не менял; false означает,
+// что повторение уже забрал другой экземпляр сервера.
+func (r *CardRepository) MaterializeRecurrence(
+	ctx context.Context, recurrence *domain.CardRecurrence, next time.Time, cards []*domain.Card,
+) (bool, error) {
+	const op = "card.repository.MaterializeRecurrence"
+	query := `
+		UPDATE card_recurrences SET next_run_at = $1
+		WHERE id = $2 AND next_run_at = $3 AND deleted_at IS NULL
+	`
+	err := utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, query, next, recurrence.ID, recurrence.NextRunAt)
+		if err != nil {
+			return err
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected != 1 {
+			return errRecurrenceClaimed
+		}
+		for _, card := range cards {
+			if err := insertCard(ctx, tx, card); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, errRecurrenceClaimed) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return true, nil
+}
+
+func (r *CardRepository) queryRecurrences(ctx context.Context, query string, args ...any) ([]*domain.CardRecurrence, error) {
+	rows, err := r.storage.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	recurrences := []*domain.CardRecurrence{}
+	for rows.Next() {
+		recurrence := &domain.CardRecurrence{}
+		if err := rows.Scan(
+			&recurrence.ID,
+			&recurrence.BoardID,
+			&recurrence.ColumnID,
+			&recurrence.CreatedBy,
+			&recurrence.Text,
+			&recurrence.Description,
+			&recurrence.CardProperties,
+			&recurrence.Rule,
+			&recurrence.StartsAt,
+			&recurrence.NextRunAt,
+			&recurrence.CreatedAt,
+			&recurrence.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		recurrences = append(recurrences, recurrence)
+	}
+	return recurrences, rows.Err()
+}
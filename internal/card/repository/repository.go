@@ -2,20 +2,211 @@ package card
 
 import (
 	"backend/internal/card/domain"
+	sharedErrors "backend/internal/shared/errors"
+	queryFilter "backend/internal/shared/filter"
+	"backend/internal/shared/rank"
 	"backend/internal/shared/utils"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 )
 
 const (
-	existsCardQuery         = "SELECT EXISTS (SELECT 1 FROM cards WHERE id = $1 AND board_id = $2 AND deleted_at IS NULL)"
+	existsCardQuery = "SELECT EXISTS (SELECT 1 FROM cards WHERE id = $1 AND board_id = $2 AND deleted_at IS NULL)"
+	// existsLiveCardQuery не находит архивные карточки: пакет и перенос с ними не работают
+	existsLiveCardQuery     = "SELECT EXISTS (SELECT 1 FROM cards WHERE id = $1 AND board_id = $2 AND deleted_at IS NULL AND archived_at IS NULL)"
 	existsCardInBoardQuery  = "SELECT EXISTS (SELECT 1 FROM cards WHERE board_id = $1 AND deleted_at IS NULL)"
 	existsCardInColumnQuery = "SELECT EXISTS (SELECT 1 FROM cards WHERE column_id = $1 AND deleted_at IS NULL)"
+	existsCardChildrenQuery = "SELECT EXISTS (SELECT 1 FROM cards WHERE parent_card_id = $1 AND deleted_at IS NULL)"
+	existsBoardColumnQuery  = "SELECT EXISTS (SELECT 1 FROM board_columns WHERE id = $1 AND board_id = $2 AND deleted_at IS NULL AND archived_at IS NULL)"
+	// bulkMoveCardQuery возвращает прежнюю колонку для события о переносе
+	bulkMoveCardQuery = `
+		UPDATE cards SET rank = $1, column_id = $2, version = cards.version + 1, updated_at = NOW()
+		FROM (SELECT id, column_id FROM cards WHERE id = $3) old
+		WHERE cards.id = old.id AND cards.board_id = $4 AND cards.deleted_at IS NULL AND cards.archived_at IS NULL
+		RETURNING old.column_id
+	`
+	// bulkSetPropertiesQuery дописывает заданные свойства поверх текущих и возвращает свойства до и после
+	bulkSetPropertiesQuery = `
+		UPDATE cards
+		SET properties = COALESCE(cards.properties, '{}'::jsonb) || $1::jsonb, version = cards.version + 1, updated_at = NOW()
+		FROM (SELECT id, properties FROM cards WHERE id = $2) old
+		WHERE cards.id = old.id AND cards.board_id = $3 AND cards.deleted_at IS NULL AND cards.archived_at IS NULL
+		RETURNING old.properties, cards.properties
+	`
+	bulkDeleteCardQuery      = "UPDATE cards SET deleted_at = NOW(), position = NULL WHERE id = $1 AND board_id = $2 AND deleted_at IS NULL AND archived_at IS NULL"
+	existsEditableBoardQuery = "SELECT EXISTS (SELECT 1 FROM boards WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL)"
+	selectTransferCardQuery  = `
+		SELECT text, COALESCE(description, ''), properties, due_date FROM cards
+		WHERE id = $1 AND board_id = $2 AND deleted_at IS NULL AND archived_at IS NULL
+		FOR UPDATE
+	`
+	// selectBoardTagQuery — написание тега $2, принятое на доске $1: сравнение без учёта регистра, берётся самое частое
+	selectBoardTagQuery = `
+		SELECT properties->>'tag' FROM cards
+		WHERE board_id = $1 AND deleted_at IS NULL AND LOWER(properties->>'tag') = LOWER($2)
+		GROUP BY 1
+		ORDER BY COUNT(*) DESC, 1
+		LIMIT 1
+	`
+	// номер карточки берётся из счётчика доски назначения, как при создании
+	moveCardToBoardQuery = `
+		WITH seq AS (
+			UPDATE boards SET card_seq = card_seq + 1 WHERE id = $1 RETURNING card_seq
+		)
+		UPDATE cards
+		SET board_id = $1, column_id = $2, rank = $3, properties = $4, number = seq.card_seq,
+			parent_card_id = NULL, version = version + 1, updated_at = NOW()
+		FROM seq
+		WHERE cards.id = $5
+	`
+	copyCardToBoardQuery = `
+		WITH seq AS (
+			UPDATE boards SET card_seq = card_seq + 1 WHERE id = $1 RETURNING card_seq
+		)
+		INSERT INTO cards (board_id, column_id, text, description, rank, properties, created_by, due_date, number)
+		SELECT $1, $2, $3, $4, $5, $6, NULLIF($7, 0), $8, seq.card_seq FROM seq
+		RETURNING id
+	`
+	copyCardCommentsQuery = `
+		INSERT INTO comments (card_id, user_id, text, created_at, updated_at)
+		SELECT $1, user_id, text, created_at, updated_at FROM comments
+		WHERE card_id = $2 AND deleted_at IS NULL
+		ORDER BY id
+	`
+	insertBoardAuditQuery = "INSERT INTO board_audit_log (board_id, user_id, action, card_id, details) VALUES ($1, $2, $3, $4, $5)"
+	// selectAncestorsQuery возвращает предков карточки $1 от ближайшего к корню;
+	// $2 ограничивает глубину на случай повреждённых данных
+	selectAncestorsQuery = `
+		WITH RECURSIVE ancestors AS (
+			SELECT parent_card_id AS id, 1 AS depth FROM cards WHERE id = $1 AND parent_card_id IS NOT NULL
+			UNION ALL
+			SELECT c.parent_card_id, ancestors.depth + 1
+			FROM cards c
+			JOIN ancestors ON c.id = ancestors.id
+			WHERE c.parent_card_id IS NOT NULL AND ancestors.depth <= $2
+		)
+		SELECT id FROM ancestors ORDER BY depth
+	`
+	selectChildCountsQuery = `
+		SELECT parent_card_id, column_id, COUNT(*)
+		FROM cards
+		WHERE board_id = $1 AND parent_card_id IS NOT NULL AND deleted_at IS NULL
+		GROUP BY parent_card_id, column_id
+	`
+	// cardPositionExpression — порядковый номер карточки %[1]s в колонке по ключу rank;
+	// старые клиенты продолжают работать с целочисленной позицией. Архивные карточки нумеруются отдельно от живых
+	cardPositionExpression = `(
+		SELECT COUNT(*) FROM cards sibling
+		WHERE sibling.column_id = %[1]s.column_id AND sibling.deleted_at IS NULL
+			AND (sibling.archived_at IS NULL) = (%[1]s.archived_at IS NULL)
+			AND (sibling.rank, sibling.id) <= (%[1]s.rank, %[1]s.id)
+	)`
+	// selectNeighborRanksQuery — ключи карточек, между которыми встанет карточка на позиции $3 колонки $1;
+	// сама перемещаемая карточка $2 не учитывается, позиция за концом колонки означает конец
+	selectNeighborRanksQuery = `
+		WITH siblings AS (
+			SELECT rank, ROW_NUMBER() OVER (ORDER BY rank, id) AS n
+			FROM cards
+			WHERE column_id = $1 AND id <> $2 AND deleted_at IS NULL AND archived_at IS NULL
+		)
+		SELECT
+			COALESCE((SELECT rank FROM siblings WHERE n = LEAST($3 - 1, (SELECT COUNT(*) FROM siblings))), ''),
+			COALESCE((SELECT rank FROM siblings WHERE n = $3), '')
+	`
+	selectLastRankQuery           = "SELECT COALESCE(MAX(rank), '') FROM cards WHERE column_id = $1 AND deleted_at IS NULL"
+	selectColumnsToRebalanceQuery = `
+		SELECT column_id FROM cards
+		WHERE deleted_at IS NULL
+		GROUP BY column_id
+		HAVING MAX(LENGTH(rank)) > $1 OR COUNT(DISTINCT rank) < COUNT(*)
+	`
+	// openBlockersCondition — у карточки %s есть блокирующие карточки, ещё не попавшие в колонку done
+	openBlockersCondition = `EXISTS (
+		SELECT 1 FROM card_relations r
+		JOIN cards blocker ON blocker.id = r.source_card_id AND blocker.deleted_at IS NULL
+		JOIN board_columns blocker_column ON blocker_column.id = blocker.column_id
+		WHERE r.type = 'blocks' AND r.target_card_id = %s AND blocker_column.category <> 'done'
+	)`
+)
+
+var (
+	// selectSubtreeQuery обходит подзадачи карточки $2 доски $1 не глубже $3 уровней
+	selectSubtreeQuery = `
+		WITH RECURSIVE tree AS (
+			SELECT id, 0 AS depth FROM cards WHERE id = $2 AND board_id = $1 AND deleted_at IS NULL
+			UNION ALL
+			SELECT c.id, tree.depth + 1
+			FROM cards c
+			JOIN tree ON c.parent_card_id = tree.id
+			WHERE c.deleted_at IS NULL AND tree.depth < $3
+		)
+		SELECT c.id, c.board_id, c.column_id, c.text, COALESCE(c.description, ''),
+			` + fmt.Sprintf(cardPositionExpression, "c") + `, c.rank, c.properties,
+			c.parent_card_id, c.due_date, c.version, c.created_at, c.updated_at
+		FROM tree
+		JOIN cards c ON c.id = tree.id
+		ORDER BY tree.depth, c.rank, c.id
+	`
+	// selectCardListItemQuery — карточки досок пользователя $1 вместе с названиями доски и колонки
+	selectCardListItemQuery = `
+		SELECT
+				cards.id,
+				cards.number,
+				cards.board_id,
+				cards.column_id,
+				cards.text,
+				COALESCE(cards.description, ''),
+				` + fmt.Sprintf(cardPositionExpression, "cards") + `,
+				cards.properties,
+				COALESCE(cards.created_by, 0),
+				cards.due_date,
+				cards.created_at,
+				cards.updated_at,
+				cards.version,
+				b.key,
+				b.name,
+				bc.name,
+				bc.category
+		FROM cards
+		JOIN board_columns bc ON bc.id = cards.column_id AND bc.deleted_at IS NULL
+		JOIN boards b ON b.id = cards.board_id AND b.deleted_at IS NULL
+		WHERE cards.deleted_at IS NULL
+			AND b.user_id = $1
+	`
+	// listItemLiveCondition прячет архивные карточки и карточки архивных колонок и досок
+	listItemLiveCondition    = " AND cards.archived_at IS NULL AND bc.archived_at IS NULL AND b.archived_at IS NULL"
+	blockedByDoneColumnQuery = "SELECT EXISTS (SELECT 1 FROM board_columns WHERE id = $2 AND category = 'done') AND " +
+		fmt.Sprintf(openBlockersCondition, "$1")
 )
 
+// cardFilterMapping — SQL-выражения для полей domain.CardFilterFields.
+// Запросы, использующие фильтр, должны соединять board_columns как bc и boards как b.
+var cardFilterMapping = queryFilter.Mapping{
+	Columns: map[string]string{
+		"text":        "(cards.text || ' ' || COALESCE(cards.description, ''))",
+		"description": "cards.description",
+		"column":      "bc.name",
+		"board":       "b.name",
+		"category":    "bc.category",
+		"tag":         "cards.properties->>'tag'",
+		"color":       "cards.properties->>'color'",
+		"estimate":    "COALESCE((cards.properties->>'estimate')::BIGINT, 0)",
+		"sprint":      "EXISTS (SELECT 1 FROM sprint_cards sc WHERE sc.card_id = cards.id AND sc.sprint_id = %s)",
+		"created":     "cards.created_at",
+		"updated":     "cards.updated_at",
+		"due":         "cards.due_date",
+	},
+	FreeText: []string{"cards.text", "cards.description"},
+}
+
+// errBulkRollback откатывает транзакцию пакета, когда его нельзя сохранять
+var errBulkRollback = errors.New("bulk rollback")
+
 type Storage interface {
 	Exec(query string, args ...any) (sql.Result, error)
 	Query(query string, args ...any) (*sql.Rows, error)
@@ -42,43 +233,87 @@ func NewCardRepository(
 	}
 }
 
-func (r *CardRepository) GetListWithComments(ctx context.Context, boardID string) ([]*domain.CardWithComments, error) {
+func (r *CardRepository) GetListWithComments(
+	ctx context.Context, boardID string, filter *domain.CardListFilter,
+) ([]*domain.CardWithComments, error) {
 	const op = "card.repository.GetListWithComment"
 	query := `
 		SELECT
 				cards.id,
+				cards.number,
 				cards.board_id,
 				cards.column_id,
 				cards.text,
 				cards.description,
-				cards.position,
+				ordered.position,
+				cards.rank,
 				cards.properties,
+				cards.due_date,
+				cards.parent_card_id,
+				cards.version,
 				cards.created_at,
+				cards.archived_at,
+				` + fmt.Sprintf(openBlockersCondition, "cards.id") + `,
 				comments.id,
 				comments.card_id,
 				comments.text,
+				comments.version,
 				comments.created_at
 		FROM cards
+		JOIN (
+			SELECT id, ROW_NUMBER() OVER (PARTITION BY column_id, archived_at IS NULL ORDER BY rank, id) AS position
+			FROM cards WHERE board_id = $1 AND deleted_at IS NULL
+		) ordered ON ordered.id = cards.id
+		JOIN board_columns bc ON bc.id = cards.column_id
+		JOIN boards b ON b.id = cards.board_id
 		LEFT JOIN comments ON comments.card_id = cards.id AND comments.deleted_at IS NULL
 		WHERE cards.deleted_at is null
 			and cards.board_id = $1
 	`
-	rows, err := r.storage.QueryContext(ctx, query, boardID)
+	params := []any{boardID}
+	if filter == nil || !filter.Archived {
+		query += " AND cards.archived_at IS NULL AND bc.archived_at IS NULL"
+	}
+	if filter != nil && filter.SprintID != nil {
+		params = append(params, *filter.SprintID)
+		query += fmt.Sprintf(
+			" AND EXISTS (SELECT 1 FROM sprint_cards sc WHERE sc.card_id = cards.id AND sc.sprint_id = $%d)",
+			len(params),
+		)
+	}
+	if filter != nil && !filter.Query.Empty() {
+		conditions, err := queryFilter.Compile(
+			filter.Query, domain.CardFilterFields, cardFilterMapping, len(params), time.Now(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		for _, condition := range conditions.Where {
+			query += " AND " + condition
+		}
+		params = append(params, conditions.Params...)
+	}
+	query += cardListOrder(filter)
+	rows, err := r.storage.QueryContext(ctx, query, params...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 	defer rows.Close()
 
 	cardComments := make(map[uint64]*domain.CardWithComments)
+	order := []uint64{}
 	for rows.Next() {
-		var cardID, cardPosition, columnID, commentID, commentCardID sql.NullInt64
-		var boardID, cardText, cardDescription, commentText sql.NullString
+		var cardID, cardNumber, cardPosition, columnID, cardVersion, commentID, commentCardID, commentVersion sql.NullInt64
+		var boardID, cardText, cardDescription, cardRank, commentText sql.NullString
 		var properties domain.CardProperties
-		var cardCreatedAt, commentCreatedAt *time.Time
+		var cardDueDate, cardCreatedAt, cardArchivedAt, commentCreatedAt *time.Time
+		var parentID *uint64
+		var blocked bool
 
 		err := rows.Scan(
-			&cardID, &boardID, &columnID, &cardText, &cardDescription, &cardPosition, &properties, &cardCreatedAt,
-			&commentID, &commentCardID, &commentText, &commentCreatedAt,
+			&cardID, &cardNumber, &boardID, &columnID, &cardText, &cardDescription, &cardPosition, &cardRank, &properties, &cardDueDate, &parentID,
+			&cardVersion, &cardCreatedAt, &cardArchivedAt, &blocked,
+			&commentID, &commentCardID, &commentText, &commentVersion, &commentCreatedAt,
 		)
 		if err != nil {
 			fmt.Println(err)
@@ -90,15 +325,23 @@ func (r *CardRepository) GetListWithComments(ctx context.Context, boardID string
 		cardIDUint64 := uint64(cardID.Int64)
 		_, ok := cardComments[cardIDUint64]
 		if !ok {
+			order = append(order, cardIDUint64)
 			cardComments[cardIDUint64] = &domain.CardWithComments{
 				ID:             uint64(cardID.Int64),
+				Number:         uint64(cardNumber.Int64),
 				BoardID:        boardID.String,
 				ColumnID:       uint64(columnID.Int64),
 				Text:           cardText.String,
 				Description:    cardDescription.String,
 				Position:       uint64(cardPosition.Int64),
+				Rank:           cardRank.String,
 				CardProperties: properties,
+				DueDate:        cardDueDate,
+				Blocked:        blocked,
+				ParentID:       parentID,
+				Version:        uint64(cardVersion.Int64),
 				CreatedAt:      *cardCreatedAt,
+				ArchivedAt:     cardArchivedAt,
 				Comments:       []domain.CardComment{},
 			}
 		}
@@ -107,21 +350,342 @@ func (r *CardRepository) GetListWithComments(ctx context.Context, boardID string
 				ID:        uint64(commentID.Int64),
 				CardID:    uint64(commentCardID.Int64),
 				Text:      string(commentText.String),
+				Version:   uint64(commentVersion.Int64),
 				CreatedAt: *commentCreatedAt,
 			})
 		}
 	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := r.fillChildCounts(ctx, boardID, cardComments); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 	cardWithComments := make([]*domain.CardWithComments, 0, len(cardComments))
-	for _, card := range cardComments {
-		cardWithComments = append(cardWithComments, card)
+	for _, id := range order {
+		cardWithComments = append(cardWithComments, cardComments[id])
 	}
 	return cardWithComments, nil
 }
 
+// fillChildCounts считает подзадачи по колонкам; фильтр доски на подсчёт не влияет
+func (r *CardRepository) fillChildCounts(
+	ctx context.Context, boardID string, cards map[uint64]*domain.CardWithComments,
+) error {
+	rows, err := r.storage.QueryContext(ctx, selectChildCountsQuery, boardID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var parentID, columnID, count uint64
+		if err := rows.Scan(&parentID, &columnID, &count); err != nil {
+			return err
+		}
+		card, ok := cards[parentID]
+		if !ok {
+			continue
+		}
+		if card.ChildCounts == nil {
+			card.ChildCounts = map[uint64]uint64{}
+		}
+		card.ChildCounts[columnID] = count
+	}
+	return rows.Err()
+}
+
+func (r *CardRepository) GetSubtree(ctx context.Context, boardID string, cardID uint64) ([]*domain.Card, error) {
+	const op = "card.repository.GetSubtree"
+	rows, err := r.storage.QueryContext(ctx, selectSubtreeQuery, boardID, cardID, domain.MaxCardDepth)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	cards := []*domain.Card{}
+	for rows.Next() {
+		card := &domain.Card{}
+		if err := rows.Scan(
+			&card.ID,
+			&card.BoardID,
+			&card.ColumnID,
+			&card.Text,
+			&card.Description,
+			&card.Position,
+			&card.Rank,
+			&card.CardProperties,
+			&card.ParentID,
+			&card.DueDate,
+			&card.Version,
+			&card.CreatedAt,
+			&card.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		cards = append(cards, card)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return cards, nil
+}
+
+func (r *CardRepository) GetAncestorIDs(ctx context.Context, cardID uint64) ([]uint64, error) {
+	const op = "card.repository.GetAncestorIDs"
+	rows, err := r.storage.QueryContext(ctx, selectAncestorsQuery, cardID, domain.MaxCardDepth)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	ids := []uint64{}
+	for rows.Next() {
+		var id uint64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return ids, nil
+}
+
+var cardSortColumns = map[string]string{
+	domain.SortByPosition:  "cards.rank",
+	domain.SortByCreatedAt: "cards.created_at",
+	domain.SortByUpdatedAt: "cards.updated_at",
+	domain.SortByEstimate:  "COALESCE((cards.properties->>'estimate')::BIGINT, 0)",
+}
+
+// cardListOrder сортирует карточки доски; комментарии идут по дате внутри карточки
+func cardListOrder(filter *domain.CardListFilter) string {
+	column := cardSortColumns[domain.SortByPosition]
+	direction := "ASC"
+	if filter != nil {
+		if sortColumn, ok := cardSortColumns[filter.SortBy]; ok {
+			column = sortColumn
+		}
+		if filter.SortOrder == domain.SortOrderDesc {
+			direction = "DESC"
+		}
+	}
+	return fmt.Sprintf(" ORDER BY %s %s, cards.id, comments.created_at", column, direction)
+}
+
+func (r *CardRepository) Search(
+	ctx context.Context, search *domain.CardSearchQuery,
+) ([]*domain.CardListItem, uint64, error) {
+	const op = "card.repository.Search"
+	query := `
+		SELECT
+				cards.id,
+				cards.board_id,
+				cards.column_id,
+				cards.text,
+				COALESCE(cards.description, ''),
+				` + fmt.Sprintf(cardPositionExpression, "cards") + `,
+				cards.properties,
+				cards.due_date,
+				cards.created_at,
+				cards.updated_at,
+				cards.version,
+				cards.number,
+				b.key,
+				b.name,
+				bc.name,
+				bc.category,
+				COUNT(*) OVER()
+		FROM cards
+		JOIN board_columns bc ON bc.id = cards.column_id AND bc.deleted_at IS NULL
+		JOIN boards b ON b.id = cards.board_id AND b.deleted_at IS NULL
+		WHERE cards.deleted_at IS NULL
+			AND b.user_id = $1
+	`
+	if !search.Archived {
+		query += listItemLiveCondition
+	}
+	params := []any{search.UserID}
+	conditions, err := queryFilter.Compile(
+		search.Query, domain.CardFilterFields, cardFilterMapping, len(params), time.Now(),
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, condition := range conditions.Where {
+		query += " AND " + condition
+	}
+	params = append(params, conditions.Params...)
+	query += fmt.Sprintf(
+		" ORDER BY cards.updated_at DESC, cards.id DESC LIMIT $%d OFFSET $%d",
+		len(params)+1, len(params)+2,
+	)
+	params = append(params, search.PerPage, (search.Page-1)*search.PerPage)
+
+	rows, err := r.storage.QueryContext(ctx, query, params...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var totalCount uint64
+	cards := []*domain.CardListItem{}
+	for rows.Next() {
+		card := &domain.CardListItem{}
+		if err := rows.Scan(
+			&card.ID,
+			&card.BoardID,
+			&card.ColumnID,
+			&card.Text,
+			&card.Description,
+			&card.Position,
+			&card.CardProperties,
+			&card.DueDate,
+			&card.CreatedAt,
+			&card.UpdatedAt,
+			&card.Version,
+			&card.Number,
+			&card.BoardKey,
+			&card.BoardName,
+			&card.ColumnName,
+			&card.ColumnCategory,
+			&totalCount,
+		); err != nil {
+			return nil, 0, fmt.Errorf("%s: %w", op, err)
+		}
+		cards = append(cards, card)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return cards, totalCount, nil
+}
+
+// GetMyCards отдаёт карточки досок пользователя, которые он создал или комментировал.
+// Сортировка и курсор работают по паре (поле сортировки, id).
+func (r *CardRepository) GetMyCards(ctx context.Context, my *domain.MyCardsQuery) ([]*domain.CardListItem, error) {
+	const op = "card.repository.GetMyCards"
+	column := "cards.updated_at"
+	if my.SortBy == domain.SortByCreatedAt {
+		column = "cards.created_at"
+	}
+	direction, comparison := "DESC", "<"
+	if my.SortOrder == domain.SortOrderAsc {
+		direction, comparison = "ASC", ">"
+	}
+
+	query := selectCardListItemQuery + `
+			AND (
+				cards.created_by = $1
+				OR EXISTS (
+					SELECT 1 FROM comments
+					WHERE comments.card_id = cards.id AND comments.user_id = $1 AND comments.deleted_at IS NULL
+				)
+			)
+	`
+	if !my.Archived {
+		query += listItemLiveCondition
+	}
+	params := []any{my.UserID}
+	if my.Cursor != nil {
+		params = append(params, my.Cursor.Value, my.Cursor.ID)
+		query += fmt.Sprintf(" AND (%s, cards.id) %s ($2, $3)", column, comparison)
+	}
+	params = append(params, my.Limit)
+	query += fmt.Sprintf(" ORDER BY %s %s, cards.id %s LIMIT $%d", column, direction, direction, len(params))
+
+	rows, err := r.storage.QueryContext(ctx, query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	cards := []*domain.CardListItem{}
+	for rows.Next() {
+		card := &domain.CardListItem{}
+		if err := scanCardListItem(rows, card); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		cards = append(cards, card)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return cards, nil
+}
+
+// GetByKey ищет карточку по ключу доски и номеру среди досок пользователя; archived включает архивные
+func (r *CardRepository) GetByKey(
+	ctx context.Context, userID uint64, boardKey string, number uint64, archived bool,
+) (*domain.CardListItem, error) {
+	const op = "card.repository.GetByKey"
+	query := selectCardListItemQuery + " AND b.key = $2 AND cards.number = $3"
+	if !archived {
+		query += listItemLiveCondition
+	}
+	card := &domain.CardListItem{}
+	if err := scanCardListItem(r.storage.QueryRowContext(ctx, query, userID, boardKey, number), card); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, domain.ErrCardNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return card, nil
+}
+
+// GetListItem отдаёт карточку доски вместе с названиями доски и колонки среди досок пользователя;
+// archived включает архивные
+func (r *CardRepository) GetListItem(
+	ctx context.Context, userID uint64, boardID string, cardID uint64, archived bool,
+) (*domain.CardListItem, error) {
+	const op = "card.repository.GetListItem"
+	query := selectCardListItemQuery + " AND cards.board_id = $2 AND cards.id = $3"
+	if !archived {
+		query += listItemLiveCondition
+	}
+	card := &domain.CardListItem{}
+	if err := scanCardListItem(r.storage.QueryRowContext(ctx, query, userID, boardID, cardID), card); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, domain.ErrCardNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return card, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanCardListItem(row scanner, card *domain.CardListItem) error {
+	return row.Scan(
+		&card.ID,
+		&card.Number,
+		&card.BoardID,
+		&card.ColumnID,
+		&card.Text,
+		&card.Description,
+		&card.Position,
+		&card.CardProperties,
+		&card.CreatedBy,
+		&card.DueDate,
+		&card.CreatedAt,
+		&card.UpdatedAt,
+		&card.Version,
+		&card.BoardKey,
+		&card.BoardName,
+		&card.ColumnName,
+		&card.ColumnCategory,
+	)
+}
+
 func (r *CardRepository) GetById(ctx context.Context, card *domain.Card) (*domain.Card, error) {
 	const op = "card.repository.GetById"
 	data := &domain.Card{}
-	query := "SELECT id, column_id, board_id, text, description, position FROM cards WHERE id = $1 AND deleted_at IS NULL"
+	query := "SELECT id, column_id, board_id, text, description, " + fmt.Sprintf(cardPositionExpression, "cards") + ", rank, properties, version, archived_at " +
+		"FROM cards WHERE id = $1 AND deleted_at IS NULL"
 	row := r.storage.QueryRowContext(ctx, query, card.ID)
 	err := row.Scan(
 		&data.ID,
@@ -130,6 +694,10 @@ func (r *CardRepository) GetById(ctx context.Context, card *domain.Card) (*domai
 		&data.Text,
 		&data.Description,
 		&data.Position,
+		&data.Rank,
+		&data.CardProperties,
+		&data.Version,
+		&data.ArchivedAt,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -142,84 +710,186 @@ func (r *CardRepository) GetById(ctx context.Context, card *domain.Card) (*domai
 
 func (r *CardRepository) Create(ctx context.Context, card *domain.Card) error {
 	const op = "card.repository.Create"
+	return utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		if err := insertCard(ctx, tx, card); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
+}
+
+// insertCard ставит карточку в конец колонки; блокировка колонки не даёт двум вставкам получить один ключ
+func insertCard(ctx context.Context, tx *sql.Tx, card *domain.Card) error {
+	// номер берётся из счётчика доски: UPDATE блокирует строку доски до конца вставки,
+	// поэтому параллельные вставки получают разные номера
 	query := `
-		INSERT INTO cards (board_id, column_id, text, description, position, properties)
-		VALUES($1, $2, $3, $4, $5, $6)
+		WITH seq AS (
+			UPDATE boards SET card_seq = card_seq + 1 WHERE id = $1 RETURNING card_seq
+		)
+		INSERT INTO cards (board_id, column_id, text, description, rank, properties, created_by, due_date, number)
+		SELECT $1, $2, $3, $4, $5, $6, NULLIF($7, 0), $8, seq.card_seq FROM seq
+		RETURNING id
 	`
-	return utils.OpExec(
+	if err := lockColumn(ctx, tx, card.ColumnID); err != nil {
+		return err
+	}
+	var last string
+	if err := tx.QueryRowContext(ctx, selectLastRankQuery, card.ColumnID).Scan(&last); err != nil {
+		return err
+	}
+	key, err := rank.Between(last, "")
+	if err != nil {
+		return err
+	}
+	err = tx.QueryRowContext(
 		ctx,
-		r.storage.ExecContext,
-		op,
 		query,
-		domain.ErrCardAlreadyExists,
 		card.BoardID,
 		card.ColumnID,
 		card.Text,
 		card.Description,
-		card.Position,
+		key,
 		card.CardProperties,
-	)
+		card.CreatedBy,
+		card.DueDate,
+	).Scan(&card.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrCardAlreadyExists
+	}
+	return err
 }
 
 func (r *CardRepository) Update(ctx context.Context, card *domain.Card) error {
 	const op = "card.repository.Update"
 	query := `
 		UPDATE cards
-		SET text = $1, description = $2, properties = $3, updated_at = NOW()
-		WHERE id = $4 AND board_id = $5 AND deleted_at IS NULL
+		SET text = $1, description = $2, properties = $3, due_date = $4, version = version + 1, updated_at = NOW()
+		WHERE id = $5 AND board_id = $6 AND deleted_at IS NULL AND ($7::BIGINT = 0 OR version = $7)
 	`
-	return utils.OpExec(
+	return utils.OpExecVersion(
 		ctx,
-		r.storage.ExecContext,
+		r.storage,
 		op,
 		query,
 		domain.ErrCardNotFound,
+		card.Version,
+		existsCardQuery,
+		[]any{card.ID, card.BoardID},
 		card.Text,
 		card.Description,
 		card.CardProperties,
+		card.DueDate,
 		card.ID,
 		card.BoardID,
+		card.Version,
 	)
 }
 
 func (r *CardRepository) Delete(ctx context.Context, card *domain.Card) error {
 	const op = "card.repository.Delete"
-	tx, err := r.storage.BeginTx(ctx, &sql.TxOptions{
-		Isolation: sql.LevelRepeatableRead,
-	})
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
-	defer tx.Rollback()
-	query := `UPDATE cards SET position = position - 1 WHERE column_id = $1 AND  board_id = $2 AND position > $3 AND deleted_at IS NULL`
-	_, err = tx.ExecContext(ctx, query, card.ColumnID, card.BoardID, card.Position)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
-	query = `UPDATE cards SET deleted_at = NOW(), position = NULL WHERE id = $1 AND board_id = $2 AND deleted_at IS NULL`
-	err = utils.OpExec(ctx, tx.ExecContext, op, query, domain.ErrCardNotFound, card.ID, card.BoardID)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return fmt.Errorf("%s: %w", op, domain.ErrCardNotFound)
+	query := `UPDATE cards SET deleted_at = NOW(), position = NULL WHERE id = $1 AND board_id = $2 AND deleted_at IS NULL`
+	return utils.OpExec(ctx, r.storage.ExecContext, op, query, domain.ErrCardNotFound, card.ID, card.BoardID)
+}
+
+func (r *CardRepository) Archive(ctx context.Context, card *domain.Card) error {
+	const op = "card.repository.Archive"
+	query := `
+		UPDATE cards SET archived_at = NOW(), version = version + 1, updated_at = NOW()
+		WHERE id = $1 AND board_id = $2 AND deleted_at IS NULL AND archived_at IS NULL
+	`
+	return utils.OpExec(ctx, r.storage.ExecContext, op, query, domain.ErrCardArchived, card.ID, card.BoardID)
+}
+
+// Restore возвращает карточку из архива на прежнее место в колонке.
+// Если её ключ успела занять другая карточка, восстановленная встаёт в конец колонки.
+func (r *CardRepository) Restore(ctx context.Context, card *domain.Card) error {
+	const op = "card.repository.Restore"
+	query := `
+		UPDATE cards c SET
+			archived_at = NULL,
+			rank = CASE WHEN EXISTS(
+				SELECT 1 FROM cards sibling
+				WHERE sibling.column_id = c.column_id AND sibling.id <> c.id AND sibling.rank = c.rank
+					AND sibling.deleted_at IS NULL AND sibling.archived_at IS NULL
+			) THEN $3 ELSE rank END,
+			version = version + 1,
+			updated_at = NOW()
+		WHERE id = $1 AND board_id = $2 AND deleted_at IS NULL AND archived_at IS NOT NULL
+	`
+	return utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		if err := lockColumn(ctx, tx, card.ColumnID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
 		}
-		return fmt.Errorf("%s: %w", op, err)
-	}
-	return tx.Commit()
+		exists, err := utils.ExistsQueryWrapper(ctx, tx, existsBoardColumnQuery, card.ColumnID, card.BoardID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if !exists {
+			return fmt.Errorf("%s: %w", op, domain.ErrColumnNotExist)
+		}
+		var last string
+		if err := tx.QueryRowContext(ctx, selectLastRankQuery, card.ColumnID).Scan(&last); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		key, err := rank.Between(last, "")
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return utils.OpExec(ctx, tx.ExecContext, op, query, domain.ErrCardNotArchived, card.ID, card.BoardID, key)
+	})
+}
+
+func (r *CardRepository) SetParent(ctx context.Context, boardID string, cardID, parentID uint64) error {
+	const op = "card.repository.SetParent"
+	query := `
+		UPDATE cards
+		SET parent_card_id = $1, version = version + 1, updated_at = NOW()
+		WHERE id = $2 AND board_id = $3 AND deleted_at IS NULL
+	`
+	return utils.OpExec(ctx, r.storage.ExecContext, op, query, domain.ErrCardNotFound, parentID, cardID, boardID)
+}
+
+func (r *CardRepository) RemoveParent(ctx context.Context, boardID string, cardID, parentID uint64) error {
+	const op = "card.repository.RemoveParent"
+	query := `
+		UPDATE cards
+		SET parent_card_id = NULL, version = version + 1, updated_at = NOW()
+		WHERE id = $1 AND board_id = $2 AND parent_card_id = $3 AND deleted_at IS NULL
+	`
+	return utils.OpExec(ctx, r.storage.ExecContext, op, query, domain.ErrCardNotFound, cardID, boardID, parentID)
 }
 
 func (r *CardRepository) GetMaxColumnPosition(ctx context.Context, boardUUID string, columnID uint64) (uint64, error) {
 	const op = "card.repository.GetMaxColumnPosition"
-	var maxValue sql.NullInt64
-	query := "SELECT MAX(position) FROM cards WHERE board_id = $1 AND column_id = $2 AND deleted_at IS NULL"
+	var maxValue uint64
+	query := "SELECT COUNT(*) FROM cards WHERE board_id = $1 AND column_id = $2 AND deleted_at IS NULL AND archived_at IS NULL"
 	row := r.storage.QueryRowContext(ctx, query, boardUUID, columnID)
-	err := row.Scan(&maxValue)
-	if err != nil {
+	if err := row.Scan(&maxValue); err != nil {
 		return 0, fmt.Errorf("%s: %w", op, err)
 	}
-	if !maxValue.Valid {
-		return 0, nil
+	return maxValue, nil
+}
+
+func (r *CardRepository) GetColumnsToRebalance(ctx context.Context, maxLength int) ([]uint64, error) {
+	const op = "card.repository.GetColumnsToRebalance"
+	rows, err := r.storage.QueryContext(ctx, selectColumnsToRebalanceQuery, maxLength)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	ids := []uint64{}
+	for rows.Next() {
+		var id uint64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-	return uint64(maxValue.Int64), nil
+	return ids, nil
 }
 
 func (r *CardRepository) Exists(ctx context.Context, card *domain.Card) (bool, error) {
@@ -239,6 +909,25 @@ func (r *CardRepository) Exists(ctx context.Context, card *domain.Card) (bool, e
 	return exists, nil
 }
 
+// IsBlockedForColumn сообщает, что колонка относится к категории done, а у карточки есть открытые блокеры
+func (r *CardRepository) IsBlockedForColumn(ctx context.Context, cardID, columnID uint64) (bool, error) {
+	const op = "card.repository.IsBlockedForColumn"
+	blocked, err := utils.ExistsQueryWrapper(ctx, r.storage, blockedByDoneColumnQuery, cardID, columnID)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return blocked, nil
+}
+
+func (r *CardRepository) CardHasChildren(ctx context.Context, cardID uint64) (bool, error) {
+	const op = "card.repository.CardHasChildren"
+	exists, err := utils.ExistsQueryWrapper(ctx, r.storage, existsCardChildrenQuery, cardID)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return exists, nil
+}
+
 func (r *CardRepository) CardExistsInBoard(ctx context.Context, boardUUID string) (bool, error) {
 	const op = "card.repository.CardExistsInBoard"
 	var exists bool
@@ -271,72 +960,363 @@ func (r *CardRepository) CardExistsInColumn(ctx context.Context, columnID uint64
 	return exists, nil
 }
 
+// MoveToNewPosition ставит карточку на позицию position колонки toColumnID.
+// Меняется только строка карточки; блокировка колонки упорядочивает одновременные перемещения.
 func (r *CardRepository) MoveToNewPosition(
-	ctx context.Context, uuid string, cardID, fromColumnID, toColumnID, fromCardPosition, toCardPosition uint64,
+	ctx context.Context, uuid string, cardID, toColumnID, position uint64,
 ) error {
 	const op = "card.repository.MoveToNewPosition"
-	tx, err := r.storage.BeginTx(ctx, &sql.TxOptions{
-		Isolation: sql.LevelRepeatableRead,
+	query := `
+		UPDATE cards SET rank = $1, column_id = $2, version = version + 1, updated_at = NOW()
+		WHERE id = $3 AND board_id = $4 AND deleted_at IS NULL
+	`
+	return utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		if err := lockColumn(ctx, tx, toColumnID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		key, err := rankAt(ctx, tx, toColumnID, cardID, position)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return utils.OpExec(ctx, tx.ExecContext, op, query, domain.ErrCardNotFound, key, toColumnID, cardID, uuid)
 	})
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+}
+
+// ApplyBulk выполняет операции пакета по порядку в одной транзакции и возвращает результат каждой.
+// Если хотя бы одна операция не прошла или задан dryRun, транзакция откатывается.
+func (r *CardRepository) ApplyBulk(
+	ctx context.Context, boardID string, ops []*domain.CardBulkOperation, dryRun bool,
+) ([]*domain.CardBulkItemResult, error) {
+	const op = "card.repository.ApplyBulk"
+	var results []*domain.CardBulkItemResult
+	err := utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		results = make([]*domain.CardBulkItemResult, 0, len(ops))
+		last, err := lockBulkColumns(ctx, tx, ops)
+		if err != nil {
+			return err
+		}
+		failed := false
+		for _, item := range ops {
+			result := &domain.CardBulkItemResult{
+				CardID: item.CardID,
+				Op:     item.Op,
+			}
+			itemErr := applyBulkOperation(ctx, tx, boardID, item, last, result)
+			if itemErr != nil && !isBulkItemError(itemErr) {
+				return itemErr
+			}
+			failed = failed || itemErr != nil
+			result.Err = itemErr
+			results = append(results, result)
+		}
+		if failed || dryRun {
+			return errBulkRollback
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errBulkRollback) {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-	defer tx.Rollback()
-	moveToTempPosition := `
-		UPDATE cards SET position = $1 WHERE id = $2 AND board_id = $3 AND column_id = $4 AND deleted_at IS NULL
-	`
-	formPositionInt := -int64(fromCardPosition)
-	_, err = tx.ExecContext(ctx, moveToTempPosition, formPositionInt, cardID, uuid, fromColumnID)
+	return results, nil
+}
+
+func (r *CardRepository) IsBoardEditable(ctx context.Context, userID uint64, boardID string) (bool, error) {
+	const op = "card.repository.IsBoardEditable"
+	editable, err := utils.ExistsQueryWrapper(ctx, r.storage, existsEditableBoardQuery, boardID, userID)
 	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return editable, nil
+}
+
+// Transfer переносит или копирует карточку в конец колонки другой доски и возвращает id карточки на новом месте.
+// Позиции исходной колонки считаются по ключам rank, поэтому место ушедшей карточки закрывается само.
+func (r *CardRepository) Transfer(ctx context.Context, cmd *domain.CardTransferCommand) (uint64, error) {
+	const op = "card.repository.Transfer"
+	var cardID uint64
+	err := utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		exists, err := utils.ExistsQueryWrapper(ctx, tx, existsBoardColumnQuery, cmd.TargetColumnID, cmd.TargetBoardID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return domain.ErrColumnNotExist
+		}
+		if err := lockColumn(ctx, tx, cmd.TargetColumnID); err != nil {
+			return err
+		}
+
+		source := &domain.Card{ID: cmd.CardID}
+		err = tx.QueryRowContext(ctx, selectTransferCardQuery, cmd.CardID, cmd.BoardID).Scan(
+			&source.Text,
+			&source.Description,
+			&source.CardProperties,
+			&source.DueDate,
+		)
 		if errors.Is(err, sql.ErrNoRows) {
-			return fmt.Errorf("%s: %w", op, domain.ErrCardNotFound)
+			return domain.ErrCardNotFound
 		}
-		return fmt.Errorf("%s: %w", op, err)
-	}
-	if fromColumnID == toColumnID {
-		var shiftPositionQuery string
-		if fromCardPosition < toCardPosition {
-			// to right
-			shiftPositionQuery = "UPDATE cards SET position = position - 1 WHERE position > $1 AND position < $2 AND column_id = $3 AND deleted_at IS NULL"
+		if err != nil {
+			return err
+		}
+		if source.CardProperties.Tag, err = boardTag(ctx, tx, cmd.TargetBoardID, source.Tag); err != nil {
+			return err
+		}
+		var last string
+		if err := tx.QueryRowContext(ctx, selectLastRankQuery, cmd.TargetColumnID).Scan(&last); err != nil {
+			return err
+		}
+		if source.Rank, err = rank.Between(last, ""); err != nil {
+			return err
+		}
+
+		if cmd.Mode == domain.TransferMove {
+			cardID = cmd.CardID
+			err = moveCardToBoard(ctx, tx, cmd, source)
 		} else {
-			// to left
-			shiftPositionQuery = "UPDATE cards SET position = position + 1 WHERE position < $1 AND position > $2 AND column_id = $3 AND deleted_at IS NULL"
+			cardID, err = copyCardToBoard(ctx, tx, cmd, source)
 		}
-		_, err := tx.ExecContext(ctx, shiftPositionQuery, fromCardPosition, toCardPosition, fromColumnID)
 		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
+			return err
 		}
+		return writeTransferAudit(ctx, tx, cmd, cardID)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
 	}
+	return cardID, nil
+}
 
-	if fromColumnID != toColumnID {
-		var shiftColumnFromPositionQuery, shiftColumnToPositionQuery string
-		shiftColumnFromPositionQuery = `
-			UPDATE cards SET position = position - 1 WHERE position > $1 AND board_id = $2 AND column_id = $3 AND deleted_at IS NULL
-		`
-		_, err = tx.ExecContext(ctx, shiftColumnFromPositionQuery, fromCardPosition, uuid, fromColumnID)
-		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
+// boardTag приводит тег к написанию, уже принятому на доске boardID; незнакомый доске тег остаётся как есть
+func boardTag(ctx context.Context, tx *sql.Tx, boardID, tag string) (string, error) {
+	if tag == "" {
+		return "", nil
+	}
+	var existing string
+	err := tx.QueryRowContext(ctx, selectBoardTagQuery, boardID, tag).Scan(&existing)
+	if errors.Is(err, sql.ErrNoRows) {
+		return tag, nil
+	}
+	return existing, err
+}
+
+// moveCardToBoard переносит саму карточку: комментарии и связи остаются с ней, а подзадачи, родитель
+// и спринты относятся к исходной доске, поэтому карточку с подзадачами перенести нельзя
+func moveCardToBoard(ctx context.Context, tx *sql.Tx, cmd *domain.CardTransferCommand, card *domain.Card) error {
+	hasChildren, err := utils.ExistsQueryWrapper(ctx, tx, existsCardChildrenQuery, card.ID)
+	if err != nil {
+		return err
+	}
+	if hasChildren {
+		return sharedErrors.ErrCardHasChildren
+	}
+	blocked, err := utils.ExistsQueryWrapper(ctx, tx, blockedByDoneColumnQuery, card.ID, cmd.TargetColumnID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return domain.ErrCardBlocked
+	}
+	if _, err := tx.ExecContext(
+		ctx,
+		moveCardToBoardQuery,
+		cmd.TargetBoardID,
+		cmd.TargetColumnID,
+		card.Rank,
+		card.CardProperties,
+		card.ID,
+	); err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM sprint_cards WHERE card_id = $1", card.ID)
+	return err
+}
+
+func copyCardToBoard(ctx context.Context, tx *sql.Tx, cmd *domain.CardTransferCommand, card *domain.Card) (uint64, error) {
+	var cardID uint64
+	if err := tx.QueryRowContext(
+		ctx,
+		copyCardToBoardQuery,
+		cmd.TargetBoardID,
+		cmd.TargetColumnID,
+		card.Text,
+		card.Description,
+		card.Rank,
+		card.CardProperties,
+		cmd.UserID,
+		card.DueDate,
+	).Scan(&cardID); err != nil {
+		return 0, err
+	}
+	if cmd.WithComments {
+		if _, err := tx.ExecContext(ctx, copyCardCommentsQuery, cardID, card.ID); err != nil {
+			return 0, err
 		}
+	}
+	return cardID, nil
+}
 
-		shiftColumnToPositionQuery = `
-			UPDATE cards SET position = position + 1 WHERE position >= $1 AND board_id = $2 AND column_id = $3 AND deleted_at IS NULL
-		`
-		_, err = tx.ExecContext(ctx, shiftColumnToPositionQuery, toCardPosition, uuid, toColumnID)
-		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
+type transferAuditDetails struct {
+	SourceBoardID string `json:"source_board_id"`
+	SourceCardID  uint64 `json:"source_card_id"`
+	TargetBoardID string `json:"target_board_id"`
+	TargetCardID  uint64 `json:"target_card_id"`
+}
+
+// writeTransferAudit записывает событие в журнал обеих досок
+func writeTransferAudit(ctx context.Context, tx *sql.Tx, cmd *domain.CardTransferCommand, cardID uint64) error {
+	outAction, inAction := domain.AuditCardMovedOut, domain.AuditCardMovedIn
+	if cmd.Mode == domain.TransferCopy {
+		outAction, inAction = domain.AuditCardCopiedOut, domain.AuditCardCopiedIn
+	}
+	details, err := json.Marshal(transferAuditDetails{
+		SourceBoardID: cmd.BoardID,
+		SourceCardID:  cmd.CardID,
+		TargetBoardID: cmd.TargetBoardID,
+		TargetCardID:  cardID,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, insertBoardAuditQuery, cmd.BoardID, cmd.UserID, outAction, cmd.CardID, details); err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, insertBoardAuditQuery, cmd.TargetBoardID, cmd.UserID, inAction, cardID, details)
+	return err
+}
+
+// isBulkItemError отличает отказ отдельной операции пакета от ошибки базы
+func isBulkItemError(err error) bool {
+	return errors.Is(err, domain.ErrCardNotFound) ||
+		errors.Is(err, domain.ErrColumnNotExist) ||
+		errors.Is(err, domain.ErrCardBlocked) ||
+		errors.Is(err, domain.ErrInvalidBulkOperation)
+}
+
+// lockBulkColumns блокирует колонки, куда переносятся карточки, по возрастанию id, чтобы встречные пакеты
+// не ждали друг друга, и один раз читает ключ конца каждой колонки
+func lockBulkColumns(ctx context.Context, tx *sql.Tx, ops []*domain.CardBulkOperation) (map[uint64]string, error) {
+	columnIDs := make([]uint64, 0, len(ops))
+	for _, item := range ops {
+		if item.Op == domain.BulkOpMove {
+			columnIDs = append(columnIDs, item.ColumnID)
 		}
 	}
+	slices.Sort(columnIDs)
+	columnIDs = slices.Compact(columnIDs)
 
-	moveFromTempTo := `
-		UPDATE cards SET position = $1, column_id = $2, updated_at = NOW()
-		WHERE id = $3 AND board_id = $4 AND deleted_at IS NULL
-	`
-	_, err = tx.ExecContext(ctx, moveFromTempTo, toCardPosition, toColumnID, cardID, uuid)
+	last := make(map[uint64]string, len(columnIDs))
+	for _, columnID := range columnIDs {
+		if err := lockColumn(ctx, tx, columnID); err != nil {
+			return nil, err
+		}
+		var key string
+		if err := tx.QueryRowContext(ctx, selectLastRankQuery, columnID).Scan(&key); err != nil {
+			return nil, err
+		}
+		last[columnID] = key
+	}
+	return last, nil
+}
+
+// applyBulkOperation выполняет одну операцию пакета; перенесённые карточки встают в конец колонки
+// в порядке пакета, last хранит текущий конец каждой колонки. В result записывается то, что нужно
+// для событий после сохранения: прежняя колонка и изменившиеся свойства.
+func applyBulkOperation(
+	ctx context.Context, tx *sql.Tx, boardID string, item *domain.CardBulkOperation, last map[uint64]string,
+	result *domain.CardBulkItemResult,
+) error {
+	exists, err := utils.ExistsQueryWrapper(ctx, tx, existsLiveCardQuery, item.CardID, boardID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return fmt.Errorf("%s: %w", op, domain.ErrCardNotFound)
+		return err
+	}
+	if !exists {
+		return domain.ErrCardNotFound
+	}
+
+	switch item.Op {
+	case domain.BulkOpMove:
+		exists, err := utils.ExistsQueryWrapper(ctx, tx, existsBoardColumnQuery, item.ColumnID, boardID)
+		if err != nil {
+			return err
 		}
+		if !exists {
+			return domain.ErrColumnNotExist
+		}
+		blocked, err := utils.ExistsQueryWrapper(ctx, tx, blockedByDoneColumnQuery, item.CardID, item.ColumnID)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return domain.ErrCardBlocked
+		}
+		key, err := rank.Between(last[item.ColumnID], "")
+		if err != nil {
+			return err
+		}
+		last[item.ColumnID] = key
+		return tx.QueryRowContext(ctx, bulkMoveCardQuery, key, item.ColumnID, item.CardID, boardID).
+			Scan(&result.FromColumnID)
+	case domain.BulkOpSetProperties:
+		if item.Properties == nil {
+			return domain.ErrInvalidBulkOperation
+		}
+		patch, err := json.Marshal(item.Properties)
+		if err != nil {
+			return err
+		}
+		var before, after domain.CardProperties
+		err = tx.QueryRowContext(ctx, bulkSetPropertiesQuery, patch, item.CardID, boardID).Scan(&before, &after)
+		if err != nil {
+			return err
+		}
+		result.Changed = domain.ChangedProperties(before, after)
+		return nil
+	case domain.BulkOpDelete:
+		_, err := tx.ExecContext(ctx, bulkDeleteCardQuery, item.CardID, boardID)
+		return err
+	}
+	return domain.ErrInvalidBulkOperation
+}
+
+func (r *CardRepository) RebalanceColumn(ctx context.Context, columnID uint64) error {
+	const op = "card.repository.RebalanceColumn"
+	err := utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		if err := lockColumn(ctx, tx, columnID); err != nil {
+			return err
+		}
+		return rebalanceColumn(ctx, tx, columnID)
+	})
+	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
-	return tx.Commit()
+	return nil
+}
+
+func lockColumn(ctx context.Context, tx *sql.Tx, columnID uint64) error {
+	return utils.LockTx(ctx, tx, utils.CardColumnLockKey(columnID))
+}
+
+// rankAt подбирает ключ для позиции position колонки без учёта карточки cardID.
+// Совпавшие ключи соседей остаются от данных до блокировок: колонка перестраивается, и подбор повторяется.
+func rankAt(ctx context.Context, tx *sql.Tx, columnID, cardID, position uint64) (string, error) {
+	var prev, next string
+	if err := tx.QueryRowContext(ctx, selectNeighborRanksQuery, columnID, cardID, position).Scan(&prev, &next); err != nil {
+		return "", err
+	}
+	key, err := rank.Between(prev, next)
+	if !errors.Is(err, rank.ErrInvalidRange) {
+		return key, err
+	}
+	if err := rebalanceColumn(ctx, tx, columnID); err != nil {
+		return "", err
+	}
+	if err := tx.QueryRowContext(ctx, selectNeighborRanksQuery, columnID, cardID, position).Scan(&prev, &next); err != nil {
+		return "", err
+	}
+	return rank.Between(prev, next)
+}
+
+func rebalanceColumn(ctx context.Context, tx *sql.Tx, columnID uint64) error {
+	return utils.SpreadRanks(ctx, tx, "cards", "column_id", columnID)
 }
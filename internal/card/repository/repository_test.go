@@ -0,0 +1,522 @@
+package card
+
+import (
+	"backend/internal/card/domain"
+	"backend/internal/shared/testdb"
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestBoard(t *testing.T, storage *testdb.Storage, columns int) (string, []uint64) {
+	t.Helper()
+	var userID uint64
+	var boardID string
+	email := fmt.Sprintf("card-repository-%d@test.local", time.Now().UnixNano())
+	require.NoError(t, storage.QueryRow(
+		"INSERT INTO users (name, email, password, refresh_token) VALUES ('test', $1, '', '') RETURNING id", email,
+	).Scan(&userID))
+	require.NoError(t, storage.QueryRow(
+		"INSERT INTO boards (name, user_id, key) VALUES ('test', $1, 'TST') RETURNING id", userID,
+	).Scan(&boardID))
+
+	columnIDs := make([]uint64, 0, columns)
+	for i := range columns {
+		var columnID uint64
+		require.NoError(t, storage.QueryRow(
+			"INSERT INTO board_columns (board_id, name, rank) VALUES ($1, $2, $3) RETURNING id",
+			boardID, fmt.Sprintf("column %d", i), fmt.Sprintf("%d", i+1),
+		).Scan(&columnID))
+		columnIDs = append(columnIDs, columnID)
+	}
+
+	t.Cleanup(func() {
+		storage.Exec("DELETE FROM cards WHERE board_id = $1", boardID)
+		storage.Exec("DELETE FROM board_columns WHERE board_id = $1", boardID)
+		storage.Exec("DELETE FROM boards WHERE id = $1", boardID)
+		storage.Exec("DELETE FROM users WHERE id = $1", userID)
+	})
+	return boardID, columnIDs
+}
+
+// assertUniqueRanks проверяет сохранённые строки: на доске total живых карточек,
+// и в каждой колонке их ключи непустые и не повторяются
+func assertUniqueRanks(t *testing.T, storage *testdb.Storage, boardID string, total int) {
+	t.Helper()
+	rows, err := storage.Query(`
+		SELECT id, column_id, rank FROM cards
+		WHERE board_id = $1 AND deleted_at IS NULL AND archived_at IS NULL
+		ORDER BY column_id, rank, id
+	`, boardID)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	count := 0
+	lastRank := map[uint64]string{}
+	for rows.Next() {
+		var id, columnID uint64
+		var rank string
+		require.NoError(t, rows.Scan(&id, &columnID, &rank))
+		assert.Greater(t, rank, lastRank[columnID], "card %d", id)
+		lastRank[columnID] = rank
+		count++
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, total, count)
+}
+
+func Test_ConcurrentCreate(t *testing.T) {
+	storage := testdb.Open(t)
+	repo := NewCardRepository(storage)
+	boardID, columnIDs := createTestBoard(t, storage, 1)
+
+	const workers, cardsPerWorker = 8, 10
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*cardsPerWorker)
+	for worker := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range cardsPerWorker {
+				errs <- repo.Create(context.Background(), &domain.Card{
+					BoardID:  boardID,
+					ColumnID: columnIDs[0],
+					Text:     fmt.Sprintf("card %d-%d", worker, i),
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+
+	assertUniqueRanks(t, storage, boardID, workers*cardsPerWorker)
+}
+
+func Test_ConcurrentMoveToNewPosition(t *testing.T) {
+	storage := testdb.Open(t)
+	repo := NewCardRepository(storage)
+	boardID, columnIDs := createTestBoard(t, storage, 3)
+	ctx := context.Background()
+
+	const cardsPerColumn, workers, movesPerWorker = 10, 8, 30
+	for _, columnID := range columnIDs {
+		for i := range cardsPerColumn {
+			require.NoError(t, repo.Create(ctx, &domain.Card{
+				BoardID:  boardID,
+				ColumnID: columnID,
+				Text:     fmt.Sprintf("card %d", i),
+			}))
+		}
+	}
+	cards, err := repo.GetListWithComments(ctx, boardID, nil)
+	require.NoError(t, err)
+	cardIDs := make([]uint64, 0, len(cards))
+	for _, card := range cards {
+		cardIDs = append(cardIDs, card.ID)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*movesPerWorker)
+	for worker := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			random := rand.New(rand.NewPCG(uint64(worker), 0))
+			for range movesPerWorker {
+				errs <- repo.MoveToNewPosition(
+					ctx,
+					boardID,
+					cardIDs[random.IntN(len(cardIDs))],
+					columnIDs[random.IntN(len(columnIDs))],
+					uint64(random.IntN(cardsPerColumn*2)+1),
+				)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+
+	assertUniqueRanks(t, storage, boardID, len(cardIDs))
+}
+
+func Test_MoveToNewPositionPlacesCard(t *testing.T) {
+	storage := testdb.Open(t)
+	repo := NewCardRepository(storage)
+	boardID, columnIDs := createTestBoard(t, storage, 1)
+	ctx := context.Background()
+
+	for i := range 5 {
+		require.NoError(t, repo.Create(ctx, &domain.Card{BoardID: boardID, ColumnID: columnIDs[0], Text: fmt.Sprintf("card %d", i)}))
+	}
+	cards, err := repo.GetListWithComments(ctx, boardID, nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		cardID   uint64
+		position uint64
+	}{
+		{name: "to top", cardID: cards[4].ID, position: 1},
+		{name: "to bottom", cardID: cards[0].ID, position: 5},
+		{name: "to middle", cardID: cards[1].ID, position: 3},
+		{name: "past the end", cardID: cards[2].ID, position: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, repo.MoveToNewPosition(ctx, boardID, tt.cardID, columnIDs[0], tt.position))
+			card, err := repo.GetById(ctx, &domain.Card{ID: tt.cardID})
+			require.NoError(t, err)
+			assert.Equal(t, min(tt.position, 5), card.Position)
+			assertUniqueRanks(t, storage, boardID, 5)
+		})
+	}
+}
+
+func Test_ArchiveAndRestore(t *testing.T) {
+	storage := testdb.Open(t)
+	repo := NewCardRepository(storage)
+	boardID, columnIDs := createTestBoard(t, storage, 1)
+	ctx := context.Background()
+
+	for i := range 4 {
+		require.NoError(t, repo.Create(ctx, &domain.Card{BoardID: boardID, ColumnID: columnIDs[0], Text: fmt.Sprintf("card %d", i)}))
+	}
+	cards, err := repo.GetListWithComments(ctx, boardID, nil)
+	require.NoError(t, err)
+	archived := &domain.Card{ID: cards[1].ID, BoardID: boardID, ColumnID: columnIDs[0]}
+
+	require.NoError(t, repo.Archive(ctx, archived))
+	assert.ErrorIs(t, repo.Archive(ctx, archived), domain.ErrCardArchived)
+	assertUniqueRanks(t, storage, boardID, 3)
+	all, err := repo.GetListWithComments(ctx, boardID, &domain.CardListFilter{Archived: true})
+	require.NoError(t, err)
+	assert.Len(t, all, 4)
+
+	tests := []struct {
+		name     string
+		occupy   bool
+		position uint64
+	}{
+		{name: "original position", position: 2},
+		{name: "position taken", occupy: true, position: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.occupy {
+				require.NoError(t, repo.Archive(ctx, archived))
+				_, err := storage.Exec("UPDATE cards SET rank = $1 WHERE id = $2", cards[1].Rank, cards[2].ID)
+				require.NoError(t, err)
+			}
+			require.NoError(t, repo.Restore(ctx, archived))
+			card, err := repo.GetById(ctx, archived)
+			require.NoError(t, err)
+			assert.Nil(t, card.ArchivedAt)
+			assert.Equal(t, tt.position, card.Position)
+			assert.ErrorIs(t, repo.Restore(ctx, archived), domain.ErrCardNotArchived)
+		})
+	}
+}
+
+func Test_SearchHidesArchived(t *testing.T) {
+	storage := testdb.Open(t)
+	repo := NewCardRepository(storage)
+	boardID, columnIDs := createTestBoard(t, storage, 2)
+	ctx := context.Background()
+	var userID uint64
+	require.NoError(t, storage.QueryRow("SELECT user_id FROM boards WHERE id = $1", boardID).Scan(&userID))
+
+	for i, columnID := range []uint64{columnIDs[0], columnIDs[0], columnIDs[1]} {
+		require.NoError(t, repo.Create(ctx, &domain.Card{BoardID: boardID, ColumnID: columnID, Text: fmt.Sprintf("card %d", i)}))
+	}
+	cards, err := repo.GetListWithComments(ctx, boardID, nil)
+	require.NoError(t, err)
+	require.Len(t, cards, 3)
+	byColumn := map[uint64][]uint64{}
+	for _, card := range cards {
+		byColumn[card.ColumnID] = append(byColumn[card.ColumnID], card.ID)
+	}
+	archivedID, liveID, otherColumnID := byColumn[columnIDs[0]][0], byColumn[columnIDs[0]][1], byColumn[columnIDs[1]][0]
+	require.NoError(t, repo.Archive(ctx, &domain.Card{ID: archivedID, BoardID: boardID, ColumnID: columnIDs[0]}))
+	_, err = storage.Exec("UPDATE board_columns SET archived_at = NOW() WHERE id = $1", columnIDs[1])
+	require.NoError(t, err)
+
+	search := func(archived bool) []uint64 {
+		items, total, err := repo.Search(ctx, &domain.CardSearchQuery{UserID: userID, Page: 1, PerPage: 10, Archived: archived})
+		require.NoError(t, err)
+		ids := []uint64{}
+		for _, item := range items {
+			ids = append(ids, item.ID)
+		}
+		assert.Equal(t, uint64(len(ids)), total)
+		return ids
+	}
+	assert.ElementsMatch(t, []uint64{liveID}, search(false))
+	assert.ElementsMatch(t, []uint64{archivedID, liveID, otherColumnID}, search(true))
+}
+
+func Test_ApplyBulk(t *testing.T) {
+	storage := testdb.Open(t)
+	repo := NewCardRepository(storage)
+	boardID, columnIDs := createTestBoard(t, storage, 2)
+	ctx := context.Background()
+
+	for i := range 4 {
+		require.NoError(t, repo.Create(ctx, &domain.Card{BoardID: boardID, ColumnID: columnIDs[0], Text: fmt.Sprintf("card %d", i)}))
+	}
+	cards, err := repo.GetListWithComments(ctx, boardID, nil)
+	require.NoError(t, err)
+	tag := "urgent"
+
+	moves := []*domain.CardBulkOperation{
+		{Op: domain.BulkOpMove, CardID: cards[2].ID, ColumnID: columnIDs[1]},
+		{Op: domain.BulkOpMove, CardID: cards[0].ID, ColumnID: columnIDs[1]},
+		{Op: domain.BulkOpSetProperties, CardID: cards[1].ID, Properties: &domain.CardPropertiesPatch{Tag: &tag}},
+	}
+
+	t.Run("rejected batch is rolled back", func(t *testing.T) {
+		ops := append(slices.Clone(moves), &domain.CardBulkOperation{Op: domain.BulkOpDelete, CardID: math.MaxInt32})
+		results, err := repo.ApplyBulk(ctx, boardID, ops, false)
+		require.NoError(t, err)
+		require.Len(t, results, 4)
+		assert.NoError(t, results[0].Err)
+		assert.ErrorIs(t, results[3].Err, domain.ErrCardNotFound)
+
+		card, err := repo.GetById(ctx, &domain.Card{ID: cards[2].ID})
+		require.NoError(t, err)
+		assert.Equal(t, columnIDs[0], card.ColumnID)
+	})
+
+	t.Run("dry run is rolled back", func(t *testing.T) {
+		results, err := repo.ApplyBulk(ctx, boardID, moves, true)
+		require.NoError(t, err)
+		for _, result := range results {
+			assert.NoError(t, result.Err)
+		}
+		card, err := repo.GetById(ctx, &domain.Card{ID: cards[2].ID})
+		require.NoError(t, err)
+		assert.Equal(t, columnIDs[0], card.ColumnID)
+	})
+
+	t.Run("moves are appended in batch order", func(t *testing.T) {
+		results, err := repo.ApplyBulk(ctx, boardID, moves, false)
+		require.NoError(t, err)
+		assert.Equal(t, columnIDs[0], results[0].FromColumnID)
+		assert.Equal(t, []string{"tag"}, results[2].Changed)
+
+		first, err := repo.GetById(ctx, &domain.Card{ID: cards[2].ID})
+		require.NoError(t, err)
+		second, err := repo.GetById(ctx, &domain.Card{ID: cards[0].ID})
+		require.NoError(t, err)
+		assert.Equal(t, columnIDs[1], first.ColumnID)
+		assert.Equal(t, uint64(1), first.Position)
+		assert.Equal(t, uint64(2), second.Position)
+		assert.Equal(t, cards[2].Version+1, first.Version)
+		assertUniqueRanks(t, storage, boardID, 4)
+	})
+
+	t.Run("archived card is not found", func(t *testing.T) {
+		require.NoError(t, repo.Archive(ctx, &domain.Card{ID: cards[3].ID, BoardID: boardID}))
+		results, err := repo.ApplyBulk(ctx, boardID, []*domain.CardBulkOperation{
+			{Op: domain.BulkOpSetProperties, CardID: cards[3].ID, Properties: &domain.CardPropertiesPatch{Tag: &tag}},
+		}, false)
+		require.NoError(t, err)
+		assert.ErrorIs(t, results[0].Err, domain.ErrCardNotFound)
+	})
+}
+
+func Test_Transfer(t *testing.T) {
+	storage := testdb.Open(t)
+	repo := NewCardRepository(storage)
+	sourceID, sourceColumns := createTestBoard(t, storage, 1)
+	targetID, targetColumns := createTestBoard(t, storage, 1)
+	ctx := context.Background()
+
+	for i := range 3 {
+		require.NoError(t, repo.Create(ctx, &domain.Card{
+			BoardID:        sourceID,
+			ColumnID:       sourceColumns[0],
+			Text:           fmt.Sprintf("card %d", i),
+			CardProperties: domain.CardProperties{Tag: "Bug"},
+		}))
+	}
+	require.NoError(t, repo.Create(ctx, &domain.Card{
+		BoardID:        targetID,
+		ColumnID:       targetColumns[0],
+		Text:           "existing",
+		CardProperties: domain.CardProperties{Tag: "bug"},
+	}))
+	cards, err := repo.GetListWithComments(ctx, sourceID, nil)
+	require.NoError(t, err)
+
+	t.Run("copy keeps the source card", func(t *testing.T) {
+		cardID, err := repo.Transfer(ctx, &domain.CardTransferCommand{
+			BoardID:        sourceID,
+			CardID:         cards[0].ID,
+			Mode:           domain.TransferCopy,
+			TargetBoardID:  targetID,
+			TargetColumnID: targetColumns[0],
+		})
+		require.NoError(t, err)
+		assert.NotEqual(t, cards[0].ID, cardID)
+		assertUniqueRanks(t, storage, sourceID, 3)
+		assertUniqueRanks(t, storage, targetID, 2)
+	})
+
+	t.Run("move closes the source gap and remaps the tag", func(t *testing.T) {
+		cardID, err := repo.Transfer(ctx, &domain.CardTransferCommand{
+			BoardID:        sourceID,
+			CardID:         cards[1].ID,
+			Mode:           domain.TransferMove,
+			TargetBoardID:  targetID,
+			TargetColumnID: targetColumns[0],
+		})
+		require.NoError(t, err)
+		assert.Equal(t, cards[1].ID, cardID)
+		assertUniqueRanks(t, storage, sourceID, 2)
+		assertUniqueRanks(t, storage, targetID, 3)
+		moved, err := repo.GetById(ctx, &domain.Card{ID: cardID})
+		require.NoError(t, err)
+		assert.Equal(t, targetID, moved.BoardID)
+		item, err := repo.GetListWithComments(ctx, targetID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "bug", item[2].Tag)
+	})
+
+	t.Run("unknown column", func(t *testing.T) {
+		_, err := repo.Transfer(ctx, &domain.CardTransferCommand{
+			BoardID:        sourceID,
+			CardID:         cards[2].ID,
+			Mode:           domain.TransferMove,
+			TargetBoardID:  targetID,
+			TargetColumnID: sourceColumns[0],
+		})
+		assert.ErrorIs(t, err, domain.ErrColumnNotExist)
+	})
+}
+
+func Test_CardTemplates(t *testing.T) {
+	storage := testdb.Open(t)
+	repo := NewCardRepository(storage)
+	boardID, _ := createTestBoard(t, storage, 1)
+	ctx := context.Background()
+
+	template := &domain.CardTemplate{
+		BoardID:        boardID,
+		Name:           "bug",
+		Text:           "Bug: ",
+		Description:    "Steps to reproduce",
+		CardProperties: domain.CardProperties{Color: "#F44336", Tag: "bug"},
+	}
+	require.NoError(t, repo.CreateTemplate(ctx, template))
+	require.NotZero(t, template.ID)
+	assert.ErrorIs(t, repo.CreateTemplate(ctx, &domain.CardTemplate{
+		BoardID: boardID, Name: "bug", Text: "other", Description: "other",
+	}), domain.ErrCardTemplateNameTaken)
+
+	template.Description = "Steps, expected and actual result"
+	template.Estimate = 3
+	require.NoError(t, repo.UpdateTemplate(ctx, template))
+
+	stored, err := repo.GetTemplate(ctx, boardID, template.ID)
+	require.NoError(t, err)
+	assert.Equal(t, template.Description, stored.Description)
+	assert.Equal(t, domain.CardProperties{Color: "#F44336", Tag: "bug", Estimate: 3}, stored.CardProperties)
+
+	templates, err := repo.GetTemplateList(ctx, boardID)
+	require.NoError(t, err)
+	assert.Len(t, templates, 1)
+
+	require.NoError(t, repo.DeleteTemplate(ctx, boardID, template.ID))
+	_, err = repo.GetTemplate(ctx, boardID, template.ID)
+	assert.ErrorIs(t, err, domain.ErrCardTemplateNotFound)
+	assert.ErrorIs(t, repo.UpdateTemplate(ctx, template), domain.ErrCardTemplateNotFound)
+}
+
+func Test_MaterializeRecurrence(t *testing.T) {
+	storage := testdb.Open(t)
+	repo := NewCardRepository(storage)
+	boardID, columnIDs := createTestBoard(t, storage, 1)
+	ctx := context.Background()
+
+	start := time.Now().UTC().Truncate(time.Second).Add(-time.Hour)
+	recurrence := &domain.CardRecurrence{
+		BoardID:     boardID,
+		ColumnID:    columnIDs[0],
+		Text:        "rotate on-call",
+		Description: "weekly chore",
+		Rule:        "FREQ=DAILY",
+		StartsAt:    start,
+		NextRunAt:   start,
+	}
+	require.NoError(t, repo.CreateRecurrence(ctx, recurrence))
+
+	due, err := repo.GetDueRecurrences(ctx, time.Now(), 100)
+	require.NoError(t, err)
+	assert.True(t, slices.ContainsFunc(due, func(r *domain.CardRecurrence) bool { return r.ID == recurrence.ID }))
+
+	// повторения архивной колонки не наступают, пока её не восстановят
+	_, err = storage.Exec("UPDATE board_columns SET archived_at = NOW() WHERE id = $1", columnIDs[0])
+	require.NoError(t, err)
+	due, err = repo.GetDueRecurrences(ctx, time.Now(), 100)
+	require.NoError(t, err)
+	assert.False(t, slices.ContainsFunc(due, func(r *domain.CardRecurrence) bool { return r.ID == recurrence.ID }))
+	_, err = storage.Exec("UPDATE board_columns SET archived_at = NULL WHERE id = $1", columnIDs[0])
+	require.NoError(t, err)
+
+	// вставка в несуществующую колонку откатывает и сдвиг next_run_at
+	_, err = repo.MaterializeRecurrence(ctx, recurrence, start.Add(24*time.Hour), []*domain.Card{
+		{BoardID: boardID, ColumnID: math.MaxInt32, Text: recurrence.Text},
+	})
+	require.Error(t, err)
+	due, err = repo.GetDueRecurrences(ctx, time.Now(), 100)
+	require.NoError(t, err)
+	assert.True(t, slices.ContainsFunc(due, func(r *domain.CardRecurrence) bool { return r.ID == recurrence.ID }))
+
+	// два экземпляра пытаются забрать одно повторение: выигрывает только один
+	next := start.Add(24 * time.Hour)
+	var wg sync.WaitGroup
+	claims := make(chan bool, 2)
+	for range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			claimed, err := repo.MaterializeRecurrence(ctx, recurrence, next, []*domain.Card{
+				{BoardID: boardID, ColumnID: columnIDs[0], Text: recurrence.Text},
+			})
+			assert.NoError(t, err)
+			claims <- claimed
+		}()
+	}
+	wg.Wait()
+	close(claims)
+	won := 0
+	for claimed := range claims {
+		if claimed {
+			won++
+		}
+	}
+	assert.Equal(t, 1, won)
+	cards, err := repo.GetListWithComments(ctx, boardID, nil)
+	require.NoError(t, err)
+	assert.Len(t, cards, 1)
+
+	due, err = repo.GetDueRecurrences(ctx, time.Now(), 100)
+	require.NoError(t, err)
+	assert.False(t, slices.ContainsFunc(due, func(r *domain.CardRecurrence) bool { return r.ID == recurrence.ID }))
+
+	require.NoError(t, repo.DeleteRecurrence(ctx, boardID, recurrence.ID))
+}
@@ -0,0 +1,124 @@
+package card
+
+import (
+	"backend/internal/card/domain"
+	"backend/internal/shared/utils"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+const selectCardTemplateQuery = `
+	SELECT id, board_id, name, text, description, properties, created_at, updated_at
+	FROM card_templates
+	WHERE board_id = $1 AND deleted_at IS NULL
+`
+
+func (r *CardRepository) GetTemplateList(ctx context.Context, boardID string) ([]*domain.CardTemplate, error) {
+	const op = "card.repository.GetTemplateList"
+	rows, err := r.storage.QueryContext(ctx, selectCardTemplateQuery+" ORDER BY name, id", boardID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	templates := []*domain.CardTemplate{}
+	for rows.Next() {
+		template := &domain.CardTemplate{}
+		if err := scanCardTemplate(rows, template); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		templates = append(templates, template)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return templates, nil
+}
+
+func (r *CardRepository) GetTemplate(ctx context.Context, boardID string, id uint64) (*domain.CardTemplate, error) {
+	const op = "card.repository.GetTemplate"
+	template := &domain.CardTemplate{}
+	row := r.storage.QueryRowContext(ctx, selectCardTemplateQuery+" AND id = $2", boardID, id)
+	if err := scanCardTemplate(row, template); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, domain.ErrCardTemplateNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return template, nil
+}
+
+func (r *CardRepository) CreateTemplate(ctx context.Context, template *domain.CardTemplate) error {
+	const op = "card.repository.CreateTemplate"
+	query := `
+		INSERT INTO card_templates (board_id, name, text, description, properties)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, updated_at
+	`
+	err := r.storage.QueryRowContext(
+		ctx,
+		query,
+		template.BoardID,
+		template.Name,
+		template.Text,
+		template.Description,
+		template.CardProperties,
+	).Scan(&template.ID, &template.CreatedAt, &template.UpdatedAt)
+	if utils.IsUniqueViolation(err) {
+		return fmt.Errorf("%s: %w", op, domain.ErrCardTemplateNameTaken)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (r *CardRepository) UpdateTemplate(ctx context.Context, template *domain.CardTemplate) error {
+	const op = "card.repository.UpdateTemplate"
+	query := `
+		UPDATE card_templates
+		SET name = $1, text = $2, description = $3, properties = $4, updated_at = NOW()
+		WHERE id = $5 AND board_id = $6 AND deleted_at IS NULL
+		RETURNING created_at, updated_at
+	`
+	err := r.storage.QueryRowContext(
+		ctx,
+		query,
+		template.Name,
+		template.Text,
+		template.Description,
+		template.CardProperties,
+		template.ID,
+		template.BoardID,
+	).Scan(&template.CreatedAt, &template.UpdatedAt)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return fmt.Errorf("%s: %w", op, domain.ErrCardTemplateNotFound)
+	case utils.IsUniqueViolation(err):
+		return fmt.Errorf("%s: %w", op, domain.ErrCardTemplateNameTaken)
+	case err != nil:
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (r *CardRepository) DeleteTemplate(ctx context.Context, boardID string, id uint64) error {
+	const op = "card.repository.DeleteTemplate"
+	query := "UPDATE card_templates SET deleted_at = NOW() WHERE id = $1 AND board_id = $2 AND deleted_at IS NULL"
+	return utils.OpExec(ctx, r.storage.ExecContext, op, query, domain.ErrCardTemplateNotFound, id, boardID)
+}
+
+func scanCardTemplate(row scanner, template *domain.CardTemplate) error {
+	return row.Scan(
+		&template.ID,
+		&template.BoardID,
+		&template.Name,
+		&template.Text,
+		&template.Description,
+		&template.CardProperties,
+		&template.CreatedAt,
+		&template.UpdatedAt,
+	)
+}
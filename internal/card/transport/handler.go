@@ -3,6 +3,7 @@ package transport
 import (
 	"backend/internal/card/domain"
 	cardError "backend/internal/shared/errors"
+	queryFilter "backend/internal/shared/filter"
 	"backend/internal/shared/ports/http"
 	"backend/internal/shared/utils"
 	"context"
@@ -14,26 +15,66 @@ import (
 )
 
 const (
-	CardIDKey  = "card_id"
-	BoardIDKey = "id"
+	CardIDKey     = "card_id"
+	ChildIDKey    = "child_id"
+	CardKeyKey    = "key"
+	BoardIDKey    = "id"
+	FilterKey     = "q"
+	TemplateIDKey = "template_id"
+	RecurrenceKey = "recurrence_id"
+	ArchivedKey   = "archived"
 )
 
 const (
-	CreatedMessage = "created"
-	UpdatedMessage = "updated"
-	MovedMessage   = "moved"
+	BulkStatusOK     = "ok"
+	BulkStatusFailed = "failed"
+)
+
+const (
+	DefaultPage    = 1
+	DefaultPerPage = 20
+	NoDueDateKey   = "none"
+)
+
+const (
+	CreatedMessage  = "created"
+	UpdatedMessage  = "updated"
+	MovedMessage    = "moved"
+	ArchivedMessage = "archived"
+	RestoredMessage = "restored"
 )
 
 type LangMessage interface {
 	GetResponseMessage(ctx context.Context, key string) string
+	GetErrorMessage(ctx context.Context, key string, params map[string]string) string
 }
 
 type CardService interface {
-	GetListWithComments(ctx context.Context, boardID string) ([]*domain.CardWithComments, error)
+	GetListWithComments(ctx context.Context, boardID string, filter *domain.CardListFilter) ([]*domain.CardWithComments, error)
 	Create(ctx context.Context, req *domain.Card) error
+	Get(ctx context.Context, userID uint64, req *domain.Card, archived bool) (*domain.CardListItem, error)
 	Update(ctx context.Context, req *domain.Card) error
 	Delete(ctx context.Context, req *domain.Card) error
+	Archive(ctx context.Context, userID uint64, req *domain.Card) error
+	Restore(ctx context.Context, userID uint64, req *domain.Card) error
 	MoveToNewPosition(ctx context.Context, req *domain.CardMoveCommand) error
+	Transfer(ctx context.Context, cmd *domain.CardTransferCommand) (*domain.CardListItem, error)
+	Bulk(ctx context.Context, cmd *domain.CardBulkCommand) (*domain.CardBulkResult, error)
+	Search(ctx context.Context, query *domain.CardSearchQuery) (*domain.CardSearchResult, error)
+	GetMyCards(ctx context.Context, query *domain.MyCardsQuery) (*domain.MyCardsResult, error)
+	GetByKey(ctx context.Context, userID uint64, key string, archived bool) (*domain.CardListItem, error)
+	GetSubtree(ctx context.Context, req *domain.Card) (*domain.CardTreeNode, error)
+	AttachChild(ctx context.Context, req *domain.CardParentCommand) error
+	DetachChild(ctx context.Context, req *domain.CardParentCommand) error
+	GetTemplateList(ctx context.Context, userID uint64, boardID string) ([]*domain.CardTemplate, error)
+	GetTemplate(ctx context.Context, userID uint64, req *domain.CardTemplate) (*domain.CardTemplate, error)
+	CreateTemplate(ctx context.Context, userID uint64, req *domain.CardTemplate) (*domain.CardTemplate, error)
+	UpdateTemplate(ctx context.Context, userID uint64, req *domain.CardTemplate) (*domain.CardTemplate, error)
+	DeleteTemplate(ctx context.Context, userID uint64, req *domain.CardTemplate) error
+	GetRecurrenceList(ctx context.Context, userID uint64, boardID string) ([]*domain.CardRecurrence, error)
+	CreateRecurrence(ctx context.Context, userID uint64, req *domain.CardRecurrence) (*domain.CardRecurrence, error)
+	UpdateRecurrence(ctx context.Context, userID uint64, req *domain.CardRecurrence) (*domain.CardRecurrence, error)
+	DeleteRecurrence(ctx context.Context, userID uint64, req *domain.CardRecurrence) error
 }
 
 type CardHandler struct {
@@ -60,6 +101,17 @@ func (h *CardHandler) Create(c *fiber.Ctx) error {
 	}
 
 	body.BoardID = c.Params(BoardIDKey)
+	// поля шаблона подставляются до валидации, чтобы итоговая карточка проверялась целиком
+	if body.TemplateID != 0 {
+		template, err := h.cardService.GetTemplate(c.Context(), body.UserID, &domain.CardTemplate{
+			ID:      body.TemplateID,
+			BoardID: body.BoardID,
+		})
+		if err != nil {
+			return h.templateError(c, op, err)
+		}
+		h.cardMapper.ApplyCardTemplate(body, template)
+	}
 	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
 		if err != nil {
 			slog.Error("validator error",
@@ -91,6 +143,37 @@ func (h *CardHandler) Create(c *fiber.Ctx) error {
 	)
 }
 
+func (h *CardHandler) Get(c *fiber.Ctx) error {
+	const op = "card.transport.handler.Get"
+	userID, ok := c.Locals(utils.UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"errors": "Unauthorized"})
+	}
+	cardID, err := strconv.ParseUint(c.Params(CardIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid card ID"})
+	}
+
+	card, err := h.cardService.Get(c.Context(), userID, &domain.Card{
+		ID:      cardID,
+		BoardID: c.Params(BoardIDKey),
+	}, c.QueryBool(ArchivedKey))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCardNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Card not found"})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+	utils.SetETag(c, card.Version)
+	return c.JSON(h.cardMapper.ToCardListItemResponse(card))
+}
+
 func (h *CardHandler) Update(c *fiber.Ctx) error {
 	const op = "card.transport.handler.Update"
 	body, err := utils.ParseBody[CardRequest](c)
@@ -106,7 +189,63 @@ func (h *CardHandler) Update(c *fiber.Ctx) error {
 
 	body.BoardID = c.Params(BoardIDKey)
 	body.ID = cardIDUint64
+	body.Version, err = utils.IfMatchVersion(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": utils.ErrInvalidIfMatch.Error()})
+	}
+
+	return h.save(c, op, body)
+}
+
+// Patch применяет JSON Merge Patch к текущему состоянию карточки и сохраняет результат как Update
+func (h *CardHandler) Patch(c *fiber.Ctx) error {
+	const op = "card.transport.handler.Patch"
+	userID, ok := c.Locals(utils.UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"errors": "Unauthorized"})
+	}
+	cardID, err := strconv.ParseUint(c.Params(CardIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid card ID"})
+	}
+	version, err := utils.IfMatchVersion(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": utils.ErrInvalidIfMatch.Error()})
+	}
+
+	current, err := h.cardService.Get(c.Context(), userID, &domain.Card{
+		ID:      cardID,
+		BoardID: c.Params(BoardIDKey),
+	}, true)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCardNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Card not found"})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
 
+	body, err := utils.ParsePatch(c, h.cardMapper.ToCardRequest(current))
+	if err != nil {
+		if errors.Is(err, utils.ErrUnsupportedPatchType) {
+			return c.Status(fiber.StatusUnsupportedMediaType).JSON(fiber.Map{"errors": err.Error()})
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	body.ID = cardID
+	body.BoardID = c.Params(BoardIDKey)
+	body.ColumnID = current.ColumnID
+	body.Version = version
+
+	return h.save(c, op, body)
+}
+
+func (h *CardHandler) save(c *fiber.Ctx, op string, body *CardRequest) error {
 	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
 		if err != nil {
 			slog.Error("validator error",
@@ -122,6 +261,8 @@ func (h *CardHandler) Update(c *fiber.Ctx) error {
 		switch {
 		case errors.Is(err, domain.ErrCardNotFound):
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Card not found"})
+		case errors.Is(err, cardError.ErrVersionMismatch):
+			return h.versionConflict(c, op, body.UserID, body.BoardID, body.ID)
 		}
 		slog.Error(
 			"service error",
@@ -157,6 +298,10 @@ func (h *CardHandler) Delete(c *fiber.Ctx) error {
 			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
 				"errors": cardError.ErrCardHasComments.Error(),
 			})
+		case errors.Is(err, cardError.ErrCardHasChildren):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"errors": cardError.ErrCardHasChildren.Error(),
+			})
 		}
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 			"errors": domain.ErrCardNotFound.Error(),
@@ -166,6 +311,57 @@ func (h *CardHandler) Delete(c *fiber.Ctx) error {
 	return c.SendStatus(fiber.StatusNoContent)
 }
 
+// Archive убирает карточку в архив; комментарии карточки, в отличие от удаления, не мешают
+func (h *CardHandler) Archive(c *fiber.Ctx) error {
+	return h.changeArchive(c, "card.transport.handler.Archive", h.cardService.Archive, ArchivedMessage)
+}
+
+// Restore возвращает карточку на прежнее место или в конец колонки, если место занято
+func (h *CardHandler) Restore(c *fiber.Ctx) error {
+	return h.changeArchive(c, "card.transport.handler.Restore", h.cardService.Restore, RestoredMessage)
+}
+
+func (h *CardHandler) changeArchive(
+	c *fiber.Ctx, op string, action func(context.Context, uint64, *domain.Card) error, message string,
+) error {
+	userID, ok := c.Locals(utils.UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"errors": "Unauthorized"})
+	}
+	cardID, err := strconv.ParseUint(c.Params(CardIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid card ID"})
+	}
+
+	if err := action(c.Context(), userID, &domain.Card{
+		ID:      cardID,
+		BoardID: c.Params(BoardIDKey),
+	}); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBoardNotEditable):
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"errors": domain.ErrBoardNotEditable.Error()})
+		case errors.Is(err, domain.ErrCardNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": domain.ErrCardNotFound.Error()})
+		case errors.Is(err, domain.ErrColumnNotExist):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrColumnNotExist.Error()})
+		case errors.Is(err, domain.ErrCardArchived):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrCardArchived.Error()})
+		case errors.Is(err, domain.ErrCardNotArchived):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrCardNotArchived.Error()})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+
+	return c.JSON(fiber.Map{
+		"message": h.lang.GetResponseMessage(c.Context(), message),
+	})
+}
+
 func (h *CardHandler) MoveToNewPosition(c *fiber.Ctx) error {
 	const op = "card.transport.handler.MoveToNewPosition"
 	body, err := utils.ParseBody[CardMoveRequest](c)
@@ -196,6 +392,8 @@ func (h *CardHandler) MoveToNewPosition(c *fiber.Ctx) error {
 		switch {
 		case errors.Is(err, domain.ErrCardNotFound):
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Card not found"})
+		case errors.Is(err, domain.ErrCardBlocked):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrCardBlocked.Error()})
 		}
 		slog.Error(
 			"service error",
@@ -211,3 +409,571 @@ func (h *CardHandler) MoveToNewPosition(c *fiber.Ctx) error {
 		},
 	)
 }
+
+// Transfer переносит или копирует карточку на другую доску и отдаёт её с новым ключом
+func (h *CardHandler) Transfer(c *fiber.Ctx) error {
+	const op = "card.transport.handler.Transfer"
+	body, err := utils.ParseBody[CardTransferRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	body.CardID, err = strconv.ParseUint(c.Params(CardIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid card ID"})
+	}
+	body.BoardID = c.Params(BoardIDKey)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	card, err := h.cardService.Transfer(c.Context(), h.cardMapper.ToCardTransferCommand(body))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCardNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Card not found"})
+		case errors.Is(err, domain.ErrColumnNotExist):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": domain.ErrColumnNotExist.Error()})
+		case errors.Is(err, domain.ErrBoardNotEditable):
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"errors": domain.ErrBoardNotEditable.Error()})
+		case errors.Is(err, domain.ErrSameBoardMove):
+			return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{"errors": domain.ErrSameBoardMove.Error()})
+		case errors.Is(err, domain.ErrCardBlocked):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrCardBlocked.Error()})
+		case errors.Is(err, cardError.ErrCardHasChildren):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": cardError.ErrCardHasChildren.Error()})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+
+	status := fiber.StatusOK
+	if body.Mode == domain.TransferCopy {
+		status = fiber.StatusCreated
+	}
+	utils.SetETag(c, card.Version)
+	return c.Status(status).JSON(h.cardMapper.ToCardListItemResponse(card))
+}
+
+// Bulk выполняет пакет операций над карточками доски: 200 — пакет сохранён или пробный прогон прошёл,
+// 422 — хотя бы одна операция отклонена и ничего не сохранено
+func (h *CardHandler) Bulk(c *fiber.Ctx) error {
+	const op = "card.transport.handler.Bulk"
+	body, err := utils.ParseBody[CardBulkRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	body.BoardID = c.Params(BoardIDKey)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	result, err := h.cardService.Bulk(c.Context(), h.cardMapper.ToCardBulkCommand(body))
+	if err != nil {
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+
+	status := fiber.StatusOK
+	if result.Failed() {
+		status = fiber.StatusUnprocessableEntity
+	}
+	return c.Status(status).JSON(h.cardMapper.ToCardBulkResponse(result))
+}
+
+func (h *CardHandler) Search(c *fiber.Ctx) error {
+	const op = "card.transport.handler.Search"
+	query, err := utils.ParseQuery[CardSearchRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid query parameters"})
+	}
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, query); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	query.Filter, err = queryFilter.Parse(query.Query)
+	if err != nil {
+		return h.filterError(c, err)
+	}
+
+	result, err := h.cardService.Search(c.Context(), h.cardMapper.ToCardSearchQuery(query))
+	if err != nil {
+		switch {
+		case errors.As(err, new(*queryFilter.SyntaxError)):
+			return h.filterError(c, err)
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+
+	return c.JSON(h.cardMapper.ToCardSearchResponse(result))
+}
+
+func (h *CardHandler) GetMyCards(c *fiber.Ctx) error {
+	const op = "card.transport.handler.GetMyCards"
+	query, err := utils.ParseQuery[MyCardsRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid query parameters"})
+	}
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, query); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	if query.Cursor != "" {
+		query.After, err = decodeCursor(query.Cursor)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid cursor"})
+		}
+	}
+
+	result, err := h.cardService.GetMyCards(c.Context(), h.cardMapper.ToMyCardsQuery(query))
+	if err != nil {
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+
+	return c.JSON(h.cardMapper.ToMyCardsResponse(result, query.GroupBy))
+}
+
+func (h *CardHandler) GetByKey(c *fiber.Ctx) error {
+	const op = "card.transport.handler.GetByKey"
+	userID, ok := c.Locals(utils.UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"errors": "Unauthorized"})
+	}
+
+	card, err := h.cardService.GetByKey(c.Context(), userID, c.Params(CardKeyKey), c.QueryBool(ArchivedKey))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidCardKey):
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid card key"})
+		case errors.Is(err, domain.ErrCardNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Card not found"})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+	utils.SetETag(c, card.Version)
+	return c.JSON(h.cardMapper.ToCardListItemResponse(card))
+}
+
+func (h *CardHandler) GetSubtree(c *fiber.Ctx) error {
+	const op = "card.transport.handler.GetSubtree"
+	cardID, err := strconv.ParseUint(c.Params(CardIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid card ID"})
+	}
+
+	tree, err := h.cardService.GetSubtree(c.Context(), &domain.Card{
+		ID:      cardID,
+		BoardID: c.Params(BoardIDKey),
+	})
+	if err != nil {
+		return h.subtaskError(c, op, err)
+	}
+	return c.JSON(h.cardMapper.ToCardTreeResponse(tree))
+}
+
+func (h *CardHandler) AttachChild(c *fiber.Ctx) error {
+	const op = "card.transport.handler.AttachChild"
+	body, err := utils.ParseBody[CardChildRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	body.ParentID, err = strconv.ParseUint(c.Params(CardIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid card ID"})
+	}
+	body.BoardID = c.Params(BoardIDKey)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	if err := h.cardService.AttachChild(c.Context(), h.cardMapper.ToCardParentCommand(body)); err != nil {
+		return h.subtaskError(c, op, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(
+		fiber.Map{
+			"message": h.lang.GetResponseMessage(c.Context(), UpdatedMessage),
+		},
+	)
+}
+
+func (h *CardHandler) DetachChild(c *fiber.Ctx) error {
+	const op = "card.transport.handler.DetachChild"
+	parentID, err := strconv.ParseUint(c.Params(CardIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid card ID"})
+	}
+	childID, err := strconv.ParseUint(c.Params(ChildIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid card ID"})
+	}
+
+	if err := h.cardService.DetachChild(c.Context(), &domain.CardParentCommand{
+		BoardID:  c.Params(BoardIDKey),
+		ParentID: parentID,
+		ChildID:  childID,
+	}); err != nil {
+		return h.subtaskError(c, op, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *CardHandler) GetCardTemplateList(c *fiber.Ctx) error {
+	const op = "card.transport.handler.GetCardTemplateList"
+	userID, ok := c.Locals(utils.UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"errors": "Unauthorized"})
+	}
+
+	templates, err := h.cardService.GetTemplateList(c.Context(), userID, c.Params(BoardIDKey))
+	if err != nil {
+		return h.templateError(c, op, err)
+	}
+	return c.JSON(h.cardMapper.ToCardTemplateListResponse(templates))
+}
+
+func (h *CardHandler) GetCardTemplate(c *fiber.Ctx) error {
+	const op = "card.transport.handler.GetCardTemplate"
+	userID, ok := c.Locals(utils.UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"errors": "Unauthorized"})
+	}
+	templateID, err := strconv.ParseUint(c.Params(TemplateIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid template ID"})
+	}
+
+	template, err := h.cardService.GetTemplate(c.Context(), userID, &domain.CardTemplate{
+		ID:      templateID,
+		BoardID: c.Params(BoardIDKey),
+	})
+	if err != nil {
+		return h.templateError(c, op, err)
+	}
+	return c.JSON(h.cardMapper.ToCardTemplateResponse(template))
+}
+
+func (h *CardHandler) CreateCardTemplate(c *fiber.Ctx) error {
+	const op = "card.transport.handler.CreateCardTemplate"
+	body, err := utils.ParseBody[CardTemplateRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	body.BoardID = c.Params(BoardIDKey)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	template, err := h.cardService.CreateTemplate(c.Context(), body.UserID, h.cardMapper.ToCardTemplate(body))
+	if err != nil {
+		return h.templateError(c, op, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(h.cardMapper.ToCardTemplateResponse(template))
+}
+
+func (h *CardHandler) UpdateCardTemplate(c *fiber.Ctx) error {
+	const op = "card.transport.handler.UpdateCardTemplate"
+	body, err := utils.ParseBody[CardTemplateRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	templateID, err := strconv.ParseUint(c.Params(TemplateIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid template ID"})
+	}
+	body.ID = templateID
+	body.BoardID = c.Params(BoardIDKey)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	template, err := h.cardService.UpdateTemplate(c.Context(), body.UserID, h.cardMapper.ToCardTemplate(body))
+	if err != nil {
+		return h.templateError(c, op, err)
+	}
+	return c.JSON(h.cardMapper.ToCardTemplateResponse(template))
+}
+
+func (h *CardHandler) DeleteCardTemplate(c *fiber.Ctx) error {
+	const op = "card.transport.handler.DeleteCardTemplate"
+	userID, ok := c.Locals(utils.UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"errors": "Unauthorized"})
+	}
+	templateID, err := strconv.ParseUint(c.Params(TemplateIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid template ID"})
+	}
+
+	if err := h.cardService.DeleteTemplate(c.Context(), userID, &domain.CardTemplate{
+		ID:      templateID,
+		BoardID: c.Params(BoardIDKey),
+	}); err != nil {
+		return h.templateError(c, op, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *CardHandler) GetRecurrenceList(c *fiber.Ctx) error {
+	const op = "card.transport.handler.GetRecurrenceList"
+	userID, ok := c.Locals(utils.UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"errors": "Unauthorized"})
+	}
+
+	recurrences, err := h.cardService.GetRecurrenceList(c.Context(), userID, c.Params(BoardIDKey))
+	if err != nil {
+		return h.recurrenceError(c, op, err)
+	}
+	return c.JSON(h.cardMapper.ToCardRecurrenceListResponse(recurrences))
+}
+
+func (h *CardHandler) CreateRecurrence(c *fiber.Ctx) error {
+	const op = "card.transport.handler.CreateRecurrence"
+	body, err := utils.ParseBody[CardRecurrenceRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	body.BoardID = c.Params(BoardIDKey)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	recurrence, err := h.cardService.CreateRecurrence(c.Context(), body.UserID, h.cardMapper.ToCardRecurrence(body))
+	if err != nil {
+		return h.recurrenceError(c, op, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(h.cardMapper.ToCardRecurrenceResponse(recurrence))
+}
+
+func (h *CardHandler) UpdateRecurrence(c *fiber.Ctx) error {
+	const op = "card.transport.handler.UpdateRecurrence"
+	body, err := utils.ParseBody[CardRecurrenceRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	recurrenceID, err := strconv.ParseUint(c.Params(RecurrenceKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid recurrence ID"})
+	}
+	body.ID = recurrenceID
+	body.BoardID = c.Params(BoardIDKey)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	recurrence, err := h.cardService.UpdateRecurrence(c.Context(), body.UserID, h.cardMapper.ToCardRecurrence(body))
+	if err != nil {
+		return h.recurrenceError(c, op, err)
+	}
+	return c.JSON(h.cardMapper.ToCardRecurrenceResponse(recurrence))
+}
+
+func (h *CardHandler) DeleteRecurrence(c *fiber.Ctx) error {
+	const op = "card.transport.handler.DeleteRecurrence"
+	userID, ok := c.Locals(utils.UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"errors": "Unauthorized"})
+	}
+	recurrenceID, err := strconv.ParseUint(c.Params(RecurrenceKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid recurrence ID"})
+	}
+
+	if err := h.cardService.DeleteRecurrence(c.Context(), userID, &domain.CardRecurrence{
+		ID:      recurrenceID,
+		BoardID: c.Params(BoardIDKey),
+	}); err != nil {
+		return h.recurrenceError(c, op, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *CardHandler) recurrenceError(c *fiber.Ctx, op string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrRecurrenceNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Recurrence not found"})
+	case errors.Is(err, domain.ErrColumnNotExist):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Column not found"})
+	case errors.Is(err, domain.ErrBoardNotEditable):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"errors": domain.ErrBoardNotEditable.Error()})
+	case errors.Is(err, domain.ErrInvalidRecurrenceRule):
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{"errors": domain.ErrInvalidRecurrenceRule.Error()})
+	}
+	slog.Error(
+		"service error",
+		slog.String("operation", op),
+		slog.Any("errors", err),
+	)
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+}
+
+func (h *CardHandler) templateError(c *fiber.Ctx, op string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrCardTemplateNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Card template not found"})
+	case errors.Is(err, domain.ErrBoardNotEditable):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"errors": domain.ErrBoardNotEditable.Error()})
+	case errors.Is(err, domain.ErrCardTemplateNameTaken):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrCardTemplateNameTaken.Error()})
+	}
+	slog.Error(
+		"service error",
+		slog.String("operation", op),
+		slog.Any("errors", err),
+	)
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+}
+
+// versionConflict отвечает 412 с актуальным состоянием карточки, чтобы клиент мог слить изменения
+func (h *CardHandler) versionConflict(c *fiber.Ctx, op string, userID uint64, boardID string, cardID uint64) error {
+	card, err := h.cardService.Get(c.Context(), userID, &domain.Card{
+		ID:      cardID,
+		BoardID: boardID,
+	}, true)
+	if err != nil {
+		if errors.Is(err, domain.ErrCardNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Card not found"})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+	utils.SetETag(c, card.Version)
+	return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+		"errors":  cardError.ErrVersionMismatch.Error(),
+		"current": h.cardMapper.ToCardListItemResponse(card),
+	})
+}
+
+func (h *CardHandler) subtaskError(c *fiber.Ctx, op string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrCardNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Card not found"})
+	}
+	for _, target := range []error{
+		domain.ErrInvalidParent,
+		domain.ErrParentCycle,
+		domain.ErrMaxDepthExceeded,
+	} {
+		if errors.Is(err, target) {
+			return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{"errors": target.Error()})
+		}
+	}
+	slog.Error(
+		"service error",
+		slog.String("operation", op),
+		slog.Any("errors", err),
+	)
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+}
+
+// filterError отдаёт локализованную ошибку разбора фильтра с позицией
+func (h *CardHandler) filterError(c *fiber.Ctx, err error) error {
+	var syntaxErr *queryFilter.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid filter"})
+	}
+	return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+		"errors": fiber.Map{
+			FilterKey: h.lang.GetErrorMessage(c.Context(), syntaxErr.Key(), syntaxErr.MessageParams()),
+		},
+		"position": syntaxErr.Pos,
+	})
+}
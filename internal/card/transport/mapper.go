@@ -2,6 +2,13 @@ package transport
 
 import (
 	"backend/internal/card/domain"
+	cardError "backend/internal/shared/errors"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type CardMapper struct{}
@@ -31,18 +38,476 @@ func (m *CardMapper) ToCard(req *CardRequest) *domain.Card {
 		BoardID:        req.BoardID,
 		Text:           req.Text,
 		Description:    req.Description,
+		CreatedBy:      req.UserID,
+		DueDate:        req.DueDate,
+		Version:        req.Version,
 		CardProperties: domain.CardProperties{},
 	}
 
 	card.CardProperties.Color = safeDerefString(req.CardProperties.Color)
 	card.CardProperties.Tag = safeDerefString(req.CardProperties.Tag)
+	card.CardProperties.Estimate = safeDerefUint64(req.CardProperties.Estimate)
 
 	return card
 }
 
+// ToCardRequest представляет текущее состояние карточки как запрос на обновление, к которому применяется PATCH
+func (m *CardMapper) ToCardRequest(data *domain.CardListItem) *CardRequest {
+	if data == nil {
+		return nil
+	}
+
+	req := &CardRequest{
+		ID:          data.ID,
+		ColumnID:    data.ColumnID,
+		BoardID:     data.BoardID,
+		Text:        data.Text,
+		Description: data.Description,
+		DueDate:     data.DueDate,
+		Version:     data.Version,
+	}
+	if data.Color != "" {
+		req.CardProperties.Color = &data.Color
+	}
+	if data.Tag != "" {
+		req.CardProperties.Tag = &data.Tag
+	}
+	if data.Estimate != 0 {
+		req.CardProperties.Estimate = &data.Estimate
+	}
+	return req
+}
+
+func (m *CardMapper) ToCardSearchQuery(req *CardSearchRequest) *domain.CardSearchQuery {
+	if req == nil {
+		return nil
+	}
+
+	query := &domain.CardSearchQuery{
+		UserID:   req.UserID,
+		Query:    req.Filter,
+		Page:     req.Page,
+		PerPage:  req.PerPage,
+		Archived: req.Archived,
+	}
+	if query.Page == 0 {
+		query.Page = DefaultPage
+	}
+	if query.PerPage == 0 {
+		query.PerPage = DefaultPerPage
+	}
+	return query
+}
+
+func (m *CardMapper) ToCardListItemResponse(data *domain.CardListItem) *CardListItemResponse {
+	if data == nil {
+		return nil
+	}
+
+	return &CardListItemResponse{
+		ID:             data.ID,
+		Key:            domain.CardKey(data.BoardKey, data.Number),
+		BoardID:        data.BoardID,
+		BoardName:      data.BoardName,
+		ColumnID:       data.ColumnID,
+		ColumnName:     data.ColumnName,
+		ColumnCategory: data.ColumnCategory,
+		Text:           data.Text,
+		Description:    data.Description,
+		Position:       data.Position,
+		CreatedBy:      data.CreatedBy,
+		DueDate:        data.DueDate,
+		Properties: CardPropertiesResponse{
+			Color:    data.Color,
+			Tag:      data.Tag,
+			Estimate: data.Estimate,
+		},
+		Version:   data.Version,
+		CreatedAt: data.CreatedAt,
+		UpdatedAt: data.UpdatedAt,
+	}
+}
+
+func (m *CardMapper) ToCardSearchResponse(data *domain.CardSearchResult) *CardSearchResponse {
+	if data == nil {
+		return nil
+	}
+
+	response := &CardSearchResponse{
+		PerPage:     data.PerPage,
+		CurrentPage: data.CurrentPage,
+		TotalCount:  data.TotalCount,
+		TotalPages:  data.TotalPages,
+		HasNext:     data.HasNext,
+		HasPrev:     data.HasPrev,
+		Data:        make([]*CardListItemResponse, 0, len(data.Data)),
+	}
+	for _, card := range data.Data {
+		response.Data = append(response.Data, m.ToCardListItemResponse(card))
+	}
+	return response
+}
+
+func (m *CardMapper) ToMyCardsQuery(req *MyCardsRequest) *domain.MyCardsQuery {
+	if req == nil {
+		return nil
+	}
+
+	query := &domain.MyCardsQuery{
+		UserID:    req.UserID,
+		SortBy:    req.SortBy,
+		SortOrder: req.SortOrder,
+		Cursor:    req.After,
+		Limit:     req.Limit,
+		Archived:  req.Archived,
+	}
+	if query.Limit == 0 {
+		query.Limit = DefaultPerPage
+	}
+	return query
+}
+
+// ToMyCardsResponse группирует карточки страницы по доскам или по дню срока в порядке первого появления
+func (m *CardMapper) ToMyCardsResponse(data *domain.MyCardsResult, groupBy string) *MyCardsResponse {
+	if data == nil {
+		return nil
+	}
+
+	response := &MyCardsResponse{
+		HasNext: data.NextCursor != nil,
+	}
+	if data.NextCursor != nil {
+		cursor := encodeCursor(data.NextCursor)
+		response.NextCursor = &cursor
+	}
+	if groupBy != domain.GroupByBoard && groupBy != domain.GroupByDueDate {
+		response.Data = make([]*CardListItemResponse, 0, len(data.Data))
+		for _, card := range data.Data {
+			response.Data = append(response.Data, m.ToCardListItemResponse(card))
+		}
+		return response
+	}
+
+	response.Groups = []*CardGroupResponse{}
+	groups := make(map[string]*CardGroupResponse)
+	for _, card := range data.Data {
+		key := card.BoardID
+		if groupBy == domain.GroupByDueDate {
+			key = NoDueDateKey
+			if card.DueDate != nil {
+				key = card.DueDate.UTC().Format(time.DateOnly)
+			}
+		}
+		group, ok := groups[key]
+		if !ok {
+			group = &CardGroupResponse{
+				Key:   key,
+				Cards: []*CardListItemResponse{},
+			}
+			if groupBy == domain.GroupByBoard {
+				group.BoardID = card.BoardID
+				group.BoardName = card.BoardName
+			}
+			groups[key] = group
+			response.Groups = append(response.Groups, group)
+		}
+		group.Cards = append(group.Cards, m.ToCardListItemResponse(card))
+	}
+	return response
+}
+
+// encodeCursor и decodeCursor переводят курсор в непрозрачную строку вида base64("<unix micro>.<id>")
+func (m *CardMapper) ToCardTransferCommand(req *CardTransferRequest) *domain.CardTransferCommand {
+	if req == nil {
+		return nil
+	}
+
+	return &domain.CardTransferCommand{
+		UserID:         req.UserID,
+		BoardID:        req.BoardID,
+		CardID:         req.CardID,
+		Mode:           req.Mode,
+		TargetBoardID:  req.TargetBoardID,
+		TargetColumnID: req.TargetColumnID,
+		WithComments:   req.WithComments,
+	}
+}
+
+func (m *CardMapper) ToCardBulkCommand(req *CardBulkRequest) *domain.CardBulkCommand {
+	if req == nil {
+		return nil
+	}
+
+	cmd := &domain.CardBulkCommand{
+		BoardID:    req.BoardID,
+		DryRun:     req.DryRun,
+		Operations: make([]*domain.CardBulkOperation, 0, len(req.Operations)),
+	}
+	for _, item := range req.Operations {
+		operation := &domain.CardBulkOperation{
+			Op:       item.Op,
+			CardID:   item.CardID,
+			ColumnID: item.ColumnID,
+		}
+		if item.Properties != nil {
+			operation.Properties = &domain.CardPropertiesPatch{
+				Color:    item.Properties.Color,
+				Tag:      item.Properties.Tag,
+				Estimate: item.Properties.Estimate,
+			}
+		}
+		cmd.Operations = append(cmd.Operations, operation)
+	}
+	return cmd
+}
+
+func (m *CardMapper) ToCardBulkResponse(data *domain.CardBulkResult) *CardBulkResponse {
+	if data == nil {
+		return nil
+	}
+
+	resp := &CardBulkResponse{
+		DryRun:  data.DryRun,
+		Applied: data.Applied,
+		Results: make([]*CardBulkResultResponse, 0, len(data.Items)),
+	}
+	for i, item := range data.Items {
+		result := &CardBulkResultResponse{
+			Index:  i,
+			Op:     item.Op,
+			CardID: item.CardID,
+			Status: BulkStatusOK,
+		}
+		if item.Err != nil {
+			result.Status = BulkStatusFailed
+			result.Error = bulkItemError(item.Err)
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return resp
+}
+
+// bulkItemError отдаёт клиенту текст известной ошибки без цепочки операций сервиса
+func bulkItemError(err error) string {
+	for _, known := range []error{
+		domain.ErrCardNotFound,
+		domain.ErrColumnNotExist,
+		domain.ErrCardBlocked,
+		domain.ErrInvalidBulkOperation,
+		cardError.ErrCardHasChildren,
+		cardError.ErrCardHasComments,
+	} {
+		if errors.Is(err, known) {
+			return known.Error()
+		}
+	}
+	return err.Error()
+}
+
+func (m *CardMapper) ToCardParentCommand(req *CardChildRequest) *domain.CardParentCommand {
+	if req == nil {
+		return nil
+	}
+
+	return &domain.CardParentCommand{
+		BoardID:  req.BoardID,
+		ParentID: req.ParentID,
+		ChildID:  req.ChildID,
+	}
+}
+
+func (m *CardMapper) ToCardTreeResponse(node *domain.CardTreeNode) *CardTreeResponse {
+	if node == nil {
+		return nil
+	}
+
+	response := &CardTreeResponse{
+		ID:          node.ID,
+		BoardID:     node.BoardID,
+		ColumnID:    node.ColumnID,
+		ParentID:    node.ParentID,
+		Text:        node.Text,
+		Description: node.Description,
+		Position:    node.Position,
+		DueDate:     node.DueDate,
+		Properties: CardPropertiesResponse{
+			Color:    node.Color,
+			Tag:      node.Tag,
+			Estimate: node.Estimate,
+		},
+		Version:   node.Version,
+		CreatedAt: node.CreatedAt,
+		UpdatedAt: node.UpdatedAt,
+		Children:  make([]*CardTreeResponse, 0, len(node.Children)),
+	}
+	for _, child := range node.Children {
+		response.Children = append(response.Children, m.ToCardTreeResponse(child))
+	}
+	return response
+}
+
+func encodeCursor(cursor *domain.CardCursor) string {
+	raw := fmt.Sprintf("%d.%d", cursor.Value.UnixMicro(), cursor.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(value string) (*domain.CardCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	micro, id, found := strings.Cut(string(raw), ".")
+	if !found {
+		return nil, errors.New("invalid cursor")
+	}
+	microValue, err := strconv.ParseInt(micro, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	idValue, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.CardCursor{
+		Value: time.UnixMicro(microValue),
+		ID:    idValue,
+	}, nil
+}
+
+// ApplyCardTemplate заполняет из шаблона поля, которые не пришли в запросе; явно заданные поля остаются
+func (m *CardMapper) ApplyCardTemplate(req *CardRequest, template *domain.CardTemplate) {
+	if req == nil || template == nil {
+		return
+	}
+	if req.Text == "" {
+		req.Text = template.Text
+	}
+	if req.Description == "" {
+		req.Description = template.Description
+	}
+	if req.CardProperties.Color == nil && template.Color != "" {
+		req.CardProperties.Color = &template.Color
+	}
+	if req.CardProperties.Tag == nil && template.Tag != "" {
+		req.CardProperties.Tag = &template.Tag
+	}
+	if req.CardProperties.Estimate == nil && template.Estimate != 0 {
+		req.CardProperties.Estimate = &template.Estimate
+	}
+}
+
+func (m *CardMapper) ToCardTemplate(req *CardTemplateRequest) *domain.CardTemplate {
+	if req == nil {
+		return nil
+	}
+
+	return &domain.CardTemplate{
+		ID:          req.ID,
+		BoardID:     req.BoardID,
+		Name:        req.Name,
+		Text:        req.Text,
+		Description: req.Description,
+		CardProperties: domain.CardProperties{
+			Color:    safeDerefString(req.CardProperties.Color),
+			Tag:      safeDerefString(req.CardProperties.Tag),
+			Estimate: safeDerefUint64(req.CardProperties.Estimate),
+		},
+	}
+}
+
+func (m *CardMapper) ToCardTemplateResponse(template *domain.CardTemplate) *CardTemplateResponse {
+	if template == nil {
+		return nil
+	}
+
+	return &CardTemplateResponse{
+		ID:          template.ID,
+		BoardID:     template.BoardID,
+		Name:        template.Name,
+		Text:        template.Text,
+		Description: template.Description,
+		Properties: CardPropertiesResponse{
+			Color:    template.Color,
+			Tag:      template.Tag,
+			Estimate: template.Estimate,
+		},
+		CreatedAt: template.CreatedAt,
+		UpdatedAt: template.UpdatedAt,
+	}
+}
+
+func (m *CardMapper) ToCardTemplateListResponse(templates []*domain.CardTemplate) []*CardTemplateResponse {
+	response := make([]*CardTemplateResponse, 0, len(templates))
+	for _, template := range templates {
+		response = append(response, m.ToCardTemplateResponse(template))
+	}
+	return response
+}
+
+func (m *CardMapper) ToCardRecurrence(req *CardRecurrenceRequest) *domain.CardRecurrence {
+	if req == nil {
+		return nil
+	}
+
+	return &domain.CardRecurrence{
+		ID:          req.ID,
+		BoardID:     req.BoardID,
+		ColumnID:    req.ColumnID,
+		Text:        req.Text,
+		Description: req.Description,
+		Rule:        req.Rule,
+		StartsAt:    req.StartsAt,
+		CardProperties: domain.CardProperties{
+			Color:    safeDerefString(req.CardProperties.Color),
+			Tag:      safeDerefString(req.CardProperties.Tag),
+			Estimate: safeDerefUint64(req.CardProperties.Estimate),
+		},
+	}
+}
+
+func (m *CardMapper) ToCardRecurrenceResponse(recurrence *domain.CardRecurrence) *CardRecurrenceResponse {
+	if recurrence == nil {
+		return nil
+	}
+
+	return &CardRecurrenceResponse{
+		ID:          recurrence.ID,
+		BoardID:     recurrence.BoardID,
+		ColumnID:    recurrence.ColumnID,
+		CreatedBy:   recurrence.CreatedBy,
+		Text:        recurrence.Text,
+		Description: recurrence.Description,
+		Properties: CardPropertiesResponse{
+			Color:    recurrence.Color,
+			Tag:      recurrence.Tag,
+			Estimate: recurrence.Estimate,
+		},
+		Rule:      recurrence.Rule,
+		StartsAt:  recurrence.StartsAt,
+		NextRunAt: recurrence.NextRunAt,
+		CreatedAt: recurrence.CreatedAt,
+		UpdatedAt: recurrence.UpdatedAt,
+	}
+}
+
+func (m *CardMapper) ToCardRecurrenceListResponse(recurrences []*domain.CardRecurrence) []*CardRecurrenceResponse {
+	response := make([]*CardRecurrenceResponse, 0, len(recurrences))
+	for _, recurrence := range recurrences {
+		response = append(response, m.ToCardRecurrenceResponse(recurrence))
+	}
+	return response
+}
+
 func safeDerefString(ptr *string) string {
 	if ptr == nil {
 		return ""
 	}
 	return *ptr
 }
+
+func safeDerefUint64(ptr *uint64) uint64 {
+	if ptr == nil {
+		return 0
+	}
+	return *ptr
+}
@@ -2,8 +2,11 @@ package transport
 
 import (
 	"backend/internal/card/domain"
+	cardError "backend/internal/shared/errors"
+	"backend/internal/shared/filter"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -94,6 +97,7 @@ func Test_ToCard(t *testing.T) {
 	tag = new(string)
 	*color = "color"
 	*tag = "tag"
+	estimate := uint64(5)
 
 	tests := []struct {
 		name     string
@@ -122,6 +126,25 @@ func Test_ToCard(t *testing.T) {
 				Description: "test description",
 			},
 		},
+		{
+			name: "with expected version",
+			req: &CardRequest{
+				ID:          1,
+				ColumnID:    1,
+				BoardID:     "e102c99e-651c-44e1-bff1-c4a22e3134ce",
+				Text:        "test text",
+				Description: "test description",
+				Version:     3,
+			},
+			expected: &domain.Card{
+				ID:          1,
+				ColumnID:    1,
+				BoardID:     "e102c99e-651c-44e1-bff1-c4a22e3134ce",
+				Text:        "test text",
+				Description: "test description",
+				Version:     3,
+			},
+		},
 		{
 			name: "with color and tag properties",
 			req: &CardRequest{
@@ -193,6 +216,29 @@ func Test_ToCard(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with estimate property",
+			req: &CardRequest{
+				ID:          1,
+				ColumnID:    1,
+				BoardID:     "e102c99e-651c-44e1-bff1-c4a22e3134ce",
+				Text:        "test text",
+				Description: "test description",
+				CardProperties: CardProperties{
+					Estimate: &estimate,
+				},
+			},
+			expected: &domain.Card{
+				ID:          1,
+				ColumnID:    1,
+				BoardID:     "e102c99e-651c-44e1-bff1-c4a22e3134ce",
+				Text:        "test text",
+				Description: "test description",
+				CardProperties: domain.CardProperties{
+					Estimate: 5,
+				},
+			},
+		},
 		{
 			name: "with nil properties pointer",
 			req: &CardRequest{
@@ -244,3 +290,557 @@ func Test_ToCard(t *testing.T) {
 		})
 	}
 }
+
+func Test_ToCardSearchQuery(t *testing.T) {
+	mapper := CardMapper{}
+	query := &filter.Query{Terms: []*filter.Term{{Pos: 1, ValuePos: 5, Field: "tag", Op: filter.OpMatch, Value: "bug"}}}
+	tests := []struct {
+		name     string
+		req      *CardSearchRequest
+		expected *domain.CardSearchQuery
+	}{
+		{
+			name: "default pagination",
+			req: &CardSearchRequest{
+				UserID: 1,
+				Query:  "tag:bug",
+				Filter: query,
+			},
+			expected: &domain.CardSearchQuery{
+				UserID:  1,
+				Query:   query,
+				Page:    DefaultPage,
+				PerPage: DefaultPerPage,
+			},
+		},
+		{
+			name: "explicit pagination with archived",
+			req: &CardSearchRequest{
+				UserID:   2,
+				Query:    "tag:bug",
+				Page:     3,
+				PerPage:  50,
+				Archived: true,
+				Filter:   query,
+			},
+			expected: &domain.CardSearchQuery{
+				UserID:   2,
+				Query:    query,
+				Page:     3,
+				PerPage:  50,
+				Archived: true,
+			},
+		},
+		{
+			name:     "nil request",
+			req:      nil,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, mapper.ToCardSearchQuery(tt.req))
+		})
+	}
+}
+
+func Test_ToMyCardsQuery(t *testing.T) {
+	mapper := CardMapper{}
+	cursor := &domain.CardCursor{Value: time.UnixMicro(1700000000000000), ID: 7}
+	tests := []struct {
+		name     string
+		req      *MyCardsRequest
+		expected *domain.MyCardsQuery
+	}{
+		{
+			name:     "nil request",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "default limit",
+			req:  &MyCardsRequest{UserID: 1},
+			expected: &domain.MyCardsQuery{
+				UserID: 1,
+				Limit:  DefaultPerPage,
+			},
+		},
+		{
+			name: "with cursor and sorting",
+			req: &MyCardsRequest{
+				UserID:    1,
+				SortBy:    "created_at",
+				SortOrder: "asc",
+				Limit:     5,
+				After:     cursor,
+			},
+			expected: &domain.MyCardsQuery{
+				UserID:    1,
+				SortBy:    "created_at",
+				SortOrder: "asc",
+				Cursor:    cursor,
+				Limit:     5,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, mapper.ToMyCardsQuery(tt.req))
+		})
+	}
+}
+
+func Test_ToMyCardsResponse(t *testing.T) {
+	mapper := CardMapper{}
+	cards := []*domain.CardListItem{
+		{Card: domain.Card{ID: 1, BoardID: "a"}, BoardName: "A", ColumnName: "Todo", ColumnCategory: "todo"},
+		{Card: domain.Card{ID: 2, BoardID: "b"}, BoardName: "B", ColumnName: "Done", ColumnCategory: "done"},
+		{Card: domain.Card{ID: 3, BoardID: "a"}, BoardName: "A", ColumnName: "Todo", ColumnCategory: "todo"},
+	}
+
+	t.Run("grouped by board", func(t *testing.T) {
+		actual := mapper.ToMyCardsResponse(&domain.MyCardsResult{Data: cards}, domain.GroupByBoard)
+		assert.Nil(t, actual.Data)
+		assert.False(t, actual.HasNext)
+		assert.Len(t, actual.Groups, 2)
+		assert.Equal(t, "A", actual.Groups[0].BoardName)
+		assert.Equal(t, []uint64{1, 3}, []uint64{actual.Groups[0].Cards[0].ID, actual.Groups[0].Cards[1].ID})
+		assert.Equal(t, "done", actual.Groups[1].Cards[0].ColumnCategory)
+	})
+
+	t.Run("flat with next cursor", func(t *testing.T) {
+		next := &domain.CardCursor{Value: time.UnixMicro(1700000000123456), ID: 3}
+		actual := mapper.ToMyCardsResponse(&domain.MyCardsResult{Data: cards, NextCursor: next}, "")
+		assert.Len(t, actual.Data, 3)
+		assert.Nil(t, actual.Groups)
+		assert.True(t, actual.HasNext)
+		decoded, err := decodeCursor(*actual.NextCursor)
+		assert.NoError(t, err)
+		assert.Equal(t, next.ID, decoded.ID)
+		assert.True(t, next.Value.Equal(decoded.Value))
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := decodeCursor("not a cursor")
+		assert.Error(t, err)
+	})
+}
+
+func Test_ToMyCardsResponseGroupedByDueDate(t *testing.T) {
+	mapper := CardMapper{}
+	due := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	cards := []*domain.CardListItem{
+		{Card: domain.Card{ID: 1, BoardID: "a", DueDate: &due}},
+		{Card: domain.Card{ID: 2, BoardID: "b"}},
+		{Card: domain.Card{ID: 3, BoardID: "b", DueDate: &due}},
+	}
+
+	actual := mapper.ToMyCardsResponse(&domain.MyCardsResult{Data: cards}, domain.GroupByDueDate)
+	assert.Len(t, actual.Groups, 2)
+	assert.Equal(t, "2025-03-01", actual.Groups[0].Key)
+	assert.Empty(t, actual.Groups[0].BoardID)
+	assert.Len(t, actual.Groups[0].Cards, 2)
+	assert.Equal(t, NoDueDateKey, actual.Groups[1].Key)
+}
+
+func Test_ToCardTreeResponse(t *testing.T) {
+	mapper := CardMapper{}
+	now := time.Now()
+	rootID, childID := uint64(1), uint64(2)
+	cards := []*domain.Card{
+		{ID: rootID, BoardID: "b", ColumnID: 1, Text: "Epic", CreatedAt: now, UpdatedAt: now},
+		{ID: childID, BoardID: "b", ColumnID: 2, Text: "Story", ParentID: &rootID, CreatedAt: now, UpdatedAt: now},
+		{ID: 3, BoardID: "b", ColumnID: 2, Text: "Task", ParentID: &childID, CreatedAt: now, UpdatedAt: now},
+	}
+	expected := &CardTreeResponse{
+		ID: rootID, BoardID: "b", ColumnID: 1, Text: "Epic", CreatedAt: now, UpdatedAt: now,
+		Children: []*CardTreeResponse{
+			{
+				ID: childID, BoardID: "b", ColumnID: 2, Text: "Story", ParentID: &rootID, CreatedAt: now, UpdatedAt: now,
+				Children: []*CardTreeResponse{
+					{
+						ID: 3, BoardID: "b", ColumnID: 2, Text: "Task", ParentID: &childID, CreatedAt: now, UpdatedAt: now,
+						Children: []*CardTreeResponse{},
+					},
+				},
+			},
+		},
+	}
+
+	tree := domain.BuildCardTree(cards)
+	assert.Equal(t, expected, mapper.ToCardTreeResponse(tree))
+	assert.Equal(t, 2, domain.TreeHeight(tree))
+	assert.Nil(t, mapper.ToCardTreeResponse(nil))
+}
+
+func Test_ToCardParentCommand(t *testing.T) {
+	mapper := CardMapper{}
+	assert.Nil(t, mapper.ToCardParentCommand(nil))
+	assert.Equal(t,
+		&domain.CardParentCommand{BoardID: "b", ParentID: 1, ChildID: 2},
+		mapper.ToCardParentCommand(&CardChildRequest{BoardID: "b", ParentID: 1, ChildID: 2}),
+	)
+}
+
+func Test_ToCardListItemResponseKey(t *testing.T) {
+	mapper := CardMapper{}
+	tests := []struct {
+		name     string
+		card     *domain.CardListItem
+		expected string
+	}{
+		{
+			name:     "with board key",
+			card:     &domain.CardListItem{Card: domain.Card{ID: 7, Number: 42}, BoardKey: "WEB"},
+			expected: "WEB-42",
+		},
+		{
+			name:     "without number",
+			card:     &domain.CardListItem{Card: domain.Card{ID: 7}, BoardKey: "WEB"},
+			expected: "",
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToCardListItemResponse(tc.card).Key)
+		})
+	}
+}
+
+func Test_ToCardListItemResponseVersion(t *testing.T) {
+	mapper := CardMapper{}
+	card := &domain.CardListItem{Card: domain.Card{ID: 7, Number: 42, Version: 5}, BoardKey: "WEB"}
+
+	assert.Equal(t, uint64(5), mapper.ToCardListItemResponse(card).Version)
+	assert.Equal(t, uint64(5), mapper.ToCardTreeResponse(&domain.CardTreeNode{Card: card.Card}).Version)
+}
+
+func Test_ParseCardKey(t *testing.T) {
+	tests := []struct {
+		key      string
+		boardKey string
+		number   uint64
+		err      error
+	}{
+		{key: "WEB-42", boardKey: "WEB", number: 42},
+		{key: "web2-1", boardKey: "WEB2", number: 1},
+		{key: "WEB", err: domain.ErrInvalidCardKey},
+		{key: "-42", err: domain.ErrInvalidCardKey},
+		{key: "WEB-0", err: domain.ErrInvalidCardKey},
+		{key: "WEB-x", err: domain.ErrInvalidCardKey},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.key, func(t *testing.T) {
+			boardKey, number, err := domain.ParseCardKey(tc.key)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.boardKey, boardKey)
+			assert.Equal(t, tc.number, number)
+		})
+	}
+}
+
+func Test_ToCardRequest(t *testing.T) {
+	mapper := CardMapper{}
+	color := "#ffffff"
+	estimate := uint64(3)
+	tests := []struct {
+		name     string
+		data     *domain.CardListItem
+		expected *CardRequest
+	}{
+		{
+			name:     "nil pointer",
+			data:     nil,
+			expected: nil,
+		},
+		{
+			name: "empty properties",
+			data: &domain.CardListItem{
+				Card: domain.Card{ID: 1, ColumnID: 2, BoardID: "b", Text: "text", Description: "desc", Version: 4},
+			},
+			expected: &CardRequest{ID: 1, ColumnID: 2, BoardID: "b", Text: "text", Description: "desc", Version: 4},
+		},
+		{
+			name: "with properties",
+			data: &domain.CardListItem{
+				Card: domain.Card{ID: 1, ColumnID: 2, BoardID: "b", Text: "text", CardProperties: domain.CardProperties{Color: color, Estimate: estimate}},
+			},
+			expected: &CardRequest{
+				ID:             1,
+				ColumnID:       2,
+				BoardID:        "b",
+				Text:           "text",
+				CardProperties: CardProperties{Color: &color, Estimate: &estimate},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToCardRequest(tc.data))
+		})
+	}
+}
+
+func Test_ToCardBulkCommand(t *testing.T) {
+	mapper := CardMapper{}
+	tag := "urgent"
+	tests := []struct {
+		name     string
+		req      *CardBulkRequest
+		expected *domain.CardBulkCommand
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "operations",
+			req: &CardBulkRequest{
+				BoardID: "b",
+				DryRun:  true,
+				Operations: []*CardBulkOperationRequest{
+					{Op: domain.BulkOpMove, CardID: 1, ColumnID: 2},
+					{Op: domain.BulkOpSetProperties, CardID: 3, Properties: &CardProperties{Tag: &tag}},
+					{Op: domain.BulkOpDelete, CardID: 4},
+				},
+			},
+			expected: &domain.CardBulkCommand{
+				BoardID: "b",
+				DryRun:  true,
+				Operations: []*domain.CardBulkOperation{
+					{Op: domain.BulkOpMove, CardID: 1, ColumnID: 2},
+					{Op: domain.BulkOpSetProperties, CardID: 3, Properties: &domain.CardPropertiesPatch{Tag: &tag}},
+					{Op: domain.BulkOpDelete, CardID: 4},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToCardBulkCommand(tc.req))
+		})
+	}
+}
+
+func Test_ToCardBulkResponse(t *testing.T) {
+	mapper := CardMapper{}
+	result := &domain.CardBulkResult{
+		Items: []*domain.CardBulkItemResult{
+			{Op: domain.BulkOpMove, CardID: 1},
+			{Op: domain.BulkOpDelete, CardID: 2, Err: fmt.Errorf("card.service.Bulk: %w", cardError.ErrCardHasComments)},
+		},
+	}
+
+	assert.Equal(t, &CardBulkResponse{
+		Results: []*CardBulkResultResponse{
+			{Index: 0, Op: domain.BulkOpMove, CardID: 1, Status: BulkStatusOK},
+			{Index: 1, Op: domain.BulkOpDelete, CardID: 2, Status: BulkStatusFailed, Error: cardError.ErrCardHasComments.Error()},
+		},
+	}, mapper.ToCardBulkResponse(result))
+}
+
+func Test_ToCardTransferCommand(t *testing.T) {
+	mapper := CardMapper{}
+	tests := []struct {
+		name     string
+		req      *CardTransferRequest
+		expected *domain.CardTransferCommand
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "copy with comments",
+			req: &CardTransferRequest{
+				UserID:         1,
+				BoardID:        "a",
+				CardID:         2,
+				Mode:           domain.TransferCopy,
+				TargetBoardID:  "b",
+				TargetColumnID: 3,
+				WithComments:   true,
+			},
+			expected: &domain.CardTransferCommand{
+				UserID:         1,
+				BoardID:        "a",
+				CardID:         2,
+				Mode:           domain.TransferCopy,
+				TargetBoardID:  "b",
+				TargetColumnID: 3,
+				WithComments:   true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToCardTransferCommand(tc.req))
+		})
+	}
+}
+
+func Test_ApplyCardTemplate(t *testing.T) {
+	mapper := CardMapper{}
+	color := "#FFFFFF"
+	template := &domain.CardTemplate{
+		ID:             1,
+		Text:           "Bug: ",
+		Description:    "Steps to reproduce",
+		CardProperties: domain.CardProperties{Color: "#F44336", Tag: "bug", Estimate: 3},
+	}
+	tests := []struct {
+		name     string
+		req      *CardRequest
+		expected *CardRequest
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "empty request takes everything from template",
+			req:  &CardRequest{TemplateID: 1},
+			expected: &CardRequest{
+				TemplateID:  1,
+				Text:        "Bug: ",
+				Description: "Steps to reproduce",
+				CardProperties: CardProperties{
+					Color:    &template.Color,
+					Tag:      &template.Tag,
+					Estimate: &template.Estimate,
+				},
+			},
+		},
+		{
+			name: "explicit fields win over template",
+			req: &CardRequest{
+				TemplateID:     1,
+				Text:           "Login fails",
+				CardProperties: CardProperties{Color: &color},
+			},
+			expected: &CardRequest{
+				TemplateID:  1,
+				Text:        "Login fails",
+				Description: "Steps to reproduce",
+				CardProperties: CardProperties{
+					Color:    &color,
+					Tag:      &template.Tag,
+					Estimate: &template.Estimate,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			mapper.ApplyCardTemplate(tc.req, template)
+			assert.Equal(t, tc.expected, tc.req)
+		})
+	}
+}
+
+func Test_ToCardTemplate(t *testing.T) {
+	mapper := CardMapper{}
+	tag := "bug"
+	tests := []struct {
+		name     string
+		req      *CardTemplateRequest
+		expected *domain.CardTemplate
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "valid data",
+			req: &CardTemplateRequest{
+				ID:             2,
+				UserID:         1,
+				BoardID:        "382a14b1-46f0-4df4-975c-e0d62bd6c358",
+				Name:           "bug",
+				Text:           "Bug: ",
+				Description:    "Steps to reproduce",
+				CardProperties: CardProperties{Tag: &tag},
+			},
+			expected: &domain.CardTemplate{
+				ID:             2,
+				BoardID:        "382a14b1-46f0-4df4-975c-e0d62bd6c358",
+				Name:           "bug",
+				Text:           "Bug: ",
+				Description:    "Steps to reproduce",
+				CardProperties: domain.CardProperties{Tag: "bug"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToCardTemplate(tc.req))
+		})
+	}
+}
+
+func Test_ToCardRecurrence(t *testing.T) {
+	mapper := CardMapper{}
+	startsAt := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	tag := "chore"
+	tests := []struct {
+		name     string
+		req      *CardRecurrenceRequest
+		expected *domain.CardRecurrence
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "valid data",
+			req: &CardRecurrenceRequest{
+				ID:             3,
+				UserID:         1,
+				BoardID:        "382a14b1-46f0-4df4-975c-e0d62bd6c358",
+				ColumnID:       2,
+				Text:           "update deps",
+				Description:    "weekly chore",
+				Rule:           "FREQ=WEEKLY;BYDAY=MO",
+				StartsAt:       startsAt,
+				CardProperties: CardProperties{Tag: &tag},
+			},
+			expected: &domain.CardRecurrence{
+				ID:             3,
+				BoardID:        "382a14b1-46f0-4df4-975c-e0d62bd6c358",
+				ColumnID:       2,
+				Text:           "update deps",
+				Description:    "weekly chore",
+				Rule:           "FREQ=WEEKLY;BYDAY=MO",
+				StartsAt:       startsAt,
+				CardProperties: domain.CardProperties{Tag: "chore"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToCardRecurrence(tc.req))
+		})
+	}
+}
@@ -1,14 +1,48 @@
 package transport
 
+import (
+	"backend/internal/card/domain"
+	"backend/internal/shared/filter"
+	"time"
+)
+
 type CardRequest struct {
 	ID             uint64
-	ColumnID       uint64 `json:"column_id" validate:"required,min=1"`
-	BoardID        string `json:"board_id" validate:"required,uuid"`
+	UserID         uint64
+	ColumnID       uint64     `json:"column_id" validate:"required,min=1"`
+	BoardID        string     `json:"board_id" validate:"required,uuid"`
+	Text           string     `json:"text" validate:"required,min=1,max=255"`
+	Description    string     `json:"description" validate:"required,min=1,max=255"`
+	DueDate        *time.Time `json:"due_date,omitempty"`
+	TemplateID     uint64     `json:"template_id,omitempty"`
+	Version        uint64     `json:"-"`
+	CardProperties `json:"properties"`
+}
+
+// CardTemplateRequest проверяет текст, описание и свойства по тем же правилам, что и CardRequest
+type CardTemplateRequest struct {
+	ID             uint64
+	UserID         uint64 `validate:"required,min=1"`
+	BoardID        string `validate:"required,uuid"`
+	Name           string `json:"name" validate:"required,min=1,max=100"`
 	Text           string `json:"text" validate:"required,min=1,max=255"`
 	Description    string `json:"description" validate:"required,min=1,max=255"`
 	CardProperties `json:"properties"`
 }
 
+// CardRecurrenceRequest проверяет заготовку карточки по тем же правилам, что и CardRequest
+type CardRecurrenceRequest struct {
+	ID             uint64
+	UserID         uint64    `validate:"required,min=1"`
+	BoardID        string    `validate:"required,uuid"`
+	ColumnID       uint64    `json:"column_id" validate:"required,min=1"`
+	Text           string    `json:"text" validate:"required,min=1,max=255"`
+	Description    string    `json:"description" validate:"required,min=1,max=255"`
+	Rule           string    `json:"rule" validate:"required,max=255"`
+	StartsAt       time.Time `json:"starts_at" validate:"required"`
+	CardProperties `json:"properties"`
+}
+
 type CardMoveRequest struct {
 	ID           uint64 `json:"id" validate:"required,min=1"`
 	FromColumnID uint64 `json:"from_column_id" validate:"required,min=1"`
@@ -18,7 +52,57 @@ type CardMoveRequest struct {
 	BoardID      string `json:"board_id" validate:"required,uuid"`
 }
 
+type CardTransferRequest struct {
+	UserID         uint64 `validate:"required,min=1"`
+	BoardID        string `validate:"required,uuid"`
+	CardID         uint64 `validate:"required,min=1"`
+	Mode           string `json:"mode" validate:"required,oneof=move copy"`
+	TargetBoardID  string `json:"target_board_id" validate:"required,uuid"`
+	TargetColumnID uint64 `json:"target_column_id" validate:"required,min=1"`
+	WithComments   bool   `json:"with_comments"`
+}
+
+type CardBulkRequest struct {
+	BoardID    string                      `validate:"required,uuid"`
+	DryRun     bool                        `json:"dry_run"`
+	Operations []*CardBulkOperationRequest `json:"operations" validate:"required,min=1,max=100,dive,required"`
+}
+
+type CardBulkOperationRequest struct {
+	Op         string          `json:"op" validate:"required,oneof=move set_properties delete"`
+	CardID     uint64          `json:"card_id" validate:"required,min=1"`
+	ColumnID   uint64          `json:"column_id" validate:"required_if=Op move"`
+	Properties *CardProperties `json:"properties" validate:"required_if=Op set_properties"`
+}
+
+type CardChildRequest struct {
+	BoardID  string `validate:"required,uuid"`
+	ParentID uint64 `validate:"required,min=1"`
+	ChildID  uint64 `json:"card_id" validate:"required,min=1"`
+}
+
 type CardProperties struct {
-	Color *string `json:"color,omitempty" validate:"omitnil,hexcolor,max=255"`
-	Tag   *string `json:"tag,omitempty" validate:"omitnil,max=255"`
+	Color    *string `json:"color,omitempty" validate:"omitnil,hexcolor,max=255"`
+	Tag      *string `json:"tag,omitempty" validate:"omitnil,max=255"`
+	Estimate *uint64 `json:"estimate,omitempty" validate:"omitnil,lte=1000"`
+}
+
+type CardSearchRequest struct {
+	UserID   uint64        `validate:"required,min=1"`
+	Query    string        `query:"q" validate:"required,max=500"`
+	Page     uint64        `query:"page" validate:"omitempty,min=1"`
+	PerPage  uint64        `query:"per_page" validate:"omitempty,min=1,max=200"`
+	Archived bool          `query:"archived"`
+	Filter   *filter.Query `query:"-"`
+}
+
+type MyCardsRequest struct {
+	UserID    uint64             `validate:"required,min=1"`
+	GroupBy   string             `query:"group_by" validate:"omitempty,oneof=board due_date"`
+	SortBy    string             `query:"sort_by" validate:"omitempty,oneof=created_at updated_at"`
+	SortOrder string             `query:"sort_order" validate:"omitempty,oneof=asc desc"`
+	Cursor    string             `query:"cursor" validate:"omitempty,max=100"`
+	Limit     uint64             `query:"limit" validate:"omitempty,min=1,max=100"`
+	Archived  bool               `query:"archived"`
+	After     *domain.CardCursor `query:"-"`
 }
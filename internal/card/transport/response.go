@@ -0,0 +1,112 @@
+package transport
+
+import (
+	"time"
+)
+
+type CardListItemResponse struct {
+	ID             uint64                 `json:"id"`
+	Key            string                 `json:"key"`
+	BoardID        string                 `json:"board_id"`
+	BoardName      string                 `json:"board_name"`
+	ColumnID       uint64                 `json:"column_id"`
+	ColumnName     string                 `json:"column_name"`
+	ColumnCategory string                 `json:"column_category"`
+	Text           string                 `json:"text"`
+	Description    string                 `json:"description"`
+	Position       uint64                 `json:"position"`
+	CreatedBy      uint64                 `json:"created_by,omitempty"`
+	DueDate        *time.Time             `json:"due_date"`
+	Properties     CardPropertiesResponse `json:"properties"`
+	Version        uint64                 `json:"version"`
+	CreatedAt      time.Time              `json:"created_at"`
+	UpdatedAt      time.Time              `json:"updated_at"`
+}
+
+type CardPropertiesResponse struct {
+	Color    string `json:"color,omitempty"`
+	Tag      string `json:"tag,omitempty"`
+	Estimate uint64 `json:"estimate,omitempty"`
+}
+
+type CardSearchResponse struct {
+	Data        []*CardListItemResponse `json:"data"`
+	PerPage     uint64                  `json:"per_page"`
+	CurrentPage uint64                  `json:"current_page"`
+	TotalCount  uint64                  `json:"total_count"`
+	TotalPages  uint64                  `json:"total_pages"`
+	HasNext     bool                    `json:"has_next"`
+	HasPrev     bool                    `json:"has_prev"`
+}
+
+// CardGroupResponse — группа карточек; Key — id доски или дата срока (NoDueDateKey без срока)
+type CardGroupResponse struct {
+	Key       string                  `json:"key"`
+	BoardID   string                  `json:"board_id,omitempty"`
+	BoardName string                  `json:"board_name,omitempty"`
+	Cards     []*CardListItemResponse `json:"cards"`
+}
+
+type MyCardsResponse struct {
+	Data       []*CardListItemResponse `json:"data,omitempty"`
+	Groups     []*CardGroupResponse    `json:"groups,omitempty"`
+	NextCursor *string                 `json:"next_cursor"`
+	HasNext    bool                    `json:"has_next"`
+}
+
+type CardBulkResponse struct {
+	DryRun  bool                      `json:"dry_run"`
+	Applied bool                      `json:"applied"`
+	Results []*CardBulkResultResponse `json:"results"`
+}
+
+// CardBulkResultResponse — результат операции пакета; Index — её номер в запросе
+type CardBulkResultResponse struct {
+	Index  int    `json:"index"`
+	Op     string `json:"op"`
+	CardID uint64 `json:"card_id"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type CardTreeResponse struct {
+	ID          uint64                 `json:"id"`
+	BoardID     string                 `json:"board_id"`
+	ColumnID    uint64                 `json:"column_id"`
+	ParentID    *uint64                `json:"parent_card_id"`
+	Text        string                 `json:"text"`
+	Description string                 `json:"description"`
+	Position    uint64                 `json:"position"`
+	DueDate     *time.Time             `json:"due_date"`
+	Properties  CardPropertiesResponse `json:"properties"`
+	Version     uint64                 `json:"version"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+	Children    []*CardTreeResponse    `json:"children"`
+}
+
+type CardTemplateResponse struct {
+	ID          uint64                 `json:"id"`
+	BoardID     string                 `json:"board_id"`
+	Name        string                 `json:"name"`
+	Text        string                 `json:"text"`
+	Description string                 `json:"description"`
+	Properties  CardPropertiesResponse `json:"properties"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+}
+
+type CardRecurrenceResponse struct {
+	ID          uint64                 `json:"id"`
+	BoardID     string                 `json:"board_id"`
+	ColumnID    uint64                 `json:"column_id"`
+	CreatedBy   uint64                 `json:"created_by,omitempty"`
+	Text        string                 `json:"text"`
+	Description string                 `json:"description"`
+	Properties  CardPropertiesResponse `json:"properties"`
+	Rule        string                 `json:"rule"`
+	StartsAt    time.Time              `json:"starts_at"`
+	NextRunAt   time.Time              `json:"next_run_at"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+}
@@ -7,6 +7,7 @@ type Comment struct {
 	CardID    uint64
 	UserID    uint64
 	Text      string
+	Version   uint64
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
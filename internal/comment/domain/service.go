@@ -19,6 +19,7 @@ type CommentDeleter interface {
 
 type CommentGetter interface {
 	CommentExistsInCard(ctx context.Context, cardID uint64) (bool, error)
+	GetByID(ctx context.Context, comment *Comment) (*Comment, error)
 }
 
 type CommentRepo interface {
@@ -54,13 +55,23 @@ func (s *CommentService) Create(ctx context.Context, req *Comment) error {
 	return nil
 }
 
+func (s *CommentService) Get(ctx context.Context, req *Comment) (*Comment, error) {
+	const op = "comment.service.Get"
+	comment, err := s.repository.GetByID(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return comment, nil
+}
+
 func (s *CommentService) Update(ctx context.Context, req *Comment) error {
 	const op = "comment.service.Update"
 	comment := &Comment{
-		ID:     req.ID,
-		CardID: req.CardID,
-		Text:   req.Text,
-		UserID: req.UserID,
+		ID:      req.ID,
+		CardID:  req.CardID,
+		Text:    req.Text,
+		UserID:  req.UserID,
+		Version: req.Version,
 	}
 
 	if err := s.repository.Update(ctx, comment); err != nil {
@@ -5,11 +5,13 @@ import (
 	"backend/internal/shared/utils"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 )
 
 const (
 	existsCommentsInCard = "SELECT EXISTS(SELECT 1 FROM comments WHERE card_id = $1 AND deleted_at IS NULL)"
+	existsCommentQuery   = "SELECT EXISTS(SELECT 1 FROM comments WHERE id = $1 AND card_id = $2 AND user_id = $3)"
 )
 
 type Storage interface {
@@ -71,19 +73,52 @@ func (r *CommentRepository) Create(ctx context.Context, comment *domain.Comment)
 }
 func (r *CommentRepository) Update(ctx context.Context, comment *domain.Comment) error {
 	const op = "comment.repository.Update"
-	query := "UPDATE comments SET text = $1, updated_at = NOW() WHERE id = $2 AND card_id = $3 AND user_id = $4"
+	query := `
+		UPDATE comments SET text = $1, version = version + 1, updated_at = NOW()
+		WHERE id = $2 AND card_id = $3 AND user_id = $4 AND ($5::BIGINT = 0 OR version = $5)
+	`
 
-	return utils.OpExec(
+	return utils.OpExecVersion(
 		ctx,
-		r.storage.ExecContext,
+		r.storage,
 		op,
 		query,
 		domain.ErrCardNotFound,
+		comment.Version,
+		existsCommentQuery,
+		[]any{comment.ID, comment.CardID, comment.UserID},
 		comment.Text,
 		comment.ID,
 		comment.CardID,
 		comment.UserID,
+		comment.Version,
+	)
+}
+
+func (r *CommentRepository) GetByID(ctx context.Context, comment *domain.Comment) (*domain.Comment, error) {
+	const op = "comment.repository.GetByID"
+	query := `
+		SELECT id, card_id, user_id, text, version, created_at, updated_at
+		FROM comments
+		WHERE id = $1 AND card_id = $2 AND deleted_at IS NULL
+	`
+	data := &domain.Comment{}
+	err := r.storage.QueryRowContext(ctx, query, comment.ID, comment.CardID).Scan(
+		&data.ID,
+		&data.CardID,
+		&data.UserID,
+		&data.Text,
+		&data.Version,
+		&data.CreatedAt,
+		&data.UpdatedAt,
 	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, domain.ErrCommentNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return data, nil
 }
 
 func (r *CommentRepository) Delete(ctx context.Context, commentID uint64) error {
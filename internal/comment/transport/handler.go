@@ -2,6 +2,7 @@ package transport
 
 import (
 	"backend/internal/comment/domain"
+	sharedErrors "backend/internal/shared/errors"
 	"backend/internal/shared/ports/http"
 	"backend/internal/shared/utils"
 	"context"
@@ -24,6 +25,7 @@ const (
 )
 
 type CommentService interface {
+	Get(ctx context.Context, req *domain.Comment) (*domain.Comment, error)
 	Create(ctx context.Context, req *domain.Comment) error
 	Update(ctx context.Context, req *domain.Comment) error
 	Delete(ctx context.Context, commentID uint64) error
@@ -114,7 +116,66 @@ func (h *CommentHandler) Update(c *fiber.Ctx) error {
 	}
 	comment.CardID = cardID
 	comment.ID = commentIDUint64
+	comment.Version, err = utils.IfMatchVersion(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": utils.ErrInvalidIfMatch.Error()})
+	}
+
+	return h.save(c, op, comment)
+}
+
+// Patch применяет JSON Merge Patch к текущему комментарию
+func (h *CommentHandler) Patch(c *fiber.Ctx) error {
+	const op = "comment.transport.handler.Patch"
+	userID, ok := c.Locals(UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"errors": "Unauthorized"})
+	}
+	commentID, err := strconv.ParseUint(c.Params(CommentIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": domain.ErrCommentNotFound.Error()})
+	}
+	cardID, err := strconv.ParseUint(c.Params(CardIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": domain.ErrCardNotFound.Error()})
+	}
+	version, err := utils.IfMatchVersion(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": utils.ErrInvalidIfMatch.Error()})
+	}
+
+	current, err := h.service.Get(c.Context(), &domain.Comment{
+		ID:     commentID,
+		CardID: cardID,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrCommentNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": domain.ErrCommentNotFound.Error()})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+
+	comment, err := utils.ParsePatch(c, h.commentMapper.ToCommentRequest(current))
+	if err != nil {
+		if errors.Is(err, utils.ErrUnsupportedPatchType) {
+			return c.Status(fiber.StatusUnsupportedMediaType).JSON(fiber.Map{"errors": err.Error()})
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	comment.ID = commentID
+	comment.CardID = cardID
+	comment.UserID = userID
+	comment.Version = version
+
+	return h.save(c, op, comment)
+}
 
+func (h *CommentHandler) save(c *fiber.Ctx, op string, comment *Comment) error {
 	if validationErrors, statusCode, err := h.validaotr.ValidateStruct(c, comment); validationErrors != nil {
 		if err != nil {
 			slog.Error("validator error",
@@ -130,6 +191,8 @@ func (h *CommentHandler) Update(c *fiber.Ctx) error {
 		switch {
 		case errors.Is(err, domain.ErrCardNotFound):
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Card not found"})
+		case errors.Is(err, sharedErrors.ErrVersionMismatch):
+			return h.versionConflict(c, op, comment)
 		}
 		slog.Error(
 			"service error",
@@ -159,3 +222,24 @@ func (h *CommentHandler) Delete(c *fiber.Ctx) error {
 
 	return c.SendStatus(fiber.StatusNoContent)
 }
+
+// versionConflict отвечает 412 с актуальным текстом комментария
+func (h *CommentHandler) versionConflict(c *fiber.Ctx, op string, req *Comment) error {
+	comment, err := h.service.Get(c.Context(), h.commentMapper.ToComment(req))
+	if err != nil {
+		if errors.Is(err, domain.ErrCommentNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": domain.ErrCommentNotFound.Error()})
+		}
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+	utils.SetETag(c, comment.Version)
+	return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+		"errors":  sharedErrors.ErrVersionMismatch.Error(),
+		"current": h.commentMapper.ToCommentResponse(comment),
+	})
+}
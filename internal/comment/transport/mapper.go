@@ -12,9 +12,40 @@ func (m *CommentMapper) ToComment(req *Comment) *domain.Comment {
 	}
 
 	return &domain.Comment{
-		ID:     req.ID,
-		UserID: req.UserID,
-		CardID: req.CardID,
-		Text:   req.Text,
+		ID:      req.ID,
+		UserID:  req.UserID,
+		CardID:  req.CardID,
+		Text:    req.Text,
+		Version: req.Version,
+	}
+}
+
+func (m *CommentMapper) ToCommentRequest(data *domain.Comment) *Comment {
+	if data == nil {
+		return nil
+	}
+
+	return &Comment{
+		ID:      data.ID,
+		UserID:  data.UserID,
+		CardID:  data.CardID,
+		Text:    data.Text,
+		Version: data.Version,
+	}
+}
+
+func (m *CommentMapper) ToCommentResponse(data *domain.Comment) *CommentResponse {
+	if data == nil {
+		return nil
+	}
+
+	return &CommentResponse{
+		ID:        data.ID,
+		CardID:    data.CardID,
+		UserID:    data.UserID,
+		Text:      data.Text,
+		Version:   data.Version,
+		CreatedAt: data.CreatedAt,
+		UpdatedAt: data.UpdatedAt,
 	}
 }
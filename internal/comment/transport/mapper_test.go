@@ -4,6 +4,7 @@ import (
 	"backend/internal/comment/domain"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -93,3 +94,71 @@ func Test_ToComment(t *testing.T) {
 		})
 	}
 }
+
+func Test_ToCommentResponse(t *testing.T) {
+	mapper := CommentMapper{}
+	now := time.Now()
+
+	assert.Nil(t, mapper.ToCommentResponse(nil))
+	assert.Equal(t, &CommentResponse{
+		ID:        1,
+		CardID:    2,
+		UserID:    3,
+		Text:      "Test comment",
+		Version:   2,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, mapper.ToCommentResponse(&domain.Comment{
+		ID:        1,
+		CardID:    2,
+		UserID:    3,
+		Text:      "Test comment",
+		Version:   2,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}))
+}
+
+func Test_ToCommentRequest(t *testing.T) {
+	mapper := CommentMapper{}
+	tests := []struct {
+		name     string
+		data     *domain.Comment
+		expected *Comment
+	}{
+		{
+			name:     "nil pointer",
+			data:     nil,
+			expected: nil,
+		},
+		{
+			name: "valid data",
+			data: &domain.Comment{
+				ID:        1,
+				CardID:    2,
+				UserID:    3,
+				Text:      "Test comment",
+				Version:   4,
+				CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expected: &Comment{
+				ID:      1,
+				CardID:  2,
+				UserID:  3,
+				Text:    "Test comment",
+				Version: 4,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		name := fmt.Sprintf("case(%s)", test.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(
+				t,
+				test.expected,
+				mapper.ToCommentRequest(test.data),
+			)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package transport
+
+import "time"
+
+type CommentResponse struct {
+	ID        uint64    `json:"id"`
+	CardID    uint64    `json:"card_id"`
+	UserID    uint64    `json:"user_id"`
+	Text      string    `json:"text"`
+	Version   uint64    `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
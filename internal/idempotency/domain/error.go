@@ -0,0 +1,9 @@
+package domain
+
+import "errors"
+
+var (
+	ErrRecordNotFound    = errors.New("idempotency key not found")
+	ErrKeyReused         = errors.New("idempotency key reused with a different request")
+	ErrRequestInProgress = errors.New("request with this idempotency key is in progress")
+)
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"time"
+)
+
+const (
+	// KeyTTL — сколько хранится ответ, сохранённый по ключу идемпотентности
+	KeyTTL = 24 * time.Hour
+	// ReservationTTL — сколько держится резерв незавершённого запроса: если процесс упал,
+	// не сохранив ответ и не сняв резерв, после этого срока ключ можно занять заново
+	ReservationTTL = 5 * time.Minute
+	MaxKeyLength   = 255
+)
+
+// Record — ответ на запрос с ключом идемпотентности.
+// Пока запрос выполняется, StatusCode равен нулю.
+type Record struct {
+	UserID      uint64
+	Key         string
+	RequestHash string
+	StatusCode  int
+	ContentType string
+	Body        []byte
+	ExpiresAt   time.Time
+}
+
+func (r *Record) Completed() bool {
+	return r.StatusCode != 0
+}
+
+// RequestHash — отпечаток запроса: повтор с тем же ключом должен совпасть по методу, пути,
+// параметрам строки запроса и телу. Параметры сортируются, поэтому их порядок не важен.
+func RequestHash(method, path, rawQuery string, body []byte) string {
+	query := rawQuery
+	if values, err := url.ParseQuery(rawQuery); err == nil {
+		query = values.Encode()
+	}
+	hash := sha256.New()
+	hash.Write([]byte(method + " " + path + "?" + query + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
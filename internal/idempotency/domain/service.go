@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// maxReserveAttempts ограничивает повторы, когда ключ освобождается между резервированием и чтением
+const maxReserveAttempts = 3
+
+type IdempotencyRepo interface {
+	Reserve(ctx context.Context, record *Record) (bool, error)
+	Get(ctx context.Context, userID uint64, key string) (*Record, error)
+	Complete(ctx context.Context, record *Record) error
+	Release(ctx context.Context, userID uint64, key string) error
+	DeleteExpired(ctx context.Context) error
+}
+
+type IdempotencyService struct {
+	repo IdempotencyRepo
+}
+
+func NewIdempotencyService(repo IdempotencyRepo) *IdempotencyService {
+	return &IdempotencyService{
+		repo: repo,
+	}
+}
+
+// Begin закрепляет ключ за запросом. nil без ошибки означает, что запрос нужно выполнить;
+// иначе возвращается сохранённый ответ для повтора.
+func (s *IdempotencyService) Begin(ctx context.Context, req *Record) (*Record, error) {
+	const op = "idempotency.service.Begin"
+	record := &Record{
+		UserID:      req.UserID,
+		Key:         req.Key,
+		RequestHash: req.RequestHash,
+		ExpiresAt:   time.Now().Add(KeyTTL),
+	}
+	for range maxReserveAttempts {
+		reserved, err := s.repo.Reserve(ctx, record)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if reserved {
+			return nil, nil
+		}
+		stored, err := s.repo.Get(ctx, record.UserID, record.Key)
+		if errors.Is(err, ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if stored.RequestHash != record.RequestHash {
+			return nil, fmt.Errorf("%s: %w", op, ErrKeyReused)
+		}
+		if !stored.Completed() {
+			return nil, fmt.Errorf("%s: %w", op, ErrRequestInProgress)
+		}
+		return stored, nil
+	}
+	return nil, fmt.Errorf("%s: %w", op, ErrRequestInProgress)
+}
+
+func (s *IdempotencyService) Complete(ctx context.Context, record *Record) error {
+	const op = "idempotency.service.Complete"
+	if err := s.repo.Complete(ctx, record); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Release снимает резерв с ключа, чтобы повтор выполнил запрос заново
+func (s *IdempotencyService) Release(ctx context.Context, userID uint64, key string) error {
+	const op = "idempotency.service.Release"
+	if err := s.repo.Release(ctx, userID, key); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *IdempotencyService) DeleteExpired(ctx context.Context) error {
+	const op = "idempotency.service.DeleteExpired"
+	if err := s.repo.DeleteExpired(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
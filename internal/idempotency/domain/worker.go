@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type ExpiredCleaner interface {
+	DeleteExpired(ctx context.Context) error
+}
+
+// CleanupWorker периодически удаляет ответы с истёкшим сроком хранения
+type CleanupWorker struct {
+	cleaner  ExpiredCleaner
+	interval time.Duration
+}
+
+func NewCleanupWorker(cleaner ExpiredCleaner, interval time.Duration) *CleanupWorker {
+	return &CleanupWorker{
+		cleaner:  cleaner,
+		interval: interval,
+	}
+}
+
+func (w *CleanupWorker) Run(ctx context.Context) {
+	const op = "idempotency.worker.Run"
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		if err := w.cleaner.DeleteExpired(ctx); err != nil {
+			slog.Error("delete expired idempotency keys", slog.String("op", op), slog.Any("err", err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"backend/internal/idempotency/domain"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+const (
+	// reserveQuery занимает ключ; просроченная запись с тем же ключом перезаписывается,
+	// как и резерв без ответа старше $5 секунд: его запрос уже не завершится
+	reserveQuery = `
+		INSERT INTO idempotency_keys (user_id, key, request_hash, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash,
+			status_code = NULL,
+			content_type = '',
+			response_body = NULL,
+			created_at = NOW(),
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= NOW()
+			OR (idempotency_keys.status_code IS NULL AND idempotency_keys.created_at < NOW() - make_interval(secs => $5))
+		RETURNING user_id
+	`
+	selectRecordQuery = `
+		SELECT user_id, key, request_hash, COALESCE(status_code, 0), content_type, response_body, expires_at
+		FROM idempotency_keys
+		WHERE user_id = $1 AND key = $2 AND expires_at > NOW()
+	`
+	completeQuery = `
+		UPDATE idempotency_keys
+		SET status_code = $3, content_type = $4, response_body = $5
+		WHERE user_id = $1 AND key = $2 AND status_code IS NULL
+	`
+	releaseQuery       = "DELETE FROM idempotency_keys WHERE user_id = $1 AND key = $2 AND status_code IS NULL"
+	deleteExpiredQuery = "DELETE FROM idempotency_keys WHERE expires_at <= NOW()"
+)
+
+type Storage interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+	Begin() (*sql.Tx, error)
+
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+	GetDB() *sql.DB
+	Close() error
+}
+
+type IdempotencyRepository struct {
+	storage Storage
+}
+
+func NewIdempotencyRepository(storage Storage) *IdempotencyRepository {
+	return &IdempotencyRepository{
+		storage: storage,
+	}
+}
+
+// Reserve сообщает, удалось ли занять ключ; false — ключ уже занят живой записью
+func (r *IdempotencyRepository) Reserve(ctx context.Context, record *domain.Record) (bool, error) {
+	const op = "idempotency.repository.Reserve"
+	var userID uint64
+	err := r.storage.QueryRowContext(
+		ctx,
+		reserveQuery,
+		record.UserID,
+		record.Key,
+		record.RequestHash,
+		record.ExpiresAt,
+		domain.ReservationTTL.Seconds(),
+	).Scan(&userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return true, nil
+}
+
+func (r *IdempotencyRepository) Get(ctx context.Context, userID uint64, key string) (*domain.Record, error) {
+	const op = "idempotency.repository.Get"
+	record := &domain.Record{}
+	err := r.storage.QueryRowContext(ctx, selectRecordQuery, userID, key).Scan(
+		&record.UserID,
+		&record.Key,
+		&record.RequestHash,
+		&record.StatusCode,
+		&record.ContentType,
+		&record.Body,
+		&record.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, domain.ErrRecordNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return record, nil
+}
+
+func (r *IdempotencyRepository) Complete(ctx context.Context, record *domain.Record) error {
+	const op = "idempotency.repository.Complete"
+	_, err := r.storage.ExecContext(
+		ctx,
+		completeQuery,
+		record.UserID,
+		record.Key,
+		record.StatusCode,
+		record.ContentType,
+		record.Body,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (r *IdempotencyRepository) Release(ctx context.Context, userID uint64, key string) error {
+	const op = "idempotency.repository.Release"
+	if _, err := r.storage.ExecContext(ctx, releaseQuery, userID, key); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context) error {
+	const op = "idempotency.repository.DeleteExpired"
+	if _, err := r.storage.ExecContext(ctx, deleteExpiredQuery); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
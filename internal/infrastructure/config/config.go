@@ -4,6 +4,7 @@ import (
 	"log/slog"
 	"os"
 	"strconv"
+	"strings"
 )
 
 type dbConfig struct {
@@ -27,6 +28,10 @@ type Config struct {
 	LogLevel    int8
 	BcryptPower int8
 	ServerPort  string
+	// RecurrenceCatchUp — создавать ли карточки за повторения, пропущенные пока сервер не работал
+	RecurrenceCatchUp bool
+	// AdminUserIDs — пользователи с доступом к /admin, из ADMIN_USER_IDS через запятую
+	AdminUserIDs []uint64
 	dbConfig
 	jwtConfig
 }
@@ -46,11 +51,32 @@ func NewConfig() *Config {
 		slog.Error(err.Error())
 		panic(err.Error())
 	}
+	var recurrenceCatchUp bool
+	if value := os.Getenv("RECURRENCE_CATCH_UP"); value != "" {
+		if recurrenceCatchUp, err = strconv.ParseBool(value); err != nil {
+			slog.Error(err.Error())
+			panic(err.Error())
+		}
+	}
+	adminUserIDs := []uint64{}
+	for _, value := range strings.Split(os.Getenv("ADMIN_USER_IDS"), ",") {
+		if value = strings.TrimSpace(value); value == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || id == 0 {
+			slog.Error("invalid ADMIN_USER_IDS", slog.String("value", value))
+			panic("invalid ADMIN_USER_IDS: " + value)
+		}
+		adminUserIDs = append(adminUserIDs, id)
+	}
 	serverConfig := &Config{
-		AppName:     os.Getenv("APP_NAME"),
-		ServerPort:  os.Getenv("HTTP_PORT"),
-		LogLevel:    int8(logLevel),
-		BcryptPower: int8(bcryptPower),
+		AppName:           os.Getenv("APP_NAME"),
+		ServerPort:        os.Getenv("HTTP_PORT"),
+		LogLevel:          int8(logLevel),
+		BcryptPower:       int8(bcryptPower),
+		RecurrenceCatchUp: recurrenceCatchUp,
+		AdminUserIDs:      adminUserIDs,
 	}
 	serverConfig.dbConfig = dbConfig{
 		Username: os.Getenv("DB_USERNAME"),
@@ -121,6 +147,14 @@ func (c *Config) GetServerPort() string {
 	return c.ServerPort
 }
 
+func (c *Config) GetRecurrenceCatchUp() bool {
+	return c.RecurrenceCatchUp
+}
+
+func (c *Config) GetAdminUserIDs() []uint64 {
+	return c.AdminUserIDs
+}
+
 func (c *Config) GetAppName() string {
 	return c.AppName
 }
@@ -0,0 +1 @@
+ALTER TABLE board_columns ADD COLUMN IF NOT EXISTS category VARCHAR(20) NOT NULL DEFAULT 'todo';
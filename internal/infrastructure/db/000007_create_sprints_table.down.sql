@@ -0,0 +1 @@
+DROP TABLE IF EXISTS sprints;
\ No newline at end of file
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS sprints (
+    id SERIAL PRIMARY KEY,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE RESTRICT,
+    name VARCHAR(255) NOT NULL,
+    goal TEXT,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'planned',
+    closed_at TIMESTAMPTZ DEFAULT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ DEFAULT NULL,
+    CHECK (end_date >= start_date)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS sprints_one_active_per_board_idx
+    ON sprints (board_id)
+    WHERE status = 'active' AND deleted_at IS NULL;
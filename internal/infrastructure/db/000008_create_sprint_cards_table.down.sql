@@ -0,0 +1 @@
+DROP TABLE IF EXISTS sprint_cards;
\ No newline at end of file
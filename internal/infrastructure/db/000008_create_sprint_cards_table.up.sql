@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS sprint_cards (
+    sprint_id INTEGER NOT NULL REFERENCES sprints(id) ON DELETE RESTRICT,
+    card_id INTEGER NOT NULL REFERENCES cards(id) ON DELETE RESTRICT,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (sprint_id, card_id)
+);
+
+CREATE INDEX IF NOT EXISTS sprint_cards_card_id_idx ON sprint_cards (card_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS sprint_snapshots;
\ No newline at end of file
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS sprint_snapshots (
+    sprint_id INTEGER NOT NULL REFERENCES sprints(id) ON DELETE RESTRICT,
+    snapshot_date DATE NOT NULL,
+    total_cards INTEGER NOT NULL DEFAULT 0,
+    completed_cards INTEGER NOT NULL DEFAULT 0,
+    total_estimate INTEGER NOT NULL DEFAULT 0,
+    completed_estimate INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (sprint_id, snapshot_date)
+);
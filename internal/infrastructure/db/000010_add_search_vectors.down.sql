@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS comments_search_ru_idx;
+DROP INDEX IF EXISTS comments_search_en_idx;
+DROP INDEX IF EXISTS cards_search_ru_idx;
+DROP INDEX IF EXISTS cards_search_en_idx;
+DROP INDEX IF EXISTS boards_search_ru_idx;
+DROP INDEX IF EXISTS boards_search_en_idx;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS search_en, DROP COLUMN IF EXISTS search_ru;
+ALTER TABLE cards DROP COLUMN IF EXISTS search_en, DROP COLUMN IF EXISTS search_ru;
+ALTER TABLE boards DROP COLUMN IF EXISTS search_en, DROP COLUMN IF EXISTS search_ru;
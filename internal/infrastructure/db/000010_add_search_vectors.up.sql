@@ -0,0 +1,34 @@
+ALTER TABLE boards
+    ADD COLUMN IF NOT EXISTS search_en TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', COALESCE(name, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(description, '')), 'B')
+    ) STORED,
+    ADD COLUMN IF NOT EXISTS search_ru TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', COALESCE(name, '')), 'A') ||
+        setweight(to_tsvector('russian', COALESCE(description, '')), 'B')
+    ) STORED;
+
+ALTER TABLE cards
+    ADD COLUMN IF NOT EXISTS search_en TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', COALESCE(text, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(description, '')), 'B')
+    ) STORED,
+    ADD COLUMN IF NOT EXISTS search_ru TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', COALESCE(text, '')), 'A') ||
+        setweight(to_tsvector('russian', COALESCE(description, '')), 'B')
+    ) STORED;
+
+ALTER TABLE comments
+    ADD COLUMN IF NOT EXISTS search_en TSVECTOR GENERATED ALWAYS AS (
+        to_tsvector('english', COALESCE(text, ''))
+    ) STORED,
+    ADD COLUMN IF NOT EXISTS search_ru TSVECTOR GENERATED ALWAYS AS (
+        to_tsvector('russian', COALESCE(text, ''))
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS boards_search_en_idx ON boards USING GIN (search_en);
+CREATE INDEX IF NOT EXISTS boards_search_ru_idx ON boards USING GIN (search_ru);
+CREATE INDEX IF NOT EXISTS cards_search_en_idx ON cards USING GIN (search_en);
+CREATE INDEX IF NOT EXISTS cards_search_ru_idx ON cards USING GIN (search_ru);
+CREATE INDEX IF NOT EXISTS comments_search_en_idx ON comments USING GIN (search_en);
+CREATE INDEX IF NOT EXISTS comments_search_ru_idx ON comments USING GIN (search_ru);
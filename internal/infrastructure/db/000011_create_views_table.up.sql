@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS views (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    board_id UUID REFERENCES boards(id) ON DELETE RESTRICT,
+    name VARCHAR(100) NOT NULL,
+    query TEXT NOT NULL DEFAULT '',
+    sort_by VARCHAR(20) NOT NULL DEFAULT 'position',
+    sort_order VARCHAR(4) NOT NULL DEFAULT 'asc',
+    shared BOOLEAN NOT NULL DEFAULT FALSE,
+    layout JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ DEFAULT NULL,
+    CHECK (sort_by IN ('position', 'created_at', 'updated_at', 'estimate')),
+    CHECK (sort_order IN ('asc', 'desc')),
+    CHECK (NOT shared OR board_id IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS views_user_id_idx ON views (user_id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS views_board_id_idx ON views (board_id) WHERE deleted_at IS NULL;
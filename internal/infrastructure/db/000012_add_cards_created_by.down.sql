@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS comments_user_id_idx;
+DROP INDEX IF EXISTS cards_created_by_idx;
+ALTER TABLE cards DROP COLUMN IF EXISTS created_by;
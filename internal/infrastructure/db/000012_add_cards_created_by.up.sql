@@ -0,0 +1,8 @@
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS created_by INTEGER REFERENCES users(id) ON DELETE RESTRICT;
+
+UPDATE cards SET created_by = boards.user_id
+FROM boards
+WHERE boards.id = cards.board_id AND cards.created_by IS NULL;
+
+CREATE INDEX IF NOT EXISTS cards_created_by_idx ON cards (created_by) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS comments_user_id_idx ON comments (user_id) WHERE deleted_at IS NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS calendar_feed_tokens;
+DROP INDEX IF EXISTS cards_due_date_idx;
+ALTER TABLE cards DROP COLUMN IF EXISTS due_date;
@@ -0,0 +1,9 @@
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS due_date TIMESTAMPTZ DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS cards_due_date_idx ON cards (due_date) WHERE deleted_at IS NULL AND due_date IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS calendar_feed_tokens (
+    user_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE RESTRICT,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
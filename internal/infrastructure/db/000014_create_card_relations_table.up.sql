@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS card_relations (
+    id SERIAL PRIMARY KEY,
+    source_card_id INTEGER NOT NULL REFERENCES cards(id) ON DELETE RESTRICT,
+    target_card_id INTEGER NOT NULL REFERENCES cards(id) ON DELETE RESTRICT,
+    type VARCHAR(20) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (source_card_id, target_card_id, type),
+    CHECK (type IN ('blocks', 'relates_to', 'duplicates')),
+    CHECK (source_card_id <> target_card_id)
+);
+
+CREATE INDEX IF NOT EXISTS card_relations_target_card_id_idx ON card_relations (target_card_id, type);
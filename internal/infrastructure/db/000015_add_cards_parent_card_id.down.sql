@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS cards_parent_card_id_idx;
+ALTER TABLE cards DROP COLUMN IF EXISTS parent_card_id;
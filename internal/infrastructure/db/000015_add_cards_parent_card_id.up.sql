@@ -0,0 +1,3 @@
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS parent_card_id INTEGER DEFAULT NULL REFERENCES cards(id) ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS cards_parent_card_id_idx ON cards (parent_card_id) WHERE deleted_at IS NULL AND parent_card_id IS NOT NULL;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS cards_board_id_number_idx;
+DROP INDEX IF EXISTS boards_user_id_key_idx;
+ALTER TABLE cards DROP COLUMN IF EXISTS number;
+ALTER TABLE boards DROP COLUMN IF EXISTS card_seq;
+ALTER TABLE boards DROP COLUMN IF EXISTS key;
@@ -0,0 +1,30 @@
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS key VARCHAR(10);
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS card_seq INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS number INTEGER;
+
+WITH prefixes AS (
+    SELECT id, user_id, created_at,
+        COALESCE(NULLIF(UPPER(LEFT(REGEXP_REPLACE(name, '[^A-Za-z]', '', 'g'), 4)), ''), 'BRD') AS prefix
+    FROM boards
+), numbered AS (
+    SELECT id, prefix, ROW_NUMBER() OVER (PARTITION BY user_id, prefix ORDER BY created_at, id) AS n
+    FROM prefixes
+)
+UPDATE boards
+SET key = CASE WHEN numbered.n = 1 THEN numbered.prefix ELSE numbered.prefix || numbered.n END
+FROM numbered
+WHERE numbered.id = boards.id;
+
+WITH numbered AS (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY board_id ORDER BY created_at, id) AS n
+    FROM cards
+)
+UPDATE cards SET number = numbered.n FROM numbered WHERE numbered.id = cards.id;
+
+UPDATE boards SET card_seq = COALESCE((SELECT MAX(number) FROM cards WHERE cards.board_id = boards.id), 0);
+
+ALTER TABLE boards ALTER COLUMN key SET NOT NULL;
+ALTER TABLE cards ALTER COLUMN number SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS boards_user_id_key_idx ON boards (user_id, key) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS cards_board_id_number_idx ON cards (board_id, number);
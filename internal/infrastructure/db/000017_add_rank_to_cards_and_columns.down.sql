@@ -0,0 +1,19 @@
+WITH ordered AS (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY column_id ORDER BY rank, id) AS n
+    FROM cards
+    WHERE deleted_at IS NULL
+)
+UPDATE cards SET position = ordered.n FROM ordered WHERE ordered.id = cards.id;
+
+WITH ordered AS (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY board_id ORDER BY rank, id) AS n
+    FROM board_columns
+    WHERE deleted_at IS NULL
+)
+UPDATE board_columns SET position = ordered.n FROM ordered WHERE ordered.id = board_columns.id;
+
+DROP INDEX IF EXISTS board_columns_board_id_rank_idx;
+DROP INDEX IF EXISTS cards_column_id_rank_idx;
+
+ALTER TABLE board_columns DROP COLUMN IF EXISTS rank;
+ALTER TABLE cards DROP COLUMN IF EXISTS rank;
@@ -0,0 +1,21 @@
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS rank VARCHAR(64) COLLATE "C";
+ALTER TABLE board_columns ADD COLUMN IF NOT EXISTS rank VARCHAR(64) COLLATE "C";
+
+-- ключи вида 000001i сохраняют текущий порядок и не заканчиваются на 0
+WITH ordered AS (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY column_id ORDER BY position NULLS LAST, id) AS n
+    FROM cards
+)
+UPDATE cards SET rank = LPAD(ordered.n::TEXT, 6, '0') || 'i' FROM ordered WHERE ordered.id = cards.id;
+
+WITH ordered AS (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY board_id ORDER BY position NULLS LAST, id) AS n
+    FROM board_columns
+)
+UPDATE board_columns SET rank = LPAD(ordered.n::TEXT, 6, '0') || 'i' FROM ordered WHERE ordered.id = board_columns.id;
+
+ALTER TABLE cards ALTER COLUMN rank SET NOT NULL;
+ALTER TABLE board_columns ALTER COLUMN rank SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS cards_column_id_rank_idx ON cards (column_id, rank) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS board_columns_board_id_rank_idx ON board_columns (board_id, rank) WHERE deleted_at IS NULL;
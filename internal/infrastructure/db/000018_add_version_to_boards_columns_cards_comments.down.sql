@@ -0,0 +1,4 @@
+ALTER TABLE comments DROP COLUMN IF EXISTS version;
+ALTER TABLE cards DROP COLUMN IF EXISTS version;
+ALTER TABLE board_columns DROP COLUMN IF EXISTS version;
+ALTER TABLE boards DROP COLUMN IF EXISTS version;
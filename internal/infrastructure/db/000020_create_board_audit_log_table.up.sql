@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS board_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    action VARCHAR(50) NOT NULL,
+    card_id INTEGER,
+    details JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS board_audit_log_board_id_created_at_idx ON board_audit_log (board_id, created_at);
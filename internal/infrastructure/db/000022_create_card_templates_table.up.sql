@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS card_templates (
+    id SERIAL PRIMARY KEY,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    text TEXT NOT NULL,
+    description TEXT NOT NULL,
+    properties JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ DEFAULT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS card_templates_board_id_name_idx ON card_templates (board_id, name) WHERE deleted_at IS NULL;
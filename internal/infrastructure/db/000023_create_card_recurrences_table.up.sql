@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS card_recurrences (
+    id SERIAL PRIMARY KEY,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    column_id INTEGER NOT NULL REFERENCES board_columns(id) ON DELETE CASCADE,
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    text TEXT NOT NULL,
+    description TEXT NOT NULL,
+    properties JSONB NOT NULL DEFAULT '{}',
+    rule VARCHAR(255) NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    next_run_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ DEFAULT NULL
+);
+
+CREATE INDEX IF NOT EXISTS card_recurrences_next_run_at_idx ON card_recurrences (next_run_at) WHERE deleted_at IS NULL;
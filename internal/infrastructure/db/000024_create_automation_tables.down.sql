@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS automation_executions;
+DROP TABLE IF EXISTS automation_rules;
@@ -0,0 +1,3 @@
+ALTER TABLE cards DROP COLUMN IF EXISTS archived_at;
+ALTER TABLE board_columns DROP COLUMN IF EXISTS archived_at;
+ALTER TABLE boards DROP COLUMN IF EXISTS archived_at;
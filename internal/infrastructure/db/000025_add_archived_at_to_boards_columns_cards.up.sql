@@ -0,0 +1,3 @@
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ DEFAULT NULL;
+ALTER TABLE board_columns ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ DEFAULT NULL;
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ DEFAULT NULL;
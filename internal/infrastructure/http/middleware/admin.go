@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"slices"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// AdminRequired пропускает только пользователей из adminUserIDs (ADMIN_USER_IDS в конфигурации).
+// Ставится после AuthRequired.
+func AdminRequired(adminUserIDs []uint64) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, ok := c.Locals(UserIDKey).(uint64)
+		if !ok || !slices.Contains(adminUserIDs, userID) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				fiber.Map{"errors": "forbidden"},
+			)
+		}
+
+		return c.Next()
+	}
+}
@@ -50,6 +50,23 @@ func AuthRequired(c *fiber.Ctx) error {
 	return c.Next()
 }
 
+// tokenUserID достаёт пользователя из действующего access-токена, не отвечая клиенту
+func tokenUserID(c *fiber.Ctx) (uint64, bool) {
+	token := getToken(c)
+	if token == "" {
+		return 0, false
+	}
+
+	claims := &Claims{}
+	if parsedToken, err := parseToken(token, claims); err != nil || !parsedToken.Valid {
+		return 0, false
+	}
+	if claims.Sub.UserID == nil || *claims.Sub.UserID == 0 {
+		return 0, false
+	}
+	return *claims.Sub.UserID, true
+}
+
 func getToken(c *fiber.Ctx) string {
 	token := c.Get("Authorization")
 	token = strings.TrimPrefix(token, BearerPrefix)
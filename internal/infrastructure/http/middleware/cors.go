@@ -7,7 +7,8 @@ import (
 func CORS(c *fiber.Ctx) error {
 	c.Set("Access-Control-Allow-Origin", "*")
 	c.Set("Access-Control-Allow-Methods", "GET,POST,PUT,DELETE,PATCH,OPTIONS")
-	c.Set("Access-Control-Allow-Headers", "Content-Type,Authorization,Refresh-Token,Accept,Origin,X-Requested-With")
+	c.Set("Access-Control-Allow-Headers", "Content-Type,Authorization,Refresh-Token,Accept,Origin,X-Requested-With,If-Match,Idempotency-Key")
+	c.Set("Access-Control-Expose-Headers", "ETag,Idempotent-Replayed")
 	c.Set("Access-Control-Allow-Credentials", "true")
 	c.Set("Access-Control-Max-Age", "3600")
 
@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"backend/internal/idempotency/domain"
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotentReplayedHeader  = "Idempotent-Replayed"
+	idempotencyRetryAfterSecs = "1"
+)
+
+type IdempotencyService interface {
+	Begin(ctx context.Context, req *domain.Record) (*domain.Record, error)
+	Complete(ctx context.Context, record *domain.Record) error
+	Release(ctx context.Context, userID uint64, key string) error
+}
+
+// Idempotency сохраняет ответ на POST, PUT и DELETE с заголовком Idempotency-Key
+// и отдаёт его повторно на запрос с тем же ключом. Ключ привязан к пользователю,
+// поэтому запросы без действующего токена проходят без проверки.
+// Ответы 5xx не сохраняются: повтор выполнит запрос заново.
+func Idempotency(service IdempotencyService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		const op = "middleware.Idempotency"
+		key := c.Get(IdempotencyKeyHeader)
+		if key == "" || !isIdempotentMethod(c.Method()) {
+			return c.Next()
+		}
+		userID, ok := tokenUserID(c)
+		if !ok {
+			return c.Next()
+		}
+		if len(key) > domain.MaxKeyLength {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "invalid idempotency key"})
+		}
+
+		record := &domain.Record{
+			UserID:      userID,
+			Key:         key,
+			RequestHash: domain.RequestHash(c.Method(), c.Path(), string(c.Request().URI().QueryString()), c.Body()),
+		}
+		stored, err := service.Begin(c.Context(), record)
+		switch {
+		case errors.Is(err, domain.ErrKeyReused):
+			return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{"errors": domain.ErrKeyReused.Error()})
+		case errors.Is(err, domain.ErrRequestInProgress):
+			c.Set(fiber.HeaderRetryAfter, idempotencyRetryAfterSecs)
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrRequestInProgress.Error()})
+		case err != nil:
+			slog.Error("idempotency error", slog.String("op", op), slog.Any("err", err))
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+		}
+		if stored != nil {
+			c.Set(IdempotentReplayedHeader, "true")
+			if stored.ContentType != "" {
+				c.Set(fiber.HeaderContentType, stored.ContentType)
+			}
+			return c.Status(stored.StatusCode).Send(stored.Body)
+		}
+
+		completed := false
+		// резерв снимается и при панике обработчика, иначе ключ был бы занят до конца срока хранения
+		defer func() {
+			if completed {
+				return
+			}
+			if err := service.Release(c.Context(), userID, key); err != nil {
+				slog.Error("idempotency release error", slog.String("op", op), slog.Any("err", err))
+			}
+		}()
+
+		if err := c.Next(); err != nil {
+			return err
+		}
+		if c.Response().StatusCode() >= fiber.StatusInternalServerError {
+			return nil
+		}
+		record.StatusCode = c.Response().StatusCode()
+		record.ContentType = string(c.Response().Header.ContentType())
+		record.Body = bytes.Clone(c.Response().Body())
+		if err := service.Complete(c.Context(), record); err != nil {
+			slog.Error("idempotency complete error", slog.String("op", op), slog.Any("err", err))
+			return nil
+		}
+		completed = true
+		return nil
+	}
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case fiber.MethodPost, fiber.MethodPut, fiber.MethodDelete:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,213 @@
+package middleware
+
+import (
+	"backend/internal/idempotency/domain"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTokenSecret = "test-secret"
+
+type memoryIdempotencyRepo struct {
+	mu      sync.Mutex
+	records map[string]*domain.Record
+}
+
+func newMemoryIdempotencyRepo() *memoryIdempotencyRepo {
+	return &memoryIdempotencyRepo{records: map[string]*domain.Record{}}
+}
+
+func recordKey(userID uint64, key string) string {
+	return fmt.Sprintf("%d:%s", userID, key)
+}
+
+func (r *memoryIdempotencyRepo) Reserve(_ context.Context, record *domain.Record) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if stored, ok := r.records[recordKey(record.UserID, record.Key)]; ok && stored.ExpiresAt.After(time.Now()) {
+		return false, nil
+	}
+	copied := *record
+	r.records[recordKey(record.UserID, record.Key)] = &copied
+	return true, nil
+}
+
+func (r *memoryIdempotencyRepo) Get(_ context.Context, userID uint64, key string) (*domain.Record, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.records[recordKey(userID, key)]
+	if !ok {
+		return nil, domain.ErrRecordNotFound
+	}
+	copied := *stored
+	return &copied, nil
+}
+
+func (r *memoryIdempotencyRepo) Complete(_ context.Context, record *domain.Record) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := r.records[recordKey(record.UserID, record.Key)]
+	stored.StatusCode = record.StatusCode
+	stored.ContentType = record.ContentType
+	stored.Body = record.Body
+	return nil
+}
+
+func (r *memoryIdempotencyRepo) Release(_ context.Context, userID uint64, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if stored, ok := r.records[recordKey(userID, key)]; ok && !stored.Completed() {
+		delete(r.records, recordKey(userID, key))
+	}
+	return nil
+}
+
+func (r *memoryIdempotencyRepo) DeleteExpired(context.Context) error {
+	return nil
+}
+
+func testToken(t *testing.T, userID uint64) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": map[string]any{"id": userID},
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte(testTokenSecret))
+	require.NoError(t, err)
+	return token
+}
+
+func idempotentRequest(t *testing.T, app *fiber.App, userID uint64, key, body string) (*http.Response, string) {
+	t.Helper()
+	return idempotentRequestTo(t, app, "/cards", userID, key, body)
+}
+
+func idempotentRequestTo(t *testing.T, app *fiber.App, target string, userID uint64, key, body string) (*http.Response, string) {
+	t.Helper()
+	req := httptest.NewRequest(fiber.MethodPost, target, strings.NewReader(body))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	req.Header.Set(fiber.HeaderAuthorization, BearerPrefix+testToken(t, userID))
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	raw, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(raw)
+}
+
+func newIdempotencyApp(handler fiber.Handler) *fiber.App {
+	app := fiber.New()
+	app.Use(Idempotency(domain.NewIdempotencyService(newMemoryIdempotencyRepo())))
+	app.Post("/cards", handler)
+	return app
+}
+
+func Test_IdempotencyReplaysStoredResponse(t *testing.T) {
+	t.Setenv("JWT_ACCESS_TOKEN_SECRET", testTokenSecret)
+	calls := 0
+	app := newIdempotencyApp(func(c *fiber.Ctx) error {
+		calls++
+		return c.Status(fiber.StatusCreated).JSON(fiber.Map{"call": calls})
+	})
+
+	first, firstBody := idempotentRequest(t, app, 1, "key-1", `{"text":"card"}`)
+	second, secondBody := idempotentRequest(t, app, 1, "key-1", `{"text":"card"}`)
+
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, fiber.StatusCreated, first.StatusCode)
+	assert.Equal(t, fiber.StatusCreated, second.StatusCode)
+	assert.Equal(t, firstBody, secondBody)
+	assert.Equal(t, "true", second.Header.Get(IdempotentReplayedHeader))
+	assert.Equal(t, fiber.MIMEApplicationJSON, second.Header.Get(fiber.HeaderContentType))
+
+	idempotentRequest(t, app, 2, "key-1", `{"text":"card"}`)
+	idempotentRequest(t, app, 1, "", `{"text":"card"}`)
+	assert.Equal(t, 3, calls)
+}
+
+func Test_IdempotencyRejectsDifferentBody(t *testing.T) {
+	t.Setenv("JWT_ACCESS_TOKEN_SECRET", testTokenSecret)
+	app := newIdempotencyApp(func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusCreated)
+	})
+
+	idempotentRequest(t, app, 1, "key-1", `{"text":"card"}`)
+	resp, _ := idempotentRequest(t, app, 1, "key-1", `{"text":"other"}`)
+
+	assert.Equal(t, fiber.StatusUnprocessableEntity, resp.StatusCode)
+}
+
+func Test_IdempotencyRejectsDifferentQuery(t *testing.T) {
+	t.Setenv("JWT_ACCESS_TOKEN_SECRET", testTokenSecret)
+	app := newIdempotencyApp(func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusCreated)
+	})
+
+	idempotentRequestTo(t, app, "/cards?a=1&b=2", 1, "key-1", `{"text":"card"}`)
+	replay, _ := idempotentRequestTo(t, app, "/cards?b=2&a=1", 1, "key-1", `{"text":"card"}`)
+	other, _ := idempotentRequestTo(t, app, "/cards?a=1&b=3", 1, "key-1", `{"text":"card"}`)
+
+	assert.Equal(t, "true", replay.Header.Get(IdempotentReplayedHeader))
+	assert.Equal(t, fiber.StatusUnprocessableEntity, other.StatusCode)
+}
+
+func Test_IdempotencyDoesNotStoreServerErrors(t *testing.T) {
+	t.Setenv("JWT_ACCESS_TOKEN_SECRET", testTokenSecret)
+	calls := 0
+	app := newIdempotencyApp(func(c *fiber.Ctx) error {
+		calls++
+		if calls == 1 {
+			return c.SendStatus(fiber.StatusInternalServerError)
+		}
+		return c.SendStatus(fiber.StatusCreated)
+	})
+
+	first, _ := idempotentRequest(t, app, 1, "key-1", `{}`)
+	second, _ := idempotentRequest(t, app, 1, "key-1", `{}`)
+
+	assert.Equal(t, fiber.StatusInternalServerError, first.StatusCode)
+	assert.Equal(t, fiber.StatusCreated, second.StatusCode)
+	assert.Equal(t, 2, calls)
+}
+
+func Test_IdempotencyConcurrentRequests(t *testing.T) {
+	t.Setenv("JWT_ACCESS_TOKEN_SECRET", testTokenSecret)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	app := newIdempotencyApp(func(c *fiber.Ctx) error {
+		close(started)
+		<-release
+		return c.SendStatus(fiber.StatusCreated)
+	})
+
+	done := make(chan int)
+	go func() {
+		resp, _ := idempotentRequest(t, app, 1, "key-1", `{}`)
+		done <- resp.StatusCode
+	}()
+	<-started
+
+	inFlight, _ := idempotentRequest(t, app, 1, "key-1", `{}`)
+	assert.Equal(t, fiber.StatusConflict, inFlight.StatusCode)
+	assert.NotEmpty(t, inFlight.Header.Get(fiber.HeaderRetryAfter))
+
+	close(release)
+	assert.Equal(t, fiber.StatusCreated, <-done)
+
+	replayed, _ := idempotentRequest(t, app, 1, "key-1", `{}`)
+	assert.Equal(t, fiber.StatusCreated, replayed.StatusCode)
+	assert.Equal(t, "true", replayed.Header.Get(IdempotentReplayedHeader))
+}
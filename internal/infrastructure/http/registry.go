@@ -1,6 +1,10 @@
 package http
 
-import "backend/internal/infrastructure/http/routes"
+import (
+	"backend/internal/infrastructure/http/routes"
+
+	"github.com/gofiber/fiber/v2"
+)
 
 type Handlers struct {
 	routes.AuthHandler
@@ -8,4 +12,18 @@ type Handlers struct {
 	routes.UserHandler
 	routes.CardHandler
 	routes.CommentHandler
+	routes.SprintHandler
+	routes.SearchHandler
+	routes.ViewHandler
+	routes.TemplateHandler
+	routes.CalendarHandler
+	routes.RelationHandler
+	routes.IntegrityHandler
+	routes.AutomationHandler
+	routes.TrashHandler
+
+	// Idempotency — middleware ключей идемпотентности для всех маршрутов /api/v1
+	Idempotency fiber.Handler
+	// AdminRequired — middleware доступа к маршрутам /admin
+	AdminRequired fiber.Handler
 }
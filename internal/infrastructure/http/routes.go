@@ -18,12 +18,24 @@ func RegisterRoutes(r fiber.Router, handlers Handlers) {
 	api := r.Group("/api")
 	v1 := api.Group("/v1")
 	v1.Get("/health", healthCheck)
+	if handlers.Idempotency != nil {
+		v1.Use(handlers.Idempotency)
+	}
 
 	routes.AuthRoutes(v1, handlers.AuthHandler)
 	routes.UserRoutes(v1, handlers.UserHandler)
 	routes.BoardRoutes(v1, handlers.BoardHandler)
 	routes.CardRoutes(v1, handlers.CardHandler)
 	routes.CommentRoutes(v1, handlers.CommentHandler)
+	routes.SprintRoutes(v1, handlers.SprintHandler)
+	routes.SearchRoutes(v1, handlers.SearchHandler)
+	routes.ViewRoutes(v1, handlers.ViewHandler)
+	routes.TemplateRoutes(v1, handlers.TemplateHandler)
+	routes.CalendarRoutes(v1, handlers.CalendarHandler)
+	routes.RelationRoutes(v1, handlers.RelationHandler)
+	routes.AdminRoutes(v1, handlers.IntegrityHandler, handlers.AdminRequired)
+	routes.AutomationRoutes(v1, handlers.AutomationHandler)
+	routes.TrashRoutes(v1, handlers.TrashHandler)
 }
 
 func healthCheck(c *fiber.Ctx) error {
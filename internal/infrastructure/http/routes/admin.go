@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"backend/internal/infrastructure/http/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type IntegrityHandler interface {
+	CheckPositions(*fiber.Ctx) error
+	RepairPositions(*fiber.Ctx) error
+}
+
+func AdminRoutes(router fiber.Router, h IntegrityHandler, adminRequired fiber.Handler) fiber.Router {
+	admin := router.Group("/admin").
+		Use(middleware.AuthRequired, adminRequired)
+
+	admin.Get("/positions", h.CheckPositions)
+	admin.Post("/positions/repair", h.RepairPositions)
+
+	return admin
+}
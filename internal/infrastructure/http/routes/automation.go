@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"backend/internal/infrastructure/http/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AutomationHandler interface {
+	GetRuleList(*fiber.Ctx) error
+	GetRule(*fiber.Ctx) error
+	CreateRule(*fiber.Ctx) error
+	UpdateRule(*fiber.Ctx) error
+	DeleteRule(*fiber.Ctx) error
+	GetExecutionList(*fiber.Ctx) error
+	DryRun(*fiber.Ctx) error
+}
+
+func AutomationRoutes(router fiber.Router, h AutomationHandler) fiber.Router {
+	automations := router.Group("/boards/:id/automations").
+		Use(middleware.AuthRequired)
+
+	automations.Get("/", h.GetRuleList)
+	automations.Post("/", h.CreateRule)
+	automations.Get("/:rule_id", h.GetRule)
+	automations.Put("/:rule_id", h.UpdateRule)
+	automations.Delete("/:rule_id", h.DeleteRule)
+	automations.Get("/:rule_id/executions", h.GetExecutionList)
+	automations.Post("/:rule_id/dry-run", h.DryRun)
+
+	return automations
+}
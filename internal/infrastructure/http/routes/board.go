@@ -10,12 +10,20 @@ type BoardHandler interface {
 	GetList(*fiber.Ctx) error
 	GetByUUID(*fiber.Ctx) error
 	Store(*fiber.Ctx) error
+	Duplicate(*fiber.Ctx) error
 	Update(*fiber.Ctx) error
+	Patch(*fiber.Ctx) error
 	Delete(*fiber.Ctx) error
+	Archive(*fiber.Ctx) error
+	Restore(*fiber.Ctx) error
 	CreateColumn(*fiber.Ctx) error
 	UpdateColumn(*fiber.Ctx) error
+	PatchColumn(*fiber.Ctx) error
 	DeleteColumn(*fiber.Ctx) error
 	MoveColumn(*fiber.Ctx) error
+	ReorderColumns(*fiber.Ctx) error
+	ArchiveColumn(*fiber.Ctx) error
+	RestoreColumn(*fiber.Ctx) error
 }
 
 func BoardRoutes(router fiber.Router, handler BoardHandler) fiber.Router {
@@ -23,18 +31,26 @@ func BoardRoutes(router fiber.Router, handler BoardHandler) fiber.Router {
 
 	boards.Get("/:id", handler.GetByUUID) // получить доску по UUID
 
-	boards.Post("/list", handler.GetList) // получить список досок
-	boards.Post("/", handler.Store)       // создать новую доску
-	boards.Put("/:id", handler.Update)    // обновить доску
-	boards.Delete("/:id", handler.Delete) // удалить доску
+	boards.Post("/list", handler.GetList)            // получить список досок
+	boards.Post("/", handler.Store)                  // создать новую доску
+	boards.Post("/:id/duplicate", handler.Duplicate) // копия доски с колонками и, по желанию, карточками
+	boards.Put("/:id", handler.Update)               // обновить доску
+	boards.Patch("/:id", handler.Patch)              // частично обновить доску (JSON Merge Patch)
+	boards.Delete("/:id", handler.Delete)            // удалить доску; ?cascade=true — вместе с содержимым
+	boards.Post("/:id/archive", handler.Archive)     // убрать доску в архив
+	boards.Post("/:id/restore", handler.Restore)     // вернуть доску из архива
 
 	// Работа с колонками
 	columns := boards.Group("/:id/columns")
 
 	columns.Post("/", handler.CreateColumn)
+	columns.Put("/order", handler.ReorderColumns) // до /:column_id, иначе "order" примут за id колонки
 	columns.Put("/:column_id", handler.UpdateColumn)
+	columns.Patch("/:column_id", handler.PatchColumn)
 	columns.Put("/:column_id/move", handler.MoveColumn)
-	columns.Delete("/:column_id", handler.DeleteColumn)
+	columns.Delete("/:column_id", handler.DeleteColumn) // ?cascade=true — вместе с карточками
+	columns.Post("/:column_id/archive", handler.ArchiveColumn)
+	columns.Post("/:column_id/restore", handler.RestoreColumn)
 
 	return boards
 }
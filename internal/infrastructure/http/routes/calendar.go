@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"backend/internal/infrastructure/http/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CalendarHandler interface {
+	Get(*fiber.Ctx) error
+	RotateFeedToken(*fiber.Ctx) error
+	RevokeFeedToken(*fiber.Ctx) error
+	Feed(*fiber.Ctx) error
+}
+
+func CalendarRoutes(router fiber.Router, h CalendarHandler) fiber.Router {
+	calendar := router.Group("/calendar").
+		Use(middleware.AuthRequired)
+
+	calendar.Get("/", h.Get)
+	calendar.Post("/feed-token", h.RotateFeedToken)
+	calendar.Delete("/feed-token", h.RevokeFeedToken)
+
+	// лента для внешних календарей авторизуется токеном в пути
+	router.Get("/feeds/calendar/:token", h.Feed)
+
+	return calendar
+}
@@ -8,9 +8,30 @@ import (
 
 type CardHandler interface {
 	Create(*fiber.Ctx) error
+	Get(*fiber.Ctx) error
 	Delete(*fiber.Ctx) error
+	Archive(*fiber.Ctx) error
+	Restore(*fiber.Ctx) error
 	Update(*fiber.Ctx) error
+	Patch(*fiber.Ctx) error
 	MoveToNewPosition(*fiber.Ctx) error
+	Bulk(*fiber.Ctx) error
+	Transfer(*fiber.Ctx) error
+	Search(*fiber.Ctx) error
+	GetMyCards(*fiber.Ctx) error
+	GetByKey(*fiber.Ctx) error
+	GetSubtree(*fiber.Ctx) error
+	AttachChild(*fiber.Ctx) error
+	DetachChild(*fiber.Ctx) error
+	GetCardTemplateList(*fiber.Ctx) error
+	GetCardTemplate(*fiber.Ctx) error
+	CreateCardTemplate(*fiber.Ctx) error
+	UpdateCardTemplate(*fiber.Ctx) error
+	DeleteCardTemplate(*fiber.Ctx) error
+	GetRecurrenceList(*fiber.Ctx) error
+	CreateRecurrence(*fiber.Ctx) error
+	UpdateRecurrence(*fiber.Ctx) error
+	DeleteRecurrence(*fiber.Ctx) error
 }
 
 func CardRoutes(router fiber.Router, h CardHandler) fiber.Router {
@@ -18,11 +39,44 @@ func CardRoutes(router fiber.Router, h CardHandler) fiber.Router {
 		Use(middleware.AuthRequired)
 
 	cards.Post("/", h.Create)
+	cards.Post("/bulk", h.Bulk)
 
 	cardIDGroup := cards.Group("/:card_id")
+	cardIDGroup.Get("/", h.Get)
 	cardIDGroup.Delete("/", h.Delete)
 	cardIDGroup.Put("/", h.Update)
+	cardIDGroup.Patch("/", h.Patch)
 	cardIDGroup.Put("/move", h.MoveToNewPosition)
+	cardIDGroup.Post("/archive", h.Archive)
+	cardIDGroup.Post("/restore", h.Restore)   // на прежнее место или в конец колонки
+	cardIDGroup.Post("/transfer", h.Transfer) // перенести или скопировать на другую доску
+	cardIDGroup.Get("/subtree", h.GetSubtree)
+	cardIDGroup.Post("/children", h.AttachChild)
+	cardIDGroup.Delete("/children/:child_id", h.DetachChild)
+
+	templates := router.Group("/boards/:id/card-templates").
+		Use(middleware.AuthRequired)
+	templates.Get("/", h.GetCardTemplateList)
+	templates.Post("/", h.CreateCardTemplate)
+	templates.Get("/:template_id", h.GetCardTemplate)
+	templates.Put("/:template_id", h.UpdateCardTemplate)
+	templates.Delete("/:template_id", h.DeleteCardTemplate)
+
+	recurrences := router.Group("/boards/:id/recurrences").
+		Use(middleware.AuthRequired)
+	recurrences.Get("/", h.GetRecurrenceList)
+	recurrences.Post("/", h.CreateRecurrence)
+	recurrences.Put("/:recurrence_id", h.UpdateRecurrence)
+	recurrences.Delete("/:recurrence_id", h.DeleteRecurrence)
+
+	search := router.Group("/cards").
+		Use(middleware.AuthRequired)
+	search.Get("/search", h.Search)
+	search.Get("/by-key/:key", h.GetByKey)
+
+	me := router.Group("/me").
+		Use(middleware.AuthRequired)
+	me.Get("/cards", h.GetMyCards)
 
 	return cards
 }
@@ -9,6 +9,7 @@ import (
 type CommentHandler interface {
 	Create(*fiber.Ctx) error
 	Update(*fiber.Ctx) error
+	Patch(*fiber.Ctx) error
 	Delete(*fiber.Ctx) error
 }
 
@@ -18,6 +19,7 @@ func CommentRoutes(router fiber.Router, h CommentHandler) fiber.Router {
 
 	comments.Post("/", h.Create)
 	comments.Put("/:comment_id", h.Update)
+	comments.Patch("/:comment_id", h.Patch)
 	comments.Delete("/:comment_id", h.Delete)
 
 	return comments
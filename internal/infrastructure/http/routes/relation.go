@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"backend/internal/infrastructure/http/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type RelationHandler interface {
+	GetList(*fiber.Ctx) error
+	Create(*fiber.Ctx) error
+	Delete(*fiber.Ctx) error
+}
+
+func RelationRoutes(router fiber.Router, h RelationHandler) fiber.Router {
+	relations := router.Group("/boards/:id/cards/:card_id/relations").
+		Use(middleware.AuthRequired)
+
+	relations.Get("/", h.GetList)
+	relations.Post("/", h.Create)
+	relations.Delete("/:relation_id", h.Delete)
+
+	return relations
+}
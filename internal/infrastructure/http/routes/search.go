@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"backend/internal/infrastructure/http/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type SearchHandler interface {
+	Search(*fiber.Ctx) error
+}
+
+func SearchRoutes(router fiber.Router, h SearchHandler) fiber.Router {
+	search := router.Group("/search").Use(middleware.AuthRequired)
+
+	search.Get("/", h.Search)
+
+	return search
+}
@@ -0,0 +1,40 @@
+package routes
+
+import (
+	"backend/internal/infrastructure/http/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type SprintHandler interface {
+	GetList(*fiber.Ctx) error
+	Get(*fiber.Ctx) error
+	Create(*fiber.Ctx) error
+	Update(*fiber.Ctx) error
+	Delete(*fiber.Ctx) error
+	Start(*fiber.Ctx) error
+	Close(*fiber.Ctx) error
+	AddCards(*fiber.Ctx) error
+	RemoveCard(*fiber.Ctx) error
+	Burndown(*fiber.Ctx) error
+}
+
+func SprintRoutes(router fiber.Router, h SprintHandler) fiber.Router {
+	sprints := router.Group("/boards/:id/sprints").
+		Use(middleware.AuthRequired)
+
+	sprints.Get("/", h.GetList)
+	sprints.Post("/", h.Create)
+
+	sprintIDGroup := sprints.Group("/:sprint_id")
+	sprintIDGroup.Get("/", h.Get)
+	sprintIDGroup.Put("/", h.Update)
+	sprintIDGroup.Delete("/", h.Delete)
+	sprintIDGroup.Post("/start", h.Start)
+	sprintIDGroup.Post("/close", h.Close)
+	sprintIDGroup.Get("/burndown", h.Burndown)
+	sprintIDGroup.Post("/cards", h.AddCards)
+	sprintIDGroup.Delete("/cards/:card_id", h.RemoveCard)
+
+	return sprints
+}
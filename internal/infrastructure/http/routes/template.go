@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"backend/internal/infrastructure/http/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type TemplateHandler interface {
+	GetTemplateList(*fiber.Ctx) error
+	GetTemplate(*fiber.Ctx) error
+	PublishTemplate(*fiber.Ctx) error
+	InstantiateTemplate(*fiber.Ctx) error
+	DeleteTemplate(*fiber.Ctx) error
+}
+
+func TemplateRoutes(router fiber.Router, h TemplateHandler) fiber.Router {
+	templates := router.Group("/templates").
+		Use(middleware.AuthRequired)
+
+	templates.Get("/", h.GetTemplateList)  // встроенные и личные шаблоны досок
+	templates.Post("/", h.PublishTemplate) // сохранить свою доску как шаблон
+
+	templateIDGroup := templates.Group("/:template_id")
+	templateIDGroup.Get("/", h.GetTemplate)
+	templateIDGroup.Delete("/", h.DeleteTemplate)
+	templateIDGroup.Post("/boards", h.InstantiateTemplate) // создать доску из шаблона
+
+	return templates
+}
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"backend/internal/infrastructure/http/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type TrashHandler interface {
+	GetTrashList(*fiber.Ctx) error
+	RestoreTrashItem(*fiber.Ctx) error
+}
+
+func TrashRoutes(router fiber.Router, h TrashHandler) fiber.Router {
+	trash := router.Group("/trash").
+		Use(middleware.AuthRequired)
+
+	trash.Get("/", h.GetTrashList)
+	trash.Post("/:type/:id/restore", h.RestoreTrashItem) // type: board, column или card
+
+	return trash
+}
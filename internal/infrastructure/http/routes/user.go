@@ -9,6 +9,7 @@ import (
 type UserHandler interface {
 	Current(*fiber.Ctx) error
 	Update(*fiber.Ctx) error
+	Patch(*fiber.Ctx) error
 }
 
 func UserRoutes(router fiber.Router, h UserHandler) fiber.Router {
@@ -16,6 +17,7 @@ func UserRoutes(router fiber.Router, h UserHandler) fiber.Router {
 
 	users.Get("/current", h.Current)
 	users.Put("/current", h.Update)
+	users.Patch("/current", h.Patch)
 
 	return users
 }
@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"backend/internal/infrastructure/http/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ViewHandler interface {
+	GetList(*fiber.Ctx) error
+	Get(*fiber.Ctx) error
+	Create(*fiber.Ctx) error
+	Update(*fiber.Ctx) error
+	Delete(*fiber.Ctx) error
+}
+
+func ViewRoutes(router fiber.Router, h ViewHandler) fiber.Router {
+	views := router.Group("/views").
+		Use(middleware.AuthRequired)
+
+	views.Get("/", h.GetList)
+	views.Post("/", h.Create)
+
+	viewIDGroup := views.Group("/:view_id")
+	viewIDGroup.Get("/", h.Get)
+	viewIDGroup.Put("/", h.Update)
+	viewIDGroup.Delete("/", h.Delete)
+
+	return views
+}
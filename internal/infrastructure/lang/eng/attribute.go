@@ -22,7 +22,50 @@ var attribute = map[string]string{
 	"card_id":               "Card",
 	"description":           "Description",
 	"column_id":             "Column",
+	"column_ids":            "Columns",
 	"position":              "Position",
+	"category":              "Category",
+	"estimate":              "Estimate",
+	"goal":                  "Goal",
+	"start_date":            "Start date",
+	"end_date":              "End date",
+	"card_ids":              "Cards",
+	"carry_over_sprint_id":  "Carry-over sprint",
+	"query":                 "Search query",
+	"limit":                 "Limit",
+	"sort_by":               "Sort field",
+	"sort_order":            "Sort order",
+	"shared":                "Shared",
+	"collapsed_columns":     "Collapsed columns",
+	"collapsed_swimlanes":   "Collapsed swimlanes",
+	"group_by":              "Grouping",
+	"cursor":                "Cursor",
+	"from":                  "From",
+	"to":                    "To",
+	"tz":                    "Time zone",
+	"due_date":              "Due date",
+	"type":                  "Type",
+	"key":                   "Key",
+	"operations":            "Operations",
+	"op":                    "Operation",
+	"properties":            "Properties",
+	"mode":                  "Mode",
+	"depth":                 "Depth",
+	"with_comments":         "With comments",
+	"with_cards":            "With cards",
+	"rule":                  "Rule",
+	"starts_at":             "Start",
+	"target_board_id":       "Target board",
+	"target_column_id":      "Target column",
+	"trigger":               "Trigger",
+	"conditions":            "Conditions",
+	"actions":               "Actions",
+	"property":              "Property",
+	"text_pattern":          "Text pattern",
+	"value":                 "Value",
+	"url":                   "URL",
+	"enabled":               "Enabled",
+	"rule_id":               "Rule",
 }
 
 func (p *Package) GetAttribute(field string) string {
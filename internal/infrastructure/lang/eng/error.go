@@ -0,0 +1,14 @@
+package eng
+
+var errorMessages map[string]string = map[string]string{
+	"filter.unterminated_quote": "Unterminated quoted value starting at position {position}",
+	"filter.expected_value":     "Expected a value at position {position}",
+	"filter.too_many_terms":     "Too many conditions at position {position}, the maximum is {max}",
+	"filter.unknown_field":      "Unknown field \"{field}\" at position {position}",
+	"filter.invalid_operator":   "Operator \"{operator}\" is not supported for field \"{field}\" at position {position}",
+	"filter.invalid_value":      "Invalid value \"{value}\" for field \"{field}\" at position {position}",
+}
+
+func (p *Package) GetErrorMessage(key string) string {
+	return errorMessages[key]
+}
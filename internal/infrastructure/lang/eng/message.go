@@ -6,6 +6,9 @@ var responseMessages map[string]string = map[string]string{
 	"deleted":  "Deleted successfully",
 	"moved":    "Moved successfully",
 	"archived": "Archived successfully",
+	"restored": "Restored successfully",
+	"started":  "Started successfully",
+	"closed":   "Closed successfully",
 }
 
 func (p *Package) GetResponseMessage(key string) string {
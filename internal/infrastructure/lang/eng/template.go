@@ -0,0 +1,31 @@
+package eng
+
+var templateTexts map[string]string = map[string]string{
+	"template.scrum.name":                         "Scrum",
+	"template.scrum.description":                  "Sprint work from backlog to done with a review step",
+	"template.scrum.backlog":                      "Backlog",
+	"template.scrum.todo":                         "Sprint to do",
+	"template.scrum.in_progress":                  "In progress",
+	"template.scrum.review":                       "Review",
+	"template.scrum.done":                         "Done",
+	"template.kanban.name":                        "Kanban",
+	"template.kanban.description":                 "A simple continuous flow board",
+	"template.kanban.todo":                        "To do",
+	"template.kanban.in_progress":                 "Doing",
+	"template.kanban.done":                        "Done",
+	"template.kanban.sample_card":                 "Try moving this card",
+	"template.kanban.sample_card_description":     "Drag cards between columns as the work progresses",
+	"template.bug_triage.name":                    "Bug triage",
+	"template.bug_triage.description":             "Sort incoming bug reports and track fixes",
+	"template.bug_triage.new":                     "New",
+	"template.bug_triage.confirmed":               "Confirmed",
+	"template.bug_triage.in_progress":             "Fixing",
+	"template.bug_triage.fixed":                   "Fixed",
+	"template.bug_triage.wont_fix":                "Won't fix",
+	"template.bug_triage.sample_card":             "Example bug report",
+	"template.bug_triage.sample_card_description": "Steps to reproduce, expected and actual behaviour",
+}
+
+func (p *Package) GetTemplateText(key string) string {
+	return templateTexts[key]
+}
@@ -1,14 +1,21 @@
 package eng
 
 var messages = map[string]string{
-	"required": "The {field} field is required.",
-	"email":    "The {field} must be a valid email address.",
-	"min":      "The {field} must be at least {param} characters long.",
-	"max":      "The {field} must be at most {param} characters long.",
-	"gte":      "The {field} must be greater than or equal to {param}.",
-	"lte":      "The {field} must be less than or equal to {param}.",
-	"eqfield":  "The field {field} must be equal to the field {param}.",
-	"hexcolor": "The {field} must be a valid hexadecimal color code.",
+	"required":    "The {field} field is required.",
+	"required_if": "The {field} field is required when {param}.",
+	"email":       "The {field} must be a valid email address.",
+	"min":         "The {field} must be at least {param} characters long.",
+	"max":         "The {field} must be at most {param} characters long.",
+	"gte":         "The {field} must be greater than or equal to {param}.",
+	"lte":         "The {field} must be less than or equal to {param}.",
+	"eqfield":     "The field {field} must be equal to the field {param}.",
+	"hexcolor":    "The {field} must be a valid hexadecimal color code.",
+	"datetime":    "The {field} must match the format {param}.",
+	"oneof":       "The {field} must be one of: {param}.",
+	"timezone":    "The {field} must be a valid IANA time zone.",
+	"alphanum":    "The {field} may only contain letters and digits.",
+	"uppercase":   "The {field} must be in upper case.",
+	"url":         "The {field} must be a valid URL.",
 }
 
 func (p *Package) GetMessages() map[string]string {
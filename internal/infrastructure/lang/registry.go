@@ -16,6 +16,8 @@ type Language interface {
 	GetAttribute(string) string
 	GetMessages() map[string]string
 	GetResponseMessage(key string) string
+	GetErrorMessage(key string) string
+	GetTemplateText(key string) string
 }
 
 type Registry struct {
@@ -29,14 +31,22 @@ func NewRegistry() *Registry {
 			"en": &eng.Package{},
 			"ru": &ru.Package{},
 		},
+		defaultLang: "en",
 	}
 }
 
-// GetLanguage возвращает языковый пакет по коду
+// GetLanguage возвращает языковый пакет по коду.
+// Код может прийти прямо из Accept-Language ("ru-RU,ru;q=0.9"), поэтому берётся первый основной тег.
 func (r *Registry) GetLanguage(langCode string) Language {
 	if lang, exists := r.languages[langCode]; exists {
 		return lang
 	}
+	primary, _, _ := strings.Cut(langCode, ",")
+	primary, _, _ = strings.Cut(primary, ";")
+	primary, _, _ = strings.Cut(primary, "-")
+	if lang, exists := r.languages[strings.ToLower(strings.TrimSpace(primary))]; exists {
+		return lang
+	}
 	return r.languages[r.defaultLang]
 }
 
@@ -50,6 +60,31 @@ func (r *Registry) GetResponseMessage(ctx context.Context, key string) string {
 	return message
 }
 
+// GetErrorMessage возвращает текст ошибки с подставленными {param}
+func (r *Registry) GetErrorMessage(ctx context.Context, key string, params map[string]string) string {
+	locale, ok := ctx.Value("locale").(string)
+	if !ok {
+		locale = "en"
+	}
+	message := r.GetLanguage(locale).GetErrorMessage(key)
+	for param, value := range params {
+		message = strings.ReplaceAll(message, "{"+param+"}", value)
+	}
+	return message
+}
+
+// GetTemplateText переводит ключ встроенного шаблона доски; неизвестный ключ возвращается как есть
+func (r *Registry) GetTemplateText(ctx context.Context, key string) string {
+	locale, ok := ctx.Value("locale").(string)
+	if !ok {
+		locale = "en"
+	}
+	if text := r.GetLanguage(locale).GetTemplateText(key); text != "" {
+		return text
+	}
+	return key
+}
+
 func (r *Registry) Validate(ctx context.Context, err error) (map[string]string, error) {
 	if err != nil {
 		errs := err.(validator.ValidationErrors)
@@ -3,23 +3,66 @@ package ru
 type Package struct{}
 
 var attribute = map[string]string{
-	"user_id":     "Пользователь",
-	"category_id": "Категория",
-	"platform_id": "Платформа",
-	"passowrd":    "Пароль",
-	"mail":        "Почта",
-	"name":        "Название",
-	"firstname":   "Имя",
-	"lastname":    "Фамилия",
-	"patronymic":  "Отчество",
-	"text":        "Текст",
-	"color":       "Цвет",
-	"tag":         "Тег",
-	"board_id":    "Доска",
-	"card_id":     "Карточка",
-	"description": "Описание",
-	"column_id":   "Столбец",
-	"position":    "Позиция",
+	"user_id":              "Пользователь",
+	"category_id":          "Категория",
+	"platform_id":          "Платформа",
+	"passowrd":             "Пароль",
+	"mail":                 "Почта",
+	"name":                 "Название",
+	"firstname":            "Имя",
+	"lastname":             "Фамилия",
+	"patronymic":           "Отчество",
+	"text":                 "Текст",
+	"color":                "Цвет",
+	"tag":                  "Тег",
+	"board_id":             "Доска",
+	"card_id":              "Карточка",
+	"description":          "Описание",
+	"column_id":            "Столбец",
+	"column_ids":           "Столбцы",
+	"position":             "Позиция",
+	"category":             "Категория",
+	"estimate":             "Оценка",
+	"goal":                 "Цель",
+	"start_date":           "Дата начала",
+	"end_date":             "Дата окончания",
+	"card_ids":             "Карточки",
+	"carry_over_sprint_id": "Спринт для переноса",
+	"query":                "Поисковый запрос",
+	"limit":                "Лимит",
+	"sort_by":              "Поле сортировки",
+	"sort_order":           "Порядок сортировки",
+	"shared":               "Общее",
+	"collapsed_columns":    "Свёрнутые столбцы",
+	"collapsed_swimlanes":  "Свёрнутые дорожки",
+	"group_by":             "Группировка",
+	"cursor":               "Курсор",
+	"from":                 "С",
+	"to":                   "По",
+	"tz":                   "Часовой пояс",
+	"due_date":             "Срок",
+	"type":                 "Тип",
+	"key":                  "Ключ",
+	"operations":           "Операции",
+	"op":                   "Операция",
+	"properties":           "Свойства",
+	"mode":                 "Режим",
+	"depth":                "Глубина копирования",
+	"with_comments":        "Копировать комментарии",
+	"with_cards":           "Копировать карточки",
+	"rule":                 "Правило повторения",
+	"starts_at":            "Начало",
+	"target_board_id":      "Доска назначения",
+	"target_column_id":     "Столбец назначения",
+	"trigger":              "Триггер",
+	"conditions":           "Условия",
+	"actions":              "Действия",
+	"property":             "Свойство",
+	"text_pattern":         "Шаблон текста",
+	"value":                "Значение",
+	"url":                  "URL",
+	"enabled":              "Включено",
+	"rule_id":              "Правило",
 }
 
 func (p *Package) GetAttribute(field string) string {
@@ -0,0 +1,14 @@
+package ru
+
+var errorMessages map[string]string = map[string]string{
+	"filter.unterminated_quote": "Незакрытая кавычка в позиции {position}",
+	"filter.expected_value":     "Ожидается значение в позиции {position}",
+	"filter.too_many_terms":     "Слишком много условий в позиции {position}, максимум {max}",
+	"filter.unknown_field":      "Неизвестное поле \"{field}\" в позиции {position}",
+	"filter.invalid_operator":   "Оператор \"{operator}\" не поддерживается для поля \"{field}\" в позиции {position}",
+	"filter.invalid_value":      "Недопустимое значение \"{value}\" для поля \"{field}\" в позиции {position}",
+}
+
+func (p *Package) GetErrorMessage(key string) string {
+	return errorMessages[key]
+}
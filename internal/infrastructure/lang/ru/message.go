@@ -6,6 +6,9 @@ var responseMessages map[string]string = map[string]string{
 	"deleted":  "Успешно удалено",
 	"moved":    "Успешно перемещено",
 	"archived": "Успешно архивировано",
+	"restored": "Успешно восстановлено",
+	"started":  "Успешно запущено",
+	"closed":   "Успешно закрыто",
 }
 
 func (p *Package) GetResponseMessage(key string) string {
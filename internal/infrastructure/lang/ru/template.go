@@ -0,0 +1,31 @@
+package ru
+
+var templateTexts map[string]string = map[string]string{
+	"template.scrum.name":                         "Scrum",
+	"template.scrum.description":                  "Работа спринта от бэклога до готовности с этапом ревью",
+	"template.scrum.backlog":                      "Бэклог",
+	"template.scrum.todo":                         "К выполнению в спринте",
+	"template.scrum.in_progress":                  "В работе",
+	"template.scrum.review":                       "Ревью",
+	"template.scrum.done":                         "Готово",
+	"template.kanban.name":                        "Канбан",
+	"template.kanban.description":                 "Простая доска непрерывного потока",
+	"template.kanban.todo":                        "К выполнению",
+	"template.kanban.in_progress":                 "В работе",
+	"template.kanban.done":                        "Готово",
+	"template.kanban.sample_card":                 "Попробуйте переместить эту карточку",
+	"template.kanban.sample_card_description":     "Перетаскивайте карточки между колонками по мере работы",
+	"template.bug_triage.name":                    "Разбор багов",
+	"template.bug_triage.description":             "Сортировка входящих отчётов об ошибках и отслеживание исправлений",
+	"template.bug_triage.new":                     "Новые",
+	"template.bug_triage.confirmed":               "Подтверждённые",
+	"template.bug_triage.in_progress":             "Исправляются",
+	"template.bug_triage.fixed":                   "Исправлены",
+	"template.bug_triage.wont_fix":                "Не будут исправлены",
+	"template.bug_triage.sample_card":             "Пример отчёта об ошибке",
+	"template.bug_triage.sample_card_description": "Шаги воспроизведения, ожидаемое и фактическое поведение",
+}
+
+func (p *Package) GetTemplateText(key string) string {
+	return templateTexts[key]
+}
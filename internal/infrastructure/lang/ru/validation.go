@@ -1,14 +1,21 @@
 package ru
 
 var messages = map[string]string{
-	"required": "Поле {field} обязательно для заполнения.",
-	"email":    "Поле {field} должно быть корректным адресом электронной почты.",
-	"min":      "Поле {field} должно содержать не менее {param} символов.",
-	"max":      "Поле {field} должно содержать не более {param} символов.",
-	"gte":      "Поле {field} должно быть больше или равно {param}.",
-	"lte":      "Поле {field} должно быть меньше или равно {param}.",
-	"eqfield":  "Поле {field} должно быть равно полью {param}.",
-	"hexcolor": "Поле {field} должно быть валидным шестнадцатеричным цветовым кодом.",
+	"required":    "Поле {field} обязательно для заполнения.",
+	"required_if": "Поле {field} обязательно, если {param}.",
+	"email":       "Поле {field} должно быть корректным адресом электронной почты.",
+	"min":         "Поле {field} должно содержать не менее {param} символов.",
+	"max":         "Поле {field} должно содержать не более {param} символов.",
+	"gte":         "Поле {field} должно быть больше или равно {param}.",
+	"lte":         "Поле {field} должно быть меньше или равно {param}.",
+	"eqfield":     "Поле {field} должно быть равно полью {param}.",
+	"hexcolor":    "Поле {field} должно быть валидным шестнадцатеричным цветовым кодом.",
+	"datetime":    "Поле {field} должно соответствовать формату {param}.",
+	"oneof":       "Поле {field} должно быть одним из: {param}.",
+	"timezone":    "Поле {field} должно быть часовым поясом IANA.",
+	"alphanum":    "Поле {field} может содержать только буквы и цифры.",
+	"uppercase":   "Поле {field} должно быть в верхнем регистре.",
+	"url":         "Поле {field} должно быть валидным URL.",
 }
 
 func (p *Package) GetMessages() map[string]string {
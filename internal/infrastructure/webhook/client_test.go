@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CheckAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		allowed bool
+	}{
+		{address: "93.184.216.34:443", allowed: true},
+		{address: "[2606:2800:220:1::1]:443", allowed: true},
+		{address: "127.0.0.1:80"},
+		{address: "[::1]:80"},
+		{address: "169.254.169.254:80"},
+		{address: "10.0.0.5:8080"},
+		{address: "172.16.3.4:80"},
+		{address: "192.168.1.1:80"},
+		{address: "100.64.0.1:80"},
+		{address: "0.0.0.0:80"},
+		{address: "[::ffff:127.0.0.1]:80"},
+		{address: "[fd00::1]:80"},
+	}
+	for _, tt := range tests {
+		name := fmt.Sprintf("case(%s)", tt.address)
+		t.Run(name, func(t *testing.T) {
+			err := CheckAddress(tt.address)
+			if tt.allowed {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, ErrForbiddenAddress)
+		})
+	}
+}
+
+func Test_SendRefusesLoopback(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	err := NewClient(time.Second).Send(context.Background(), server.URL, map[string]string{})
+	assert.ErrorIs(t, err, ErrForbiddenAddress)
+	assert.False(t, called)
+}
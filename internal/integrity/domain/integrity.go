@@ -0,0 +1,70 @@
+package domain
+
+// Проверка работает с rank, а не с колонкой position: с миграции 000017 порядок хранится в rank,
+// а position больше не ведётся и по ней ничего не сортируется. Позиция строки — её номер
+// в порядке (rank, id), поэтому пропусков в ней не бывает. Нарушения из исходной постановки
+// соответствуют видам так:
+//   - NULL-позиция живой строки — missing_rank (rank объявлен NOT NULL, пустая строка — единственный «пустой» ключ);
+//   - повтор позиции — duplicate_rank;
+//   - пропуски — не проверяются, их роль «места между соседями» играют invalid_rank и long_rank:
+//     такие ключи не дают вставить строку между соседями;
+//   - карточка на чужой доске — board_mismatch.
+const (
+	IssueMissingRank   = "missing_rank"
+	IssueDuplicateRank = "duplicate_rank"
+	IssueInvalidRank   = "invalid_rank"
+	IssueLongRank      = "long_rank"
+	IssueBoardMismatch = "board_mismatch"
+
+	TableCards        = "cards"
+	TableBoardColumns = "board_columns"
+)
+
+// Issue — нарушение порядка одной живой строки: пустой, совпавший или испорченный ключ
+// делает её позицию неоднозначной.
+type Issue struct {
+	Kind    string
+	Table   string
+	RowID   uint64
+	BoardID string
+	// ColumnID — колонка карточки; для строк board_columns равен 0
+	ColumnID uint64
+	Rank     string
+	// ColumnBoardID — доска колонки, если она не совпадает с доской карточки
+	ColumnBoardID string
+}
+
+type Report struct {
+	BoardID string
+	Issues  []*Issue
+	// Repaired — ключи затронутых колонок и досок пересчитаны; несовпадения досок не исправляются
+	Repaired          bool
+	RenumberedColumns []uint64
+	RenumberedBoards  []string
+}
+
+// RepairScopes возвращает колонки, карточки которых нужно перенумеровать, и доски, колонки которых нужно перенумеровать
+func RepairScopes(issues []*Issue) ([]uint64, []string) {
+	columnIDs := []uint64{}
+	boardIDs := []string{}
+	seenColumns := map[uint64]bool{}
+	seenBoards := map[string]bool{}
+	for _, issue := range issues {
+		if issue.Kind == IssueBoardMismatch {
+			continue
+		}
+		switch issue.Table {
+		case TableCards:
+			if !seenColumns[issue.ColumnID] {
+				seenColumns[issue.ColumnID] = true
+				columnIDs = append(columnIDs, issue.ColumnID)
+			}
+		case TableBoardColumns:
+			if !seenBoards[issue.BoardID] {
+				seenBoards[issue.BoardID] = true
+				boardIDs = append(boardIDs, issue.BoardID)
+			}
+		}
+	}
+	return columnIDs, boardIDs
+}
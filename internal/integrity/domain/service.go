@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"backend/internal/shared/rank"
+	"context"
+	"fmt"
+	"slices"
+)
+
+type IntegrityRepo interface {
+	FindRankIssues(ctx context.Context, boardID string, maxLength int) ([]*Issue, error)
+	FindBoardMismatches(ctx context.Context, boardID string) ([]*Issue, error)
+	Renumber(ctx context.Context, columnIDs []uint64, boardIDs []string) error
+}
+
+type IntegrityService struct {
+	repo IntegrityRepo
+}
+
+func NewIntegrityService(repo IntegrityRepo) *IntegrityService {
+	return &IntegrityService{
+		repo: repo,
+	}
+}
+
+// Check проверяет порядок карточек и колонок доски boardID; пустой boardID означает все доски
+func (s *IntegrityService) Check(ctx context.Context, boardID string) (*Report, error) {
+	const op = "integrity.service.Check"
+	issues, err := s.repo.FindRankIssues(ctx, boardID, rank.MaxLength)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	mismatches, err := s.repo.FindBoardMismatches(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &Report{
+		BoardID:           boardID,
+		Issues:            slices.Concat(issues, mismatches),
+		RenumberedColumns: []uint64{},
+		RenumberedBoards:  []string{},
+	}, nil
+}
+
+// Repair перенумеровывает колонки и доски с нарушениями одной транзакцией.
+// Отчёт описывает найденное до исправления.
+func (s *IntegrityService) Repair(ctx context.Context, boardID string) (*Report, error) {
+	const op = "integrity.service.Repair"
+	report, err := s.Check(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	columnIDs, boardIDs := RepairScopes(report.Issues)
+	if len(columnIDs) > 0 || len(boardIDs) > 0 {
+		if err := s.repo.Renumber(ctx, columnIDs, boardIDs); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	report.Repaired = true
+	report.RenumberedColumns = columnIDs
+	report.RenumberedBoards = boardIDs
+	return report, nil
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"backend/internal/integrity/domain"
+	"backend/internal/shared/utils"
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+)
+
+const (
+	// validRankPattern — ключ из цифр и строчных латинских букв, не заканчивающийся на 0, см. rank.Between
+	validRankPattern = `'^[0-9a-z]*[1-9a-z]$'`
+	// selectRankIssuesQuery ищет пустые, испорченные, слишком длинные ($2) и совпавшие ключи живых строк доски $1;
+	// пустой $1 означает все доски
+	selectRankIssuesQuery = `
+		SELECT kind, tbl, id, board_id, column_id, rank FROM (
+			SELECT 'missing_rank' AS kind, 'cards' AS tbl, c.id, c.board_id::TEXT AS board_id, c.column_id, c.rank
+			FROM cards c
+			WHERE c.deleted_at IS NULL AND c.rank = ''
+			UNION ALL
+			SELECT 'invalid_rank', 'cards', c.id, c.board_id::TEXT, c.column_id, c.rank
+			FROM cards c
+			WHERE c.deleted_at IS NULL AND c.rank <> '' AND c.rank !~ ` + validRankPattern + `
+			UNION ALL
+			SELECT 'long_rank', 'cards', c.id, c.board_id::TEXT, c.column_id, c.rank
+			FROM cards c
+			WHERE c.deleted_at IS NULL AND c.rank ~ ` + validRankPattern + ` AND LENGTH(c.rank) > $2
+			UNION ALL
+			SELECT 'duplicate_rank', 'cards', c.id, c.board_id::TEXT, c.column_id, c.rank
+			FROM cards c
+			WHERE c.deleted_at IS NULL AND EXISTS (
+				SELECT 1 FROM cards d
+				WHERE d.column_id = c.column_id AND d.rank = c.rank AND d.id <> c.id AND d.deleted_at IS NULL
+			)
+			UNION ALL
+			SELECT 'missing_rank', 'board_columns', bc.id, bc.board_id::TEXT, 0, bc.rank
+			FROM board_columns bc
+			WHERE bc.deleted_at IS NULL AND bc.rank = ''
+			UNION ALL
+			SELECT 'invalid_rank', 'board_columns', bc.id, bc.board_id::TEXT, 0, bc.rank
+			FROM board_columns bc
+			WHERE bc.deleted_at IS NULL AND bc.rank <> '' AND bc.rank !~ ` + validRankPattern + `
+			UNION ALL
+			SELECT 'long_rank', 'board_columns', bc.id, bc.board_id::TEXT, 0, bc.rank
+			FROM board_columns bc
+			WHERE bc.deleted_at IS NULL AND bc.rank ~ ` + validRankPattern + ` AND LENGTH(bc.rank) > $2
+			UNION ALL
+			SELECT 'duplicate_rank', 'board_columns', bc.id, bc.board_id::TEXT, 0, bc.rank
+			FROM board_columns bc
+			WHERE bc.deleted_at IS NULL AND EXISTS (
+				SELECT 1 FROM board_columns d
+				WHERE d.board_id = bc.board_id AND d.rank = bc.rank AND d.id <> bc.id AND d.deleted_at IS NULL
+			)
+		) issues
+		WHERE $1 = '' OR board_id = $1
+		ORDER BY tbl, board_id, column_id, rank, id
+	`
+	// selectBoardMismatchesQuery — живые карточки, чья доска не совпадает с доской их колонки
+	selectBoardMismatchesQuery = `
+		SELECT c.id, c.board_id::TEXT, c.column_id, c.rank, bc.board_id::TEXT
+		FROM cards c
+		JOIN board_columns bc ON bc.id = c.column_id
+		WHERE c.deleted_at IS NULL AND c.board_id <> bc.board_id
+			AND ($1 = '' OR c.board_id::TEXT = $1 OR bc.board_id::TEXT = $1)
+		ORDER BY c.board_id, c.column_id, c.id
+	`
+)
+
+type Storage interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+	Begin() (*sql.Tx, error)
+
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+	GetDB() *sql.DB
+	Close() error
+}
+
+type IntegrityRepository struct {
+	storage Storage
+}
+
+func NewIntegrityRepository(storage Storage) *IntegrityRepository {
+	return &IntegrityRepository{
+		storage: storage,
+	}
+}
+
+func (r *IntegrityRepository) FindRankIssues(ctx context.Context, boardID string, maxLength int) ([]*domain.Issue, error) {
+	const op = "integrity.repository.FindRankIssues"
+	rows, err := r.storage.QueryContext(ctx, selectRankIssuesQuery, boardID, maxLength)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	issues := []*domain.Issue{}
+	for rows.Next() {
+		issue := &domain.Issue{}
+		if err := rows.Scan(
+			&issue.Kind,
+			&issue.Table,
+			&issue.RowID,
+			&issue.BoardID,
+			&issue.ColumnID,
+			&issue.Rank,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		issues = append(issues, issue)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return issues, nil
+}
+
+func (r *IntegrityRepository) FindBoardMismatches(ctx context.Context, boardID string) ([]*domain.Issue, error) {
+	const op = "integrity.repository.FindBoardMismatches"
+	rows, err := r.storage.QueryContext(ctx, selectBoardMismatchesQuery, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	issues := []*domain.Issue{}
+	for rows.Next() {
+		issue := &domain.Issue{
+			Kind:  domain.IssueBoardMismatch,
+			Table: domain.TableCards,
+		}
+		if err := rows.Scan(
+			&issue.RowID,
+			&issue.BoardID,
+			&issue.ColumnID,
+			&issue.Rank,
+			&issue.ColumnBoardID,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		issues = append(issues, issue)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return issues, nil
+}
+
+// Renumber раздаёт карточкам колонок columnIDs и колонкам досок boardIDs новые ключи в порядке (rank, id).
+// Блокировки берутся в отсортированном порядке, чтобы не сцепиться с параллельным ремонтом.
+func (r *IntegrityRepository) Renumber(ctx context.Context, columnIDs []uint64, boardIDs []string) error {
+	const op = "integrity.repository.Renumber"
+	columnIDs = slices.Sorted(slices.Values(columnIDs))
+	boardIDs = slices.Sorted(slices.Values(boardIDs))
+	err := utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		for _, boardID := range boardIDs {
+			if err := utils.LockTx(ctx, tx, utils.BoardColumnsLockKey(boardID)); err != nil {
+				return err
+			}
+			if err := utils.SpreadRanks(ctx, tx, "board_columns", "board_id", boardID); err != nil {
+				return err
+			}
+		}
+		for _, columnID := range columnIDs {
+			if err := utils.LockTx(ctx, tx, utils.CardColumnLockKey(columnID)); err != nil {
+				return err
+			}
+			if err := utils.SpreadRanks(ctx, tx, "cards", "column_id", columnID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package transport
+
+import (
+	"backend/internal/integrity/domain"
+	"backend/internal/shared/ports/http"
+	"backend/internal/shared/utils"
+	"context"
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type IntegrityService interface {
+	Check(ctx context.Context, boardID string) (*domain.Report, error)
+	Repair(ctx context.Context, boardID string) (*domain.Report, error)
+}
+
+type IntegrityHandler struct {
+	validator       http.Validator
+	service         IntegrityService
+	integrityMapper *IntegrityMapper
+}
+
+func NewIntegrityHandler(validator http.Validator, service IntegrityService) *IntegrityHandler {
+	return &IntegrityHandler{
+		validator:       validator,
+		service:         service,
+		integrityMapper: &IntegrityMapper{},
+	}
+}
+
+func (h *IntegrityHandler) CheckPositions(c *fiber.Ctx) error {
+	const op = "integrity.transport.handler.CheckPositions"
+	return h.run(c, op, h.service.Check)
+}
+
+func (h *IntegrityHandler) RepairPositions(c *fiber.Ctx) error {
+	const op = "integrity.transport.handler.RepairPositions"
+	return h.run(c, op, h.service.Repair)
+}
+
+func (h *IntegrityHandler) run(
+	c *fiber.Ctx, op string, action func(ctx context.Context, boardID string) (*domain.Report, error),
+) error {
+	query, err := utils.ParseQuery[PositionsRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid query parameters"})
+	}
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, query); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	report, err := action(c.Context(), query.BoardID)
+	if err != nil {
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+
+	return c.JSON(h.integrityMapper.ToReportResponse(report))
+}
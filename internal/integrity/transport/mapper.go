@@ -0,0 +1,77 @@
+package transport
+
+import (
+	"backend/internal/integrity/domain"
+	"fmt"
+	"strings"
+)
+
+type IntegrityMapper struct{}
+
+func (m *IntegrityMapper) ToReportResponse(report *domain.Report) *ReportResponse {
+	if report == nil {
+		return nil
+	}
+
+	response := &ReportResponse{
+		BoardID:           report.BoardID,
+		Issues:            make([]*IssueResponse, 0, len(report.Issues)),
+		Summary:           map[string]uint64{},
+		Repaired:          report.Repaired,
+		RenumberedColumns: report.RenumberedColumns,
+		RenumberedBoards:  report.RenumberedBoards,
+	}
+	for _, issue := range report.Issues {
+		mapped := &IssueResponse{
+			Kind:          issue.Kind,
+			Table:         issue.Table,
+			ID:            issue.RowID,
+			BoardID:       issue.BoardID,
+			Rank:          issue.Rank,
+			ColumnBoardID: issue.ColumnBoardID,
+		}
+		if issue.Table == domain.TableCards {
+			mapped.ColumnID = &issue.ColumnID
+		}
+		response.Issues = append(response.Issues, mapped)
+		response.Summary[issue.Kind]++
+	}
+	return response
+}
+
+// ToReportText печатает отчёт для консоли: строка на нарушение и итог
+func (m *IntegrityMapper) ToReportText(report *domain.Report) string {
+	if report == nil {
+		return ""
+	}
+
+	var text strings.Builder
+	scope := "all boards"
+	if report.BoardID != "" {
+		scope = "board " + report.BoardID
+	}
+	fmt.Fprintf(&text, "positions check: %s\n", scope)
+	for _, issue := range report.Issues {
+		fmt.Fprintf(&text, "%-15s %s #%d board=%s", issue.Kind, issue.Table, issue.RowID, issue.BoardID)
+		if issue.Table == domain.TableCards {
+			fmt.Fprintf(&text, " column=%d", issue.ColumnID)
+		}
+		fmt.Fprintf(&text, " rank=%q", issue.Rank)
+		if issue.ColumnBoardID != "" {
+			fmt.Fprintf(&text, " column_board=%s", issue.ColumnBoardID)
+		}
+		text.WriteString("\n")
+	}
+	if len(report.Issues) == 0 {
+		text.WriteString("no issues found\n")
+	} else {
+		fmt.Fprintf(&text, "%d issue(s) found\n", len(report.Issues))
+	}
+	if report.Repaired {
+		fmt.Fprintf(
+			&text, "repaired: %d card column(s), %d board(s) renumbered\n",
+			len(report.RenumberedColumns), len(report.RenumberedBoards),
+		)
+	}
+	return text.String()
+}
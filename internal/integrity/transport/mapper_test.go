@@ -0,0 +1,121 @@
+package transport
+
+import (
+	"backend/internal/integrity/domain"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testBoardID = "93a49b99-a029-4a18-bbbc-c10d91a8c267"
+
+func testReport() *domain.Report {
+	return &domain.Report{
+		BoardID: testBoardID,
+		Issues: []*domain.Issue{
+			{Kind: domain.IssueDuplicateRank, Table: domain.TableCards, RowID: 1, BoardID: testBoardID, ColumnID: 5, Rank: "i"},
+			{Kind: domain.IssueDuplicateRank, Table: domain.TableCards, RowID: 2, BoardID: testBoardID, ColumnID: 5, Rank: "i"},
+			{Kind: domain.IssueInvalidRank, Table: domain.TableBoardColumns, RowID: 5, BoardID: testBoardID, Rank: "a0"},
+			{Kind: domain.IssueMissingRank, Table: domain.TableCards, RowID: 4, BoardID: testBoardID, ColumnID: 6},
+			{
+				Kind:          domain.IssueBoardMismatch,
+				Table:         domain.TableCards,
+				RowID:         3,
+				BoardID:       testBoardID,
+				ColumnID:      7,
+				Rank:          "k",
+				ColumnBoardID: "0b0f0d8e-43f4-4f4c-9a0e-1b1c5d8a6f11",
+			},
+		},
+		Repaired:          true,
+		RenumberedColumns: []uint64{5, 6},
+		RenumberedBoards:  []string{testBoardID},
+	}
+}
+
+func Test_ToReportResponse(t *testing.T) {
+	mapper := IntegrityMapper{}
+	columnID, emptyColumnID, otherColumnID := uint64(5), uint64(6), uint64(7)
+	tests := []struct {
+		name     string
+		report   *domain.Report
+		expected *ReportResponse
+	}{
+		{
+			name:     "nil pointer",
+			report:   nil,
+			expected: nil,
+		},
+		{
+			name:   "no issues",
+			report: &domain.Report{RenumberedColumns: []uint64{}, RenumberedBoards: []string{}},
+			expected: &ReportResponse{
+				Issues:            []*IssueResponse{},
+				Summary:           map[string]uint64{},
+				RenumberedColumns: []uint64{},
+				RenumberedBoards:  []string{},
+			},
+		},
+		{
+			name:   "issues with summary",
+			report: testReport(),
+			expected: &ReportResponse{
+				BoardID: testBoardID,
+				Issues: []*IssueResponse{
+					{Kind: domain.IssueDuplicateRank, Table: domain.TableCards, ID: 1, BoardID: testBoardID, ColumnID: &columnID, Rank: "i"},
+					{Kind: domain.IssueDuplicateRank, Table: domain.TableCards, ID: 2, BoardID: testBoardID, ColumnID: &columnID, Rank: "i"},
+					{Kind: domain.IssueInvalidRank, Table: domain.TableBoardColumns, ID: 5, BoardID: testBoardID, Rank: "a0"},
+					{Kind: domain.IssueMissingRank, Table: domain.TableCards, ID: 4, BoardID: testBoardID, ColumnID: &emptyColumnID},
+					{
+						Kind:          domain.IssueBoardMismatch,
+						Table:         domain.TableCards,
+						ID:            3,
+						BoardID:       testBoardID,
+						ColumnID:      &otherColumnID,
+						Rank:          "k",
+						ColumnBoardID: "0b0f0d8e-43f4-4f4c-9a0e-1b1c5d8a6f11",
+					},
+				},
+				Summary: map[string]uint64{
+					domain.IssueDuplicateRank: 2,
+					domain.IssueInvalidRank:   1,
+					domain.IssueMissingRank:   1,
+					domain.IssueBoardMismatch: 1,
+				},
+				Repaired:          true,
+				RenumberedColumns: []uint64{5, 6},
+				RenumberedBoards:  []string{testBoardID},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToReportResponse(tc.report))
+		})
+	}
+}
+
+func Test_ToReportText(t *testing.T) {
+	mapper := IntegrityMapper{}
+
+	text := mapper.ToReportText(testReport())
+	assert.Contains(t, text, "positions check: board "+testBoardID+"\n")
+	assert.Contains(t, text, `duplicate_rank  cards #1 board=`+testBoardID+` column=5 rank="i"`)
+	assert.Contains(t, text, `invalid_rank    board_columns #5 board=`+testBoardID+` rank="a0"`)
+	assert.Contains(t, text, `missing_rank    cards #4 board=`+testBoardID+` column=6 rank=""`)
+	assert.Contains(t, text, "column_board=0b0f0d8e-43f4-4f4c-9a0e-1b1c5d8a6f11")
+	assert.Contains(t, text, "5 issue(s) found\n")
+	assert.Contains(t, text, "repaired: 2 card column(s), 1 board(s) renumbered\n")
+
+	text = mapper.ToReportText(&domain.Report{})
+	assert.Equal(t, "positions check: all boards\nno issues found\n", text)
+}
+
+func Test_RepairScopes(t *testing.T) {
+	columnIDs, boardIDs := domain.RepairScopes(testReport().Issues)
+	assert.Equal(t, []uint64{5, 6}, columnIDs)
+	assert.Equal(t, []string{testBoardID}, boardIDs)
+}
@@ -0,0 +1,6 @@
+package transport
+
+type PositionsRequest struct {
+	UserID  uint64 `validate:"required,min=1"`
+	BoardID string `query:"board_id" validate:"omitempty,uuid"`
+}
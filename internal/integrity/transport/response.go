@@ -0,0 +1,20 @@
+package transport
+
+type ReportResponse struct {
+	BoardID           string            `json:"board_id,omitempty"`
+	Issues            []*IssueResponse  `json:"issues"`
+	Summary           map[string]uint64 `json:"summary"`
+	Repaired          bool              `json:"repaired"`
+	RenumberedColumns []uint64          `json:"renumbered_columns"`
+	RenumberedBoards  []string          `json:"renumbered_boards"`
+}
+
+type IssueResponse struct {
+	Kind          string  `json:"kind"`
+	Table         string  `json:"table"`
+	ID            uint64  `json:"id"`
+	BoardID       string  `json:"board_id"`
+	ColumnID      *uint64 `json:"column_id,omitempty"`
+	Rank          string  `json:"rank"`
+	ColumnBoardID string  `json:"column_board_id,omitempty"`
+}
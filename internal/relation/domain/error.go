@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"errors"
+)
+
+var (
+	ErrCardNotFound          = errors.New("card not found")
+	ErrRelationNotFound      = errors.New("relation not found")
+	ErrRelationAlreadyExists = errors.New("relation already exists")
+	ErrInvalidRelationType   = errors.New("invalid relation type")
+	ErrSelfRelation          = errors.New("card cannot be related to itself")
+	ErrRelationCycle         = errors.New("relation would create a blocking cycle")
+)
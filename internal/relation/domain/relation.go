@@ -0,0 +1,50 @@
+package domain
+
+import "time"
+
+// Типы связей, которые хранятся в card_relations
+const (
+	RelationBlocks     = "blocks"
+	RelationRelatesTo  = "relates_to"
+	RelationDuplicates = "duplicates"
+)
+
+// Обратные типы: как связь выглядит со стороны целевой карточки
+const (
+	RelationBlockedBy    = "blocked_by"
+	RelationDuplicatedBy = "duplicated_by"
+)
+
+type Relation struct {
+	ID           uint64
+	SourceCardID uint64
+	TargetCardID uint64
+	Type         string
+	CreatedAt    time.Time
+}
+
+// RelatedCard — карточка на другой стороне связи
+type RelatedCard struct {
+	ID             uint64
+	BoardID        string
+	BoardName      string
+	Text           string
+	ColumnCategory string
+}
+
+// CardRelation — связь с точки зрения конкретной карточки, Type уже развёрнут в blocked_by/duplicated_by
+type CardRelation struct {
+	ID        uint64
+	Type      string
+	Card      RelatedCard
+	CreatedAt time.Time
+}
+
+type RelationCommand struct {
+	ID           uint64
+	UserID       uint64
+	BoardID      string
+	CardID       uint64
+	TargetCardID uint64
+	Type         string
+}
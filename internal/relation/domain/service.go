@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+)
+
+type RelationGetter interface {
+	GetList(ctx context.Context, cardID uint64) ([]*CardRelation, error)
+	CardAccessible(ctx context.Context, boardID string, cardID, userID uint64) (bool, error)
+	CardAccessibleByUser(ctx context.Context, cardID, userID uint64) (bool, error)
+}
+
+type RelationCreator interface {
+	Create(ctx context.Context, relation *Relation) error
+}
+
+type RelationDeleter interface {
+	Delete(ctx context.Context, relationID, cardID uint64) error
+}
+
+type RelationRepo interface {
+	RelationGetter
+	RelationCreator
+	RelationDeleter
+}
+
+type RelationService struct {
+	repo RelationRepo
+}
+
+func NewRelationService(repo RelationRepo) *RelationService {
+	return &RelationService{
+		repo: repo,
+	}
+}
+
+func (s *RelationService) GetList(ctx context.Context, req *RelationCommand) ([]*CardRelation, error) {
+	const op = "relation.service.GetList"
+	if err := s.checkCard(ctx, req); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	relations, err := s.repo.GetList(ctx, req.CardID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return relations, nil
+}
+
+func (s *RelationService) Create(ctx context.Context, req *RelationCommand) error {
+	const op = "relation.service.Create"
+	relation, err := NormalizeRelation(req.CardID, req.TargetCardID, req.Type)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.checkCard(ctx, req); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	accessible, err := s.repo.CardAccessibleByUser(ctx, req.TargetCardID, req.UserID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !accessible {
+		return fmt.Errorf("%s: %w", op, ErrCardNotFound)
+	}
+	if err := s.repo.Create(ctx, relation); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *RelationService) Delete(ctx context.Context, req *RelationCommand) error {
+	const op = "relation.service.Delete"
+	if err := s.checkCard(ctx, req); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.repo.Delete(ctx, req.ID, req.CardID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *RelationService) checkCard(ctx context.Context, req *RelationCommand) error {
+	accessible, err := s.repo.CardAccessible(ctx, req.BoardID, req.CardID, req.UserID)
+	if err != nil {
+		return err
+	}
+	if !accessible {
+		return ErrCardNotFound
+	}
+	return nil
+}
+
+// NormalizeRelation приводит связь к хранимому виду: blocked_by разворачивается в blocks,
+// а симметричная relates_to хранится от меньшего id к большему, чтобы не было дублей.
+func NormalizeRelation(cardID, targetCardID uint64, relationType string) (*Relation, error) {
+	if cardID == targetCardID {
+		return nil, ErrSelfRelation
+	}
+	relation := &Relation{
+		SourceCardID: cardID,
+		TargetCardID: targetCardID,
+		Type:         relationType,
+	}
+	switch relationType {
+	case RelationBlocks, RelationDuplicates:
+	case RelationBlockedBy:
+		relation.SourceCardID, relation.TargetCardID = targetCardID, cardID
+		relation.Type = RelationBlocks
+	case RelationRelatesTo:
+		if cardID > targetCardID {
+			relation.SourceCardID, relation.TargetCardID = targetCardID, cardID
+		}
+	default:
+		return nil, ErrInvalidRelationType
+	}
+	return relation, nil
+}
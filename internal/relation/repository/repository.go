@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"backend/internal/relation/domain"
+	"backend/internal/shared/utils"
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+const (
+	existsAccessibleCardInBoardQuery = `
+		SELECT EXISTS(
+			SELECT 1 FROM cards c
+			JOIN boards b ON b.id = c.board_id AND b.deleted_at IS NULL AND b.archived_at IS NULL
+			JOIN board_columns bc ON bc.id = c.column_id AND bc.archived_at IS NULL
+			WHERE c.id = $2 AND c.board_id = $1 AND b.user_id = $3 AND c.deleted_at IS NULL AND c.archived_at IS NULL
+		)
+	`
+	existsAccessibleCardQuery = `
+		SELECT EXISTS(
+			SELECT 1 FROM cards c
+			JOIN boards b ON b.id = c.board_id AND b.deleted_at IS NULL AND b.archived_at IS NULL
+			JOIN board_columns bc ON bc.id = c.column_id AND bc.archived_at IS NULL
+			WHERE c.id = $1 AND b.user_id = $2 AND c.deleted_at IS NULL AND c.archived_at IS NULL
+		)
+	`
+	// selectCardRelationsQuery возвращает связи карточки $1 с обеих сторон;
+	// для входящих связей тип разворачивается в обратный. Связи с архивными карточками не показываются.
+	selectCardRelationsQuery = `
+		SELECT r.id,
+			CASE
+				WHEN r.source_card_id = $1 OR r.type = 'relates_to' THEN r.type
+				WHEN r.type = 'blocks' THEN 'blocked_by'
+				ELSE 'duplicated_by'
+			END,
+			c.id, c.board_id, b.name, c.text, bc.category, r.created_at
+		FROM card_relations r
+		JOIN cards c ON c.id = CASE WHEN r.source_card_id = $1 THEN r.target_card_id ELSE r.source_card_id END
+		JOIN boards b ON b.id = c.board_id
+		JOIN board_columns bc ON bc.id = c.column_id
+		WHERE (r.source_card_id = $1 OR r.target_card_id = $1)
+			AND c.deleted_at IS NULL AND b.deleted_at IS NULL
+			AND c.archived_at IS NULL AND bc.archived_at IS NULL AND b.archived_at IS NULL
+		ORDER BY r.type, r.id
+	`
+	// existsBlocksPathQuery проверяет, достижима ли карточка $2 из $1 по связям blocks;
+	// UNION без ALL отбрасывает уже пройденные карточки, поэтому обход конечен и при цикле в данных
+	existsBlocksPathQuery = `
+		WITH RECURSIVE reachable(id) AS (
+			SELECT $1::BIGINT
+			UNION
+			SELECT r.target_card_id
+			FROM card_relations r
+			JOIN reachable ON r.source_card_id = reachable.id
+			JOIN cards c ON c.id = r.target_card_id AND c.deleted_at IS NULL AND c.archived_at IS NULL
+			WHERE r.type = 'blocks'
+		)
+		SELECT EXISTS (SELECT 1 FROM reachable WHERE id = $2)
+	`
+	insertRelationQuery = `
+		INSERT INTO card_relations (source_card_id, target_card_id, type)
+		VALUES ($1, $2, $3)
+	`
+	// blocksLockKey упорядочивает создание связей blocks: проверка цикла и вставка
+	// не должны пересекаться с другой такой же парой, иначе две встречные связи замкнут цикл
+	blocksLockKey = "card_relations:blocks"
+)
+
+type Storage interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+	Begin() (*sql.Tx, error)
+
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+	GetDB() *sql.DB
+	Close() error
+}
+
+type RelationRepository struct {
+	storage Storage
+}
+
+func NewRelationRepository(storage Storage) *RelationRepository {
+	return &RelationRepository{
+		storage: storage,
+	}
+}
+
+func (r *RelationRepository) GetList(ctx context.Context, cardID uint64) ([]*domain.CardRelation, error) {
+	const op = "relation.repository.GetList"
+	rows, err := r.storage.QueryContext(ctx, selectCardRelationsQuery, cardID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	relations := []*domain.CardRelation{}
+	for rows.Next() {
+		relation := &domain.CardRelation{}
+		if err := rows.Scan(
+			&relation.ID,
+			&relation.Type,
+			&relation.Card.ID,
+			&relation.Card.BoardID,
+			&relation.Card.BoardName,
+			&relation.Card.Text,
+			&relation.Card.ColumnCategory,
+			&relation.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		relations = append(relations, relation)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return relations, nil
+}
+
+func (r *RelationRepository) CardAccessible(ctx context.Context, boardID string, cardID, userID uint64) (bool, error) {
+	const op = "relation.repository.CardAccessible"
+	exists, err := utils.ExistsQueryWrapper(ctx, r.storage, existsAccessibleCardInBoardQuery, boardID, cardID, userID)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return exists, nil
+}
+
+func (r *RelationRepository) CardAccessibleByUser(ctx context.Context, cardID, userID uint64) (bool, error) {
+	const op = "relation.repository.CardAccessibleByUser"
+	exists, err := utils.ExistsQueryWrapper(ctx, r.storage, existsAccessibleCardQuery, cardID, userID)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return exists, nil
+}
+
+// Create сохраняет связь. Для blocks в той же транзакции и под общей блокировкой проверяется,
+// что source не достижима из target: иначе новая связь source -> target замкнёт цикл.
+func (r *RelationRepository) Create(ctx context.Context, relation *domain.Relation) error {
+	const op = "relation.repository.Create"
+	err := utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		if relation.Type == domain.RelationBlocks {
+			if err := utils.LockTx(ctx, tx, blocksLockKey); err != nil {
+				return err
+			}
+			cycle, err := utils.ExistsQueryWrapper(
+				ctx, tx, existsBlocksPathQuery, relation.TargetCardID, relation.SourceCardID,
+			)
+			if err != nil {
+				return err
+			}
+			if cycle {
+				return domain.ErrRelationCycle
+			}
+		}
+		_, err := tx.ExecContext(ctx, insertRelationQuery, relation.SourceCardID, relation.TargetCardID, relation.Type)
+		return err
+	})
+	if utils.IsUniqueViolation(err) {
+		return fmt.Errorf("%s: %w", op, domain.ErrRelationAlreadyExists)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (r *RelationRepository) Delete(ctx context.Context, relationID, cardID uint64) error {
+	const op = "relation.repository.Delete"
+	query := "DELETE FROM card_relations WHERE id = $1 AND (source_card_id = $2 OR target_card_id = $2)"
+	return utils.OpExec(ctx, r.storage.ExecContext, op, query, domain.ErrRelationNotFound, relationID, cardID)
+}
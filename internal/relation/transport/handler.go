@@ -0,0 +1,158 @@
+package transport
+
+import (
+	"backend/internal/relation/domain"
+	"backend/internal/shared/ports/http"
+	"backend/internal/shared/utils"
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	BoardIDKey    = "id"
+	CardIDKey     = "card_id"
+	RelationIDKey = "relation_id"
+	UserIDKey     = "userID"
+)
+
+const (
+	CreatedMessage = "created"
+)
+
+type RelationService interface {
+	GetList(ctx context.Context, req *domain.RelationCommand) ([]*domain.CardRelation, error)
+	Create(ctx context.Context, req *domain.RelationCommand) error
+	Delete(ctx context.Context, req *domain.RelationCommand) error
+}
+
+type RelationHandler struct {
+	validator      http.Validator
+	lang           http.LangMessage
+	service        RelationService
+	relationMapper *RelationMapper
+}
+
+func NewRelationHandler(validator http.Validator, lang http.LangMessage, service RelationService) *RelationHandler {
+	return &RelationHandler{
+		validator:      validator,
+		lang:           lang,
+		service:        service,
+		relationMapper: &RelationMapper{},
+	}
+}
+
+func (h *RelationHandler) GetList(c *fiber.Ctx) error {
+	const op = "relation.transport.handler.GetList"
+	cmd, err := h.commandFromParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid card ID"})
+	}
+
+	relations, err := h.service.GetList(c.Context(), cmd)
+	if err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.JSON(h.relationMapper.ToRelationListResponse(relations))
+}
+
+func (h *RelationHandler) Create(c *fiber.Ctx) error {
+	const op = "relation.transport.handler.Create"
+	body, err := utils.ParseBody[RelationRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	cardID, err := strconv.ParseUint(c.Params(CardIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid card ID"})
+	}
+	body.CardID = cardID
+	body.BoardID = c.Params(BoardIDKey)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	if err := h.service.Create(c.Context(), h.relationMapper.ToRelationCommand(body)); err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(
+		fiber.Map{
+			"message": h.lang.GetResponseMessage(c.Context(), CreatedMessage),
+		},
+	)
+}
+
+func (h *RelationHandler) Delete(c *fiber.Ctx) error {
+	const op = "relation.transport.handler.Delete"
+	cmd, err := h.commandFromParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid card ID"})
+	}
+	cmd.ID, err = strconv.ParseUint(c.Params(RelationIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid relation ID"})
+	}
+
+	if err := h.service.Delete(c.Context(), cmd); err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *RelationHandler) commandFromParams(c *fiber.Ctx) (*domain.RelationCommand, error) {
+	cardID, err := strconv.ParseUint(c.Params(CardIDKey), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	userID, ok := c.Locals(UserIDKey).(uint64)
+	if !ok {
+		return nil, errors.New("missing user ID")
+	}
+	return &domain.RelationCommand{
+		UserID:  userID,
+		BoardID: c.Params(BoardIDKey),
+		CardID:  cardID,
+	}, nil
+}
+
+func (h *RelationHandler) serviceError(c *fiber.Ctx, op string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrCardNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Card not found"})
+	case errors.Is(err, domain.ErrRelationNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Relation not found"})
+	}
+	for _, target := range []error{
+		domain.ErrInvalidRelationType,
+		domain.ErrSelfRelation,
+	} {
+		if errors.Is(err, target) {
+			return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{"errors": target.Error()})
+		}
+	}
+	for _, target := range []error{
+		domain.ErrRelationAlreadyExists,
+		domain.ErrRelationCycle,
+	} {
+		if errors.Is(err, target) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": target.Error()})
+		}
+	}
+	slog.Error(
+		"service error",
+		slog.String("operation", op),
+		slog.Any("errors", err),
+	)
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+}
@@ -0,0 +1,40 @@
+package transport
+
+import (
+	"backend/internal/relation/domain"
+)
+
+type RelationMapper struct{}
+
+func (m *RelationMapper) ToRelationCommand(req *RelationRequest) *domain.RelationCommand {
+	if req == nil {
+		return nil
+	}
+
+	return &domain.RelationCommand{
+		UserID:       req.UserID,
+		BoardID:      req.BoardID,
+		CardID:       req.CardID,
+		TargetCardID: req.TargetCardID,
+		Type:         req.Type,
+	}
+}
+
+func (m *RelationMapper) ToRelationListResponse(relations []*domain.CardRelation) []*RelationResponse {
+	response := make([]*RelationResponse, 0, len(relations))
+	for _, relation := range relations {
+		response = append(response, &RelationResponse{
+			ID:   relation.ID,
+			Type: relation.Type,
+			Card: RelatedCardResponse{
+				ID:             relation.Card.ID,
+				BoardID:        relation.Card.BoardID,
+				BoardName:      relation.Card.BoardName,
+				Text:           relation.Card.Text,
+				ColumnCategory: relation.Card.ColumnCategory,
+			},
+			CreatedAt: relation.CreatedAt,
+		})
+	}
+	return response
+}
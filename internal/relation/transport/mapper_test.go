@@ -0,0 +1,104 @@
+package transport
+
+import (
+	"backend/internal/relation/domain"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ToRelationCommand(t *testing.T) {
+	mapper := RelationMapper{}
+	boardID := "93a49b99-a029-4a18-bbbc-c10d91a8c267"
+	tests := []struct {
+		name     string
+		req      *RelationRequest
+		expected *domain.RelationCommand
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "blocked by",
+			req: &RelationRequest{
+				UserID:       1,
+				BoardID:      boardID,
+				CardID:       10,
+				TargetCardID: 20,
+				Type:         domain.RelationBlockedBy,
+			},
+			expected: &domain.RelationCommand{
+				UserID:       1,
+				BoardID:      boardID,
+				CardID:       10,
+				TargetCardID: 20,
+				Type:         domain.RelationBlockedBy,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToRelationCommand(tc.req))
+		})
+	}
+}
+
+func Test_ToRelationListResponse(t *testing.T) {
+	mapper := RelationMapper{}
+	now := time.Now()
+	tests := []struct {
+		name      string
+		relations []*domain.CardRelation
+		expected  []*RelationResponse
+	}{
+		{
+			name:      "empty list",
+			relations: nil,
+			expected:  []*RelationResponse{},
+		},
+		{
+			name: "cross board relation",
+			relations: []*domain.CardRelation{
+				{
+					ID:   1,
+					Type: domain.RelationBlockedBy,
+					Card: domain.RelatedCard{
+						ID:             20,
+						BoardID:        "other",
+						BoardName:      "Backend",
+						Text:           "API",
+						ColumnCategory: "in_progress",
+					},
+					CreatedAt: now,
+				},
+			},
+			expected: []*RelationResponse{
+				{
+					ID:   1,
+					Type: domain.RelationBlockedBy,
+					Card: RelatedCardResponse{
+						ID:             20,
+						BoardID:        "other",
+						BoardName:      "Backend",
+						Text:           "API",
+						ColumnCategory: "in_progress",
+					},
+					CreatedAt: now,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToRelationListResponse(tc.relations))
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package transport
+
+type RelationRequest struct {
+	UserID       uint64 `validate:"required,min=1"`
+	BoardID      string `validate:"required,uuid"`
+	CardID       uint64 `validate:"required,min=1"`
+	TargetCardID uint64 `json:"card_id" validate:"required,min=1"`
+	Type         string `json:"type" validate:"required,oneof=blocks blocked_by relates_to duplicates"`
+}
@@ -0,0 +1,18 @@
+package transport
+
+import "time"
+
+type RelationResponse struct {
+	ID        uint64              `json:"id"`
+	Type      string              `json:"type"`
+	Card      RelatedCardResponse `json:"card"`
+	CreatedAt time.Time           `json:"created_at"`
+}
+
+type RelatedCardResponse struct {
+	ID             uint64 `json:"id"`
+	BoardID        string `json:"board_id"`
+	BoardName      string `json:"board_name"`
+	Text           string `json:"text"`
+	ColumnCategory string `json:"column_category"`
+}
@@ -0,0 +1,7 @@
+package domain
+
+import "errors"
+
+var (
+	ErrUnsupportedConfig = errors.New("unsupported text search configuration")
+)
@@ -0,0 +1,48 @@
+package domain
+
+import "strings"
+
+const (
+	EntityBoard   = "board"
+	EntityCard    = "card"
+	EntityComment = "comment"
+)
+
+const (
+	ConfigEnglish = "english"
+	ConfigRussian = "russian"
+)
+
+// SearchQuery — Archived добавляет в выдачу архивные доски, колонки и карточки
+type SearchQuery struct {
+	UserID   uint64
+	Query    string
+	Config   string
+	Limit    uint64
+	Archived bool
+}
+
+type SearchHit struct {
+	EntityType string
+	BoardID    string
+	CardID     uint64
+	CommentID  uint64
+	Title      string
+	Headline   string
+	Rank       float64
+}
+
+type SearchResult struct {
+	Boards   []*SearchHit
+	Cards    []*SearchHit
+	Comments []*SearchHit
+}
+
+// ConfigForLocale подбирает конфигурацию полнотекстового поиска Postgres
+// по локали запроса (значение заголовка Accept-Language).
+func ConfigForLocale(locale string) string {
+	if strings.HasPrefix(strings.ToLower(strings.TrimSpace(locale)), "ru") {
+		return ConfigRussian
+	}
+	return ConfigEnglish
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	defaultLimit = 10
+)
+
+type SearchRepo interface {
+	SearchBoards(ctx context.Context, query *SearchQuery) ([]*SearchHit, error)
+	SearchCards(ctx context.Context, query *SearchQuery) ([]*SearchHit, error)
+	SearchComments(ctx context.Context, query *SearchQuery) ([]*SearchHit, error)
+}
+
+type SearchService struct {
+	repo SearchRepo
+}
+
+func NewSearchService(repo SearchRepo) *SearchService {
+	return &SearchService{
+		repo: repo,
+	}
+}
+
+func (s *SearchService) Search(ctx context.Context, req *SearchQuery) (*SearchResult, error) {
+	const op = "search.service.Search"
+	query := &SearchQuery{
+		UserID:   req.UserID,
+		Query:    req.Query,
+		Config:   req.Config,
+		Limit:    req.Limit,
+		Archived: req.Archived,
+	}
+	if query.Config == "" {
+		query.Config = ConfigEnglish
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultLimit
+	}
+
+	result := &SearchResult{}
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		hits, err := s.repo.SearchBoards(ctx, query)
+		result.Boards = hits
+		return err
+	})
+	eg.Go(func() error {
+		hits, err := s.repo.SearchCards(ctx, query)
+		result.Cards = hits
+		return err
+	})
+	eg.Go(func() error {
+		hits, err := s.repo.SearchComments(ctx, query)
+		result.Comments = hits
+		return err
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return result, nil
+}
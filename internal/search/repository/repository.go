@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"backend/internal/search/domain"
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+const (
+	headlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=20, MinWords=5"
+)
+
+// boardLiveCondition и cardLiveCondition прячут архивные доски, колонки и карточки, если поиск не включает архив
+const (
+	boardLiveCondition = " AND b.archived_at IS NULL"
+	cardLiveCondition  = " AND c.archived_at IS NULL AND bc.archived_at IS NULL AND b.archived_at IS NULL"
+)
+
+// searchColumns сопоставляет конфигурацию поиска с индексируемой колонкой tsvector.
+var searchColumns = map[string]string{
+	domain.ConfigEnglish: "search_en",
+	domain.ConfigRussian: "search_ru",
+}
+
+type Storage interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+	Begin() (*sql.Tx, error)
+
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+	GetDB() *sql.DB
+	Close() error
+}
+
+type SearchRepository struct {
+	storage Storage
+}
+
+func NewSearchRepository(storage Storage) *SearchRepository {
+	return &SearchRepository{
+		storage: storage,
+	}
+}
+
+func (r *SearchRepository) SearchBoards(ctx context.Context, query *domain.SearchQuery) ([]*domain.SearchHit, error) {
+	const op = "search.repository.SearchBoards"
+	column, ok := searchColumns[query.Config]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", op, domain.ErrUnsupportedConfig)
+	}
+	sqlQuery := fmt.Sprintf(`
+		SELECT
+			b.id::TEXT,
+			0,
+			0,
+			b.name,
+			ts_headline($1::REGCONFIG, b.name || ' ' || COALESCE(b.description, ''), q, $5),
+			ts_rank(b.%[1]s, q) AS rank
+		FROM boards b, websearch_to_tsquery($1::REGCONFIG, $2) q
+		WHERE b.user_id = $3 AND b.deleted_at IS NULL AND b.%[1]s @@ q%[2]s
+		ORDER BY rank DESC, b.created_at DESC
+		LIMIT $4
+	`, column, liveCondition(query, boardLiveCondition))
+	return r.search(ctx, op, domain.EntityBoard, sqlQuery, query)
+}
+
+func (r *SearchRepository) SearchCards(ctx context.Context, query *domain.SearchQuery) ([]*domain.SearchHit, error) {
+	const op = "search.repository.SearchCards"
+	column, ok := searchColumns[query.Config]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", op, domain.ErrUnsupportedConfig)
+	}
+	sqlQuery := fmt.Sprintf(`
+		SELECT
+			c.board_id::TEXT,
+			c.id,
+			0,
+			c.text,
+			ts_headline($1::REGCONFIG, c.text || ' ' || COALESCE(c.description, ''), q, $5),
+			ts_rank(c.%[1]s, q) AS rank
+		FROM cards c
+		JOIN boards b ON b.id = c.board_id AND b.deleted_at IS NULL
+		JOIN board_columns bc ON bc.id = c.column_id
+		CROSS JOIN websearch_to_tsquery($1::REGCONFIG, $2) q
+		WHERE b.user_id = $3 AND c.deleted_at IS NULL AND c.%[1]s @@ q%[2]s
+		ORDER BY rank DESC, c.created_at DESC
+		LIMIT $4
+	`, column, liveCondition(query, cardLiveCondition))
+	return r.search(ctx, op, domain.EntityCard, sqlQuery, query)
+}
+
+func (r *SearchRepository) SearchComments(ctx context.Context, query *domain.SearchQuery) ([]*domain.SearchHit, error) {
+	const op = "search.repository.SearchComments"
+	column, ok := searchColumns[query.Config]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", op, domain.ErrUnsupportedConfig)
+	}
+	sqlQuery := fmt.Sprintf(`
+		SELECT
+			c.board_id::TEXT,
+			c.id,
+			cm.id,
+			c.text,
+			ts_headline($1::REGCONFIG, COALESCE(cm.text, ''), q, $5),
+			ts_rank(cm.%[1]s, q) AS rank
+		FROM comments cm
+		JOIN cards c ON c.id = cm.card_id AND c.deleted_at IS NULL
+		JOIN boards b ON b.id = c.board_id AND b.deleted_at IS NULL
+		JOIN board_columns bc ON bc.id = c.column_id
+		CROSS JOIN websearch_to_tsquery($1::REGCONFIG, $2) q
+		WHERE b.user_id = $3 AND cm.deleted_at IS NULL AND cm.%[1]s @@ q%[2]s
+		ORDER BY rank DESC, cm.created_at DESC
+		LIMIT $4
+	`, column, liveCondition(query, cardLiveCondition))
+	return r.search(ctx, op, domain.EntityComment, sqlQuery, query)
+}
+
+func liveCondition(query *domain.SearchQuery, condition string) string {
+	if query.Archived {
+		return ""
+	}
+	return condition
+}
+
+func (r *SearchRepository) search(
+	ctx context.Context, op, entityType, sqlQuery string, query *domain.SearchQuery,
+) ([]*domain.SearchHit, error) {
+	rows, err := r.storage.QueryContext(
+		ctx,
+		sqlQuery,
+		query.Config,
+		query.Query,
+		query.UserID,
+		query.Limit,
+		headlineOptions,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	hits := []*domain.SearchHit{}
+	for rows.Next() {
+		hit := &domain.SearchHit{EntityType: entityType}
+		if err := rows.Scan(
+			&hit.BoardID,
+			&hit.CardID,
+			&hit.CommentID,
+			&hit.Title,
+			&hit.Headline,
+			&hit.Rank,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		hits = append(hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return hits, nil
+}
@@ -0,0 +1,65 @@
+package transport
+
+import (
+	"backend/internal/search/domain"
+	"backend/internal/shared/ports/http"
+	"backend/internal/shared/utils"
+	"context"
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	LocaleKey = "locale"
+)
+
+type SearchService interface {
+	Search(ctx context.Context, req *domain.SearchQuery) (*domain.SearchResult, error)
+}
+
+type SearchHandler struct {
+	validator    http.Validator
+	service      SearchService
+	searchMapper *SearchMapper
+}
+
+func NewSearchHandler(validator http.Validator, service SearchService) *SearchHandler {
+	return &SearchHandler{
+		validator:    validator,
+		service:      service,
+		searchMapper: &SearchMapper{},
+	}
+}
+
+func (h *SearchHandler) Search(c *fiber.Ctx) error {
+	const op = "search.transport.handler.Search"
+	query, err := utils.ParseQuery[SearchRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid query parameters"})
+	}
+	query.Locale, _ = c.Locals(LocaleKey).(string)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, query); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	result, err := h.service.Search(c.Context(), h.searchMapper.ToSearchQuery(query))
+	if err != nil {
+		slog.Error(
+			"service error",
+			slog.String("operation", op),
+			slog.Any("errors", err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+	}
+
+	return c.JSON(h.searchMapper.ToSearchResponse(query.Query, result))
+}
@@ -0,0 +1,47 @@
+package transport
+
+import "backend/internal/search/domain"
+
+type SearchMapper struct{}
+
+func (m *SearchMapper) ToSearchQuery(req *SearchRequest) *domain.SearchQuery {
+	if req == nil {
+		return nil
+	}
+
+	return &domain.SearchQuery{
+		UserID:   req.UserID,
+		Query:    req.Query,
+		Config:   domain.ConfigForLocale(req.Locale),
+		Limit:    req.Limit,
+		Archived: req.Archived,
+	}
+}
+
+func (m *SearchMapper) ToSearchResponse(query string, result *domain.SearchResult) *SearchResponse {
+	if result == nil {
+		return nil
+	}
+
+	return &SearchResponse{
+		Query:    query,
+		Boards:   m.mapHits(result.Boards),
+		Cards:    m.mapHits(result.Cards),
+		Comments: m.mapHits(result.Comments),
+	}
+}
+
+func (m *SearchMapper) mapHits(hits []*domain.SearchHit) []*SearchHitResponse {
+	mapped := make([]*SearchHitResponse, 0, len(hits))
+	for _, hit := range hits {
+		mapped = append(mapped, &SearchHitResponse{
+			BoardID:   hit.BoardID,
+			CardID:    hit.CardID,
+			CommentID: hit.CommentID,
+			Title:     hit.Title,
+			Headline:  hit.Headline,
+			Rank:      hit.Rank,
+		})
+	}
+	return mapped
+}
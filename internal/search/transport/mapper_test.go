@@ -0,0 +1,168 @@
+package transport
+
+import (
+	"backend/internal/search/domain"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ToSearchQuery(t *testing.T) {
+	mapper := SearchMapper{}
+	tests := []struct {
+		name     string
+		req      *SearchRequest
+		expected *domain.SearchQuery
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "default locale",
+			req: &SearchRequest{
+				UserID: 1,
+				Query:  "release notes",
+				Limit:  5,
+			},
+			expected: &domain.SearchQuery{
+				UserID: 1,
+				Query:  "release notes",
+				Config: domain.ConfigEnglish,
+				Limit:  5,
+			},
+		},
+		{
+			name: "russian locale",
+			req: &SearchRequest{
+				UserID: 1,
+				Query:  "релиз",
+				Locale: "ru-RU,ru;q=0.9",
+			},
+			expected: &domain.SearchQuery{
+				UserID: 1,
+				Query:  "релиз",
+				Config: domain.ConfigRussian,
+			},
+		},
+		{
+			name: "english locale",
+			req: &SearchRequest{
+				UserID: 1,
+				Query:  "release",
+				Locale: "en",
+			},
+			expected: &domain.SearchQuery{
+				UserID: 1,
+				Query:  "release",
+				Config: domain.ConfigEnglish,
+			},
+		}, {
+			name: "archived included",
+			req: &SearchRequest{
+				UserID:   1,
+				Query:    "release",
+				Archived: true,
+			},
+			expected: &domain.SearchQuery{
+				UserID:   1,
+				Query:    "release",
+				Config:   domain.ConfigEnglish,
+				Archived: true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			actual := mapper.ToSearchQuery(tc.req)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func Test_ToSearchResponse(t *testing.T) {
+	mapper := SearchMapper{}
+	tests := []struct {
+		name     string
+		query    string
+		req      *domain.SearchResult
+		expected *SearchResponse
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name:  "empty result",
+			query: "bug",
+			req:   &domain.SearchResult{},
+			expected: &SearchResponse{
+				Query:    "bug",
+				Boards:   []*SearchHitResponse{},
+				Cards:    []*SearchHitResponse{},
+				Comments: []*SearchHitResponse{},
+			},
+		},
+		{
+			name:  "grouped hits",
+			query: "bug",
+			req: &domain.SearchResult{
+				Boards: []*domain.SearchHit{
+					{
+						EntityType: domain.EntityBoard,
+						BoardID:    "e102c99e-651c-44e1-bff1-c4a22e3134ce",
+						Title:      "Bugs",
+						Headline:   "<mark>Bugs</mark>",
+						Rank:       0.5,
+					},
+				},
+				Comments: []*domain.SearchHit{
+					{
+						EntityType: domain.EntityComment,
+						BoardID:    "e102c99e-651c-44e1-bff1-c4a22e3134ce",
+						CardID:     2,
+						CommentID:  3,
+						Title:      "Login fails",
+						Headline:   "looks like a <mark>bug</mark>",
+						Rank:       0.1,
+					},
+				},
+			},
+			expected: &SearchResponse{
+				Query: "bug",
+				Boards: []*SearchHitResponse{
+					{
+						BoardID:  "e102c99e-651c-44e1-bff1-c4a22e3134ce",
+						Title:    "Bugs",
+						Headline: "<mark>Bugs</mark>",
+						Rank:     0.5,
+					},
+				},
+				Cards: []*SearchHitResponse{},
+				Comments: []*SearchHitResponse{
+					{
+						BoardID:   "e102c99e-651c-44e1-bff1-c4a22e3134ce",
+						CardID:    2,
+						CommentID: 3,
+						Title:     "Login fails",
+						Headline:  "looks like a <mark>bug</mark>",
+						Rank:      0.1,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			actual := mapper.ToSearchResponse(tc.query, tc.req)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
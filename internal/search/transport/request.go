@@ -0,0 +1,9 @@
+package transport
+
+type SearchRequest struct {
+	UserID   uint64 `validate:"required,min=1"`
+	Query    string `query:"q" validate:"required,min=2,max=255"`
+	Limit    uint64 `query:"limit" validate:"omitempty,min=1,max=50"`
+	Archived bool   `query:"archived"`
+	Locale   string
+}
@@ -0,0 +1,17 @@
+package transport
+
+type SearchResponse struct {
+	Query    string               `json:"query"`
+	Boards   []*SearchHitResponse `json:"boards"`
+	Cards    []*SearchHitResponse `json:"cards"`
+	Comments []*SearchHitResponse `json:"comments"`
+}
+
+type SearchHitResponse struct {
+	BoardID   string  `json:"board_id"`
+	CardID    uint64  `json:"card_id,omitempty"`
+	CommentID uint64  `json:"comment_id,omitempty"`
+	Title     string  `json:"title"`
+	Headline  string  `json:"headline"`
+	Rank      float64 `json:"rank"`
+}
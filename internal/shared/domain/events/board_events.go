@@ -1,8 +1,11 @@
 package events
 
+// BoardDeletedEvent — Cascade означает удаление вместе с колонками, карточками и комментариями,
+// поэтому подписчики не запрещают его из-за дочерних записей
 type BoardDeletedEvent struct {
 	BoardID string
 	UserID  uint64
+	Cascade bool
 }
 
 func (e BoardDeletedEvent) Name() string {
@@ -11,6 +14,7 @@ func (e BoardDeletedEvent) Name() string {
 
 type ColumnDeletedEvent struct {
 	ColumnID uint64
+	Cascade  bool
 }
 
 func (c ColumnDeletedEvent) Name() string {
@@ -0,0 +1,35 @@
+package events
+
+// события ниже публикуются после успешного изменения карточки, ошибки подписчиков операцию не отменяют
+
+type CardCreatedEvent struct {
+	CardID   uint64
+	BoardID  string
+	ColumnID uint64
+}
+
+func (e CardCreatedEvent) Name() string {
+	return "CardCreated"
+}
+
+type CardMovedEvent struct {
+	CardID       uint64
+	BoardID      string
+	FromColumnID uint64
+	ToColumnID   uint64
+}
+
+func (e CardMovedEvent) Name() string {
+	return "CardMoved"
+}
+
+// CardPropertiesChangedEvent — Changed содержит имена изменившихся свойств: color, tag, estimate
+type CardPropertiesChangedEvent struct {
+	CardID  uint64
+	BoardID string
+	Changed []string
+}
+
+func (e CardPropertiesChangedEvent) Name() string {
+	return "CardPropertiesChanged"
+}
@@ -4,4 +4,5 @@ import "errors"
 
 var (
 	ErrCardHasComments = errors.New("card has comments")
+	ErrCardHasChildren = errors.New("card has child cards")
 )
@@ -0,0 +1,6 @@
+package errors
+
+import "errors"
+
+// ErrVersionMismatch — запись изменилась после того, как клиент её прочитал (If-Match не совпал)
+var ErrVersionMismatch = errors.New("version mismatch")
@@ -0,0 +1,31 @@
+package filter
+
+type Operator string
+
+const (
+	OpMatch Operator = ":"
+	OpGt    Operator = ">"
+	OpGte   Operator = ">="
+	OpLt    Operator = "<"
+	OpLte   Operator = "<="
+)
+
+// Term — одно условие фильтра: `field:value`, `-field>value` или свободный текст.
+// Pos и ValuePos — позиции (с 1) в исходной строке запроса.
+type Term struct {
+	Pos      int
+	ValuePos int
+	Negated  bool
+	Field    string
+	Op       Operator
+	Value    string
+}
+
+// Query — разобранный фильтр; условия объединяются через AND.
+type Query struct {
+	Terms []*Term
+}
+
+func (q *Query) Empty() bool {
+	return q == nil || len(q.Terms) == 0
+}
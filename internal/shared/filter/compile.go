@@ -0,0 +1,145 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Mapping связывает поля фильтра с SQL-выражениями конкретного репозитория.
+// Выражение с %s считается шаблоном: вместо %s подставляется плейсхолдер значения.
+// FreeText — колонки для условий без поля.
+type Mapping struct {
+	Columns  map[string]string
+	FreeText []string
+}
+
+// Compiled — набор условий для WHERE (объединяются через AND) и их параметры.
+type Compiled struct {
+	Where  []string
+	Params []any
+}
+
+var comparisons = map[Operator]string{
+	OpMatch: "=",
+	OpGt:    ">",
+	OpGte:   ">=",
+	OpLt:    "<",
+	OpLte:   "<=",
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Compile строит параметризованные условия; offset — число уже занятых плейсхолдеров.
+// Запрос должен быть предварительно проверен через Validate.
+func Compile(query *Query, fields Fields, mapping Mapping, offset int, now time.Time) (*Compiled, error) {
+	c := &compiler{offset: offset, now: now}
+	if query.Empty() {
+		return &c.result, nil
+	}
+	for _, term := range query.Terms {
+		condition, err := c.term(term, fields, mapping)
+		if err != nil {
+			return nil, err
+		}
+		if term.Negated {
+			condition = fmt.Sprintf("NOT COALESCE((%s), FALSE)", condition)
+		}
+		c.result.Where = append(c.result.Where, condition)
+	}
+	return &c.result, nil
+}
+
+type compiler struct {
+	offset int
+	now    time.Time
+	result Compiled
+}
+
+func (c *compiler) param(value any) string {
+	c.result.Params = append(c.result.Params, value)
+	return "$" + strconv.Itoa(c.offset+len(c.result.Params))
+}
+
+func (c *compiler) term(term *Term, fields Fields, mapping Mapping) (string, error) {
+	if term.Field == "" {
+		if len(mapping.FreeText) == 0 {
+			return "", fmt.Errorf("filter: free text is not supported")
+		}
+		placeholder := c.param(likePattern(term.Value))
+		conditions := make([]string, 0, len(mapping.FreeText))
+		for _, column := range mapping.FreeText {
+			conditions = append(conditions, fmt.Sprintf("%s ILIKE %s", column, placeholder))
+		}
+		return "(" + strings.Join(conditions, " OR ") + ")", nil
+	}
+
+	field, ok := fields[term.Field]
+	if !ok {
+		return "", fmt.Errorf("filter: unknown field %q", term.Field)
+	}
+	expr, ok := mapping.Columns[term.Field]
+	if !ok {
+		return "", fmt.Errorf("filter: no column for field %q", term.Field)
+	}
+	if strings.Contains(expr, "%s") {
+		return fmt.Sprintf(expr, c.param(c.value(field, term.Value))), nil
+	}
+
+	switch field.Kind {
+	case KindText:
+		return fmt.Sprintf("%s ILIKE %s", expr, c.param(likePattern(term.Value))), nil
+	case KindExact, KindColor:
+		return fmt.Sprintf("LOWER(%s) = LOWER(%s)", expr, c.param(term.Value)), nil
+	case KindEnum:
+		return fmt.Sprintf("%s = %s", expr, c.param(strings.ToLower(term.Value))), nil
+	case KindNumber:
+		return fmt.Sprintf("%s %s %s", expr, comparisons[term.Op], c.param(c.value(field, term.Value))), nil
+	case KindDate:
+		return c.date(expr, term)
+	default:
+		return "", fmt.Errorf("filter: unsupported kind for field %q", term.Field)
+	}
+}
+
+func (c *compiler) value(field Field, value string) any {
+	if field.Kind == KindNumber {
+		number, _ := strconv.ParseInt(value, 10, 64)
+		return number
+	}
+	return value
+}
+
+func (c *compiler) date(expr string, term *Term) (string, error) {
+	t, day, ok := parseDate(term.Value, c.now)
+	if !ok {
+		return "", fmt.Errorf("filter: invalid date %q", term.Value)
+	}
+	if !day {
+		// относительная дата: `created:-7d` и `created>-7d` означают «не раньше»
+		op := term.Op
+		if op == OpMatch {
+			op = OpGte
+		}
+		return fmt.Sprintf("%s %s %s", expr, comparisons[op], c.param(t)), nil
+	}
+
+	next := t.AddDate(0, 0, 1)
+	switch term.Op {
+	case OpGt:
+		return fmt.Sprintf("%s >= %s", expr, c.param(next)), nil
+	case OpGte:
+		return fmt.Sprintf("%s >= %s", expr, c.param(t)), nil
+	case OpLt:
+		return fmt.Sprintf("%s < %s", expr, c.param(t)), nil
+	case OpLte:
+		return fmt.Sprintf("%s < %s", expr, c.param(next)), nil
+	default:
+		return fmt.Sprintf("(%s >= %s AND %s < %s)", expr, c.param(t), expr, c.param(next)), nil
+	}
+}
+
+func likePattern(value string) string {
+	return "%" + likeEscaper.Replace(value) + "%"
+}
@@ -0,0 +1,48 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	CodeUnterminatedQuote = "unterminated_quote"
+	CodeExpectedValue     = "expected_value"
+	CodeTooManyTerms      = "too_many_terms"
+	CodeUnknownField      = "unknown_field"
+	CodeInvalidOperator   = "invalid_operator"
+	CodeInvalidValue      = "invalid_value"
+)
+
+// SyntaxError описывает ошибку разбора или проверки фильтра.
+// Текст для пользователя берётся из lang по ключу Key().
+type SyntaxError struct {
+	Pos    int
+	Code   string
+	Params map[string]string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("filter: %s at position %d", e.Code, e.Pos)
+}
+
+func (e *SyntaxError) Key() string {
+	return "filter." + e.Code
+}
+
+func (e *SyntaxError) MessageParams() map[string]string {
+	params := make(map[string]string, len(e.Params)+1)
+	for key, value := range e.Params {
+		params[key] = value
+	}
+	params["position"] = strconv.Itoa(e.Pos)
+	return params
+}
+
+func newSyntaxError(pos int, code string, params map[string]string) *SyntaxError {
+	return &SyntaxError{
+		Pos:    pos,
+		Code:   code,
+		Params: params,
+	}
+}
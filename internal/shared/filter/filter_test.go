@@ -0,0 +1,196 @@
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testFields = Fields{
+	"column":   {Kind: KindExact},
+	"tag":      {Kind: KindExact},
+	"text":     {Kind: KindText},
+	"color":    {Kind: KindColor},
+	"category": {Kind: KindEnum, Values: []string{"todo", "done"}},
+	"estimate": {Kind: KindNumber},
+	"sprint":   {Kind: KindNumber, MatchOnly: true},
+	"created":  {Kind: KindDate},
+}
+
+var testMapping = Mapping{
+	Columns: map[string]string{
+		"column":   "bc.name",
+		"tag":      "tag",
+		"text":     "text",
+		"color":    "color",
+		"category": "bc.category",
+		"estimate": "estimate",
+		"sprint":   "EXISTS (SELECT 1 FROM sprint_cards WHERE sprint_id = %s)",
+		"created":  "created_at",
+	},
+	FreeText: []string{"text", "description"},
+}
+
+func Test_Parse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []*Term
+	}{
+		{
+			name:     "empty",
+			input:    "   ",
+			expected: []*Term{},
+		},
+		{
+			name:  "full example",
+			input: `column:"In Progress" tag:bug created>-7d -text:wontfix`,
+			expected: []*Term{
+				{Pos: 1, ValuePos: 8, Field: "column", Op: OpMatch, Value: "In Progress"},
+				{Pos: 22, ValuePos: 26, Field: "tag", Op: OpMatch, Value: "bug"},
+				{Pos: 30, ValuePos: 38, Field: "created", Op: OpGt, Value: "-7d"},
+				{Pos: 42, ValuePos: 48, Negated: true, Field: "text", Op: OpMatch, Value: "wontfix"},
+			},
+		},
+		{
+			name:  "free text and escaped quote",
+			input: `login "say \"hi\"" estimate<=5`,
+			expected: []*Term{
+				{Pos: 1, ValuePos: 1, Op: OpMatch, Value: "login"},
+				{Pos: 7, ValuePos: 7, Op: OpMatch, Value: `say "hi"`},
+				{Pos: 20, ValuePos: 30, Field: "estimate", Op: OpLte, Value: "5"},
+			},
+		},
+		{
+			name:  "field names are case insensitive",
+			input: "Tag:Bug",
+			expected: []*Term{
+				{Pos: 1, ValuePos: 5, Field: "tag", Op: OpMatch, Value: "Bug"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := Parse(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, query.Terms)
+		})
+	}
+}
+
+func Test_ParseErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		code  string
+		pos   int
+	}{
+		{name: "unterminated quote", input: `tag:bug column:"In Progress`, code: CodeUnterminatedQuote, pos: 16},
+		{name: "missing value", input: "tag: bug", code: CodeExpectedValue, pos: 5},
+		{name: "lonely minus", input: "bug - tag:x", code: CodeExpectedValue, pos: 6},
+		{name: "empty quotes", input: `text:""`, code: CodeExpectedValue, pos: 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			var syntaxErr *SyntaxError
+			require.ErrorAs(t, err, &syntaxErr)
+			assert.Equal(t, tt.code, syntaxErr.Code)
+			assert.Equal(t, tt.pos, syntaxErr.Pos)
+		})
+	}
+}
+
+func Test_Validate(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		input string
+		code  string
+		pos   int
+	}{
+		{name: "valid", input: `tag:bug color:#ff0000 category:DONE estimate>=3 created:2025-03-01 sprint:4 free`},
+		{name: "unknown field", input: "tag:bug owner:me", code: CodeUnknownField, pos: 9},
+		{name: "operator on text", input: "tag>bug", code: CodeInvalidOperator, pos: 4},
+		{name: "operator on match only", input: "sprint>=2", code: CodeInvalidOperator, pos: 7},
+		{name: "bad color", input: "color:red", code: CodeInvalidValue, pos: 7},
+		{name: "bad enum", input: "category:later", code: CodeInvalidValue, pos: 10},
+		{name: "bad number", input: "estimate:abc", code: CodeInvalidValue, pos: 10},
+		{name: "bad date", input: "created>-7x", code: CodeInvalidValue, pos: 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := Parse(tt.input)
+			require.NoError(t, err)
+			err = Validate(query, testFields, now)
+			if tt.code == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var syntaxErr *SyntaxError
+			require.ErrorAs(t, err, &syntaxErr)
+			assert.Equal(t, tt.code, syntaxErr.Code)
+			assert.Equal(t, tt.pos, syntaxErr.Pos)
+		})
+	}
+}
+
+func Test_Compile(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		input  string
+		offset int
+		where  []string
+		params []any
+	}{
+		{
+			name:   "full example",
+			input:  `column:"In Progress" tag:bug created>-7d -text:wontfix`,
+			offset: 1,
+			where: []string{
+				"LOWER(bc.name) = LOWER($2)",
+				"LOWER(tag) = LOWER($3)",
+				"created_at > $4",
+				"NOT COALESCE((text ILIKE $5), FALSE)",
+			},
+			params: []any{"In Progress", "bug", now.AddDate(0, 0, -7), "%wontfix%"},
+		},
+		{
+			name:   "free text escapes like",
+			input:  "50%_off",
+			where:  []string{"(text ILIKE $1 OR description ILIKE $1)"},
+			params: []any{`%50\%\_off%`},
+		},
+		{
+			name:  "date day range and template",
+			input: "created:2025-03-01 created<=2025-03-01 sprint:4 estimate>2 category:Done",
+			where: []string{
+				"(created_at >= $1 AND created_at < $2)",
+				"created_at < $3",
+				"EXISTS (SELECT 1 FROM sprint_cards WHERE sprint_id = $4)",
+				"estimate > $5",
+				"bc.category = $6",
+			},
+			params: []any{day, day.AddDate(0, 0, 1), day.AddDate(0, 0, 1), int64(4), int64(2), "done"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := Parse(tt.input)
+			require.NoError(t, err)
+			require.NoError(t, Validate(query, testFields, now))
+			compiled, err := Compile(query, testFields, testMapping, tt.offset, now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.where, compiled.Where)
+			assert.Equal(t, tt.params, compiled.Params)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package filter
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	MaxTerms = 20
+)
+
+// Parse разбирает строку вида `column:"In Progress" tag:bug created>-7d -text:wontfix`.
+func Parse(input string) (*Query, error) {
+	p := &parser{input: []rune(input)}
+	return p.parse()
+}
+
+type parser struct {
+	input []rune
+	pos   int
+}
+
+func (p *parser) parse() (*Query, error) {
+	query := &Query{Terms: []*Term{}}
+	for {
+		p.skipSpaces()
+		if p.eof() {
+			return query, nil
+		}
+		term, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		if len(query.Terms) == MaxTerms {
+			return nil, newSyntaxError(term.Pos, CodeTooManyTerms, map[string]string{
+				"max": strconv.Itoa(MaxTerms),
+			})
+		}
+		query.Terms = append(query.Terms, term)
+	}
+}
+
+func (p *parser) parseTerm() (*Term, error) {
+	term := &Term{Pos: p.pos + 1, Op: OpMatch}
+	if p.peek() == '-' {
+		term.Negated = true
+		p.pos++
+		if p.eof() || unicode.IsSpace(p.peek()) {
+			return nil, newSyntaxError(p.pos+1, CodeExpectedValue, nil)
+		}
+	}
+
+	start := p.pos
+	if unicode.IsLetter(p.peek()) {
+		for !p.eof() && isFieldRune(p.peek()) {
+			p.pos++
+		}
+		if !p.eof() && isOperatorRune(p.peek()) {
+			term.Field = strings.ToLower(string(p.input[start:p.pos]))
+			term.Op = p.readOperator()
+		} else {
+			p.pos = start
+		}
+	}
+
+	term.ValuePos = p.pos + 1
+	value, err := p.readValue()
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return nil, newSyntaxError(term.ValuePos, CodeExpectedValue, nil)
+	}
+	term.Value = value
+	return term, nil
+}
+
+func (p *parser) readOperator() Operator {
+	r := p.peek()
+	p.pos++
+	switch r {
+	case '>':
+		if !p.eof() && p.peek() == '=' {
+			p.pos++
+			return OpGte
+		}
+		return OpGt
+	case '<':
+		if !p.eof() && p.peek() == '=' {
+			p.pos++
+			return OpLte
+		}
+		return OpLt
+	default:
+		return OpMatch
+	}
+}
+
+func (p *parser) readValue() (string, error) {
+	if p.eof() {
+		return "", nil
+	}
+	if p.peek() != '"' {
+		start := p.pos
+		for !p.eof() && !unicode.IsSpace(p.peek()) {
+			p.pos++
+		}
+		return string(p.input[start:p.pos]), nil
+	}
+
+	quotePos := p.pos + 1
+	p.pos++
+	var value strings.Builder
+	for {
+		if p.eof() {
+			return "", newSyntaxError(quotePos, CodeUnterminatedQuote, nil)
+		}
+		r := p.peek()
+		p.pos++
+		switch {
+		case r == '\\' && !p.eof():
+			value.WriteRune(p.peek())
+			p.pos++
+		case r == '"':
+			return value.String(), nil
+		default:
+			value.WriteRune(r)
+		}
+	}
+}
+
+func (p *parser) skipSpaces() {
+	for !p.eof() && unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+}
+
+func (p *parser) peek() rune {
+	return p.input[p.pos]
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+func isFieldRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+func isOperatorRune(r rune) bool {
+	return r == ':' || r == '>' || r == '<' || r == '='
+}
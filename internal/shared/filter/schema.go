@@ -0,0 +1,127 @@
+package filter
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Kind int
+
+const (
+	// KindText — поиск подстроки без учёта регистра
+	KindText Kind = iota
+	// KindExact — точное совпадение без учёта регистра
+	KindExact
+	KindColor
+	KindEnum
+	KindNumber
+	KindDate
+)
+
+var colorRegexp = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+var relativeDateRegexp = regexp.MustCompile(`^-?(\d{1,4})([hdwmy])$`)
+
+// Field описывает поле, доступное в фильтре.
+// MatchOnly запрещает операторы сравнения для числовых полей и дат.
+type Field struct {
+	Kind      Kind
+	Values    []string
+	MatchOnly bool
+}
+
+type Fields map[string]Field
+
+func (f Field) allows(op Operator) bool {
+	if op == OpMatch {
+		return true
+	}
+	return (f.Kind == KindNumber || f.Kind == KindDate) && !f.MatchOnly
+}
+
+func (f Field) valid(value string, now time.Time) bool {
+	switch f.Kind {
+	case KindColor:
+		return colorRegexp.MatchString(value)
+	case KindEnum:
+		for _, allowed := range f.Values {
+			if strings.EqualFold(allowed, value) {
+				return true
+			}
+		}
+		return false
+	case KindNumber:
+		_, err := strconv.ParseUint(value, 10, 32)
+		return err == nil
+	case KindDate:
+		_, _, ok := parseDate(value, now)
+		return ok
+	default:
+		return true
+	}
+}
+
+// Validate проверяет поля, операторы и значения условий по схеме
+func Validate(query *Query, fields Fields, now time.Time) error {
+	if query.Empty() {
+		return nil
+	}
+	for _, term := range query.Terms {
+		if term.Field == "" {
+			continue
+		}
+		field, ok := fields[term.Field]
+		if !ok {
+			return newSyntaxError(term.Pos, CodeUnknownField, map[string]string{
+				"field": term.Field,
+			})
+		}
+		if !field.allows(term.Op) {
+			return newSyntaxError(term.ValuePos-len(term.Op), CodeInvalidOperator, map[string]string{
+				"field":    term.Field,
+				"operator": string(term.Op),
+			})
+		}
+		if !field.valid(term.Value, now) {
+			return newSyntaxError(term.ValuePos, CodeInvalidValue, map[string]string{
+				"field": term.Field,
+				"value": term.Value,
+			})
+		}
+	}
+	return nil
+}
+
+// parseDate понимает today, yesterday, YYYY-MM-DD и относительные -7d, -2w, -1m, -1y, -12h.
+// day=true означает, что значение задаёт целые сутки.
+func parseDate(value string, now time.Time) (t time.Time, day bool, ok bool) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch strings.ToLower(value) {
+	case "today":
+		return today, true, true
+	case "yesterday":
+		return today.AddDate(0, 0, -1), true, true
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, now.Location()); err == nil {
+		return t, true, true
+	}
+
+	matches := relativeDateRegexp.FindStringSubmatch(strings.ToLower(value))
+	if matches == nil {
+		return time.Time{}, false, false
+	}
+	amount, _ := strconv.Atoi(matches[1])
+	switch matches[2] {
+	case "h":
+		return now.Add(-time.Duration(amount) * time.Hour), false, true
+	case "d":
+		return now.AddDate(0, 0, -amount), false, true
+	case "w":
+		return now.AddDate(0, 0, -7*amount), false, true
+	case "m":
+		return now.AddDate(0, -amount, 0), false, true
+	default:
+		return now.AddDate(-amount, 0, 0), false, true
+	}
+}
@@ -0,0 +1,110 @@
+// Package rank строит лексикографические ключи порядка: ключ нового элемента
+// выбирается между ключами соседей, поэтому перемещение меняет одну строку.
+package rank
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	alphabet = "0123456789abcdefghijklmnopqrstuvwxyz"
+	base     = len(alphabet)
+	// MaxLength — длина ключа, после которой набор соседних ключей перестраивается
+	MaxLength = 12
+)
+
+var (
+	ErrInvalidKey   = errors.New("invalid rank key")
+	ErrInvalidRange = errors.New("rank keys are not ordered")
+)
+
+// Between возвращает ключ строго между prev и next; пустая строка означает открытую границу.
+// Ключи не заканчиваются на 0, поэтому место перед любым ключом есть всегда.
+func Between(prev, next string) (string, error) {
+	if !valid(prev) || !valid(next) {
+		return "", ErrInvalidKey
+	}
+	if next != "" && prev >= next {
+		return "", ErrInvalidRange
+	}
+	return midpoint(prev, next), nil
+}
+
+// Spread раздаёт n ключей с равными промежутками, оставляя запас в одну цифру
+func Spread(n int) []string {
+	if n <= 0 {
+		return []string{}
+	}
+	width, space := 1, uint64(base)
+	for space < uint64(n+1)*uint64(base) {
+		width++
+		space *= uint64(base)
+	}
+	step := space / uint64(n+1)
+	keys := make([]string, 0, n)
+	for i := 1; i <= n; i++ {
+		keys = append(keys, format(uint64(i)*step, width))
+	}
+	return keys
+}
+
+// NeedsRebalance сообщает, что ключ стал слишком длинным
+func NeedsRebalance(key string) bool {
+	return len(key) > MaxLength
+}
+
+func midpoint(prev, next string) string {
+	if next != "" {
+		n := 0
+		for n < len(next) && digitAt(prev, n) == next[n] {
+			n++
+		}
+		if n > 0 {
+			return next[:n] + midpoint(prev[min(n, len(prev)):], next[n:])
+		}
+	}
+	low, high := 0, base
+	if prev != "" {
+		low = strings.IndexByte(alphabet, prev[0])
+	}
+	if next != "" {
+		high = strings.IndexByte(alphabet, next[0])
+	}
+	if high-low > 1 {
+		return string(alphabet[(low+high)/2])
+	}
+	if len(next) > 1 {
+		return next[:1]
+	}
+	tail := ""
+	if prev != "" {
+		tail = prev[1:]
+	}
+	return string(alphabet[low]) + midpoint(tail, "")
+}
+
+func digitAt(key string, i int) byte {
+	if i < len(key) {
+		return key[i]
+	}
+	return alphabet[0]
+}
+
+func format(value uint64, width int) string {
+	digits := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		digits[i] = alphabet[value%uint64(base)]
+		value /= uint64(base)
+	}
+	return strings.TrimRight(string(digits), alphabet[:1])
+}
+
+func valid(key string) bool {
+	for i := 0; i < len(key); i++ {
+		if strings.IndexByte(alphabet, key[i]) < 0 {
+			return false
+		}
+	}
+	return !strings.HasSuffix(key, alphabet[:1])
+}
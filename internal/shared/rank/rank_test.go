@@ -0,0 +1,93 @@
+package rank
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Between(t *testing.T) {
+	tests := []struct {
+		name string
+		prev string
+		next string
+	}{
+		{name: "empty list", prev: "", next: ""},
+		{name: "before first", prev: "", next: "i"},
+		{name: "after last", prev: "i", next: ""},
+		{name: "between distant keys", prev: "a", next: "z"},
+		{name: "between adjacent digits", prev: "a", next: "b"},
+		{name: "between prefix and longer key", prev: "a", next: "a1"},
+		{name: "after last alphabet digit", prev: "z", next: ""},
+		{name: "before key with zeros", prev: "", next: "001"},
+		{name: "different lengths", prev: "abc", next: "b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := Between(tt.prev, tt.next)
+			require.NoError(t, err)
+			assert.Greater(t, key, tt.prev)
+			if tt.next != "" {
+				assert.Less(t, key, tt.next)
+			}
+			assert.NotEqual(t, '0', rune(key[len(key)-1]))
+		})
+	}
+}
+
+func Test_BetweenErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		prev string
+		next string
+		err  error
+	}{
+		{name: "equal keys", prev: "i", next: "i", err: ErrInvalidRange},
+		{name: "reversed keys", prev: "k", next: "b", err: ErrInvalidRange},
+		{name: "trailing zero", prev: "a0", next: "", err: ErrInvalidKey},
+		{name: "upper case", prev: "", next: "A", err: ErrInvalidKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Between(tt.prev, tt.next)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func Test_BetweenRepeatedInserts(t *testing.T) {
+	keys := []string{}
+	prev := ""
+	for range 100 {
+		key, err := Between(prev, "")
+		require.NoError(t, err)
+		keys = append(keys, key)
+		prev = key
+	}
+	assert.True(t, slices.IsSorted(keys))
+
+	next := keys[1]
+	for range 50 {
+		key, err := Between(keys[0], next)
+		require.NoError(t, err)
+		assert.Greater(t, key, keys[0])
+		assert.Less(t, key, next)
+		next = key
+	}
+}
+
+func Test_Spread(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 35, 36, 1000} {
+		keys := Spread(n)
+		require.Len(t, keys, n)
+		assert.True(t, slices.IsSorted(keys))
+		assert.Len(t, slices.Compact(slices.Clone(keys)), n)
+		for _, key := range keys {
+			assert.False(t, NeedsRebalance(key))
+			_, err := Between(key, "")
+			assert.NoError(t, err)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package rank
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type Rebalancer interface {
+	RebalanceRanks(ctx context.Context) error
+}
+
+// RebalanceWorker периодически перестраивает ключи порядка, ставшие слишком длинными
+type RebalanceWorker struct {
+	rebalancers []Rebalancer
+	interval    time.Duration
+}
+
+func NewRebalanceWorker(interval time.Duration, rebalancers ...Rebalancer) *RebalanceWorker {
+	return &RebalanceWorker{
+		rebalancers: rebalancers,
+		interval:    interval,
+	}
+}
+
+func (w *RebalanceWorker) Run(ctx context.Context) {
+	const op = "rank.worker.Run"
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		for _, rebalancer := range w.rebalancers {
+			if err := rebalancer.RebalanceRanks(ctx); err != nil {
+				slog.Error("rebalance ranks", slog.String("op", op), slog.Any("err", err))
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,35 @@
+// Package testdb подключает тесты репозиториев к настоящей базе:
+// TEST_DATABASE_DSN должен указывать на базу с применёнными миграциями, без него тесты пропускаются.
+package testdb
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/require"
+)
+
+// Storage — подключение к базе с методами, которые репозитории ждут от хранилища
+type Storage struct {
+	*sql.DB
+}
+
+func (s *Storage) GetDB() *sql.DB {
+	return s.DB
+}
+
+// Open открывает базу из TEST_DATABASE_DSN и закрывает её по окончании теста
+func Open(t *testing.T) *Storage {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN is not set")
+	}
+	db, err := sql.Open("pgx", dsn)
+	require.NoError(t, err)
+	require.NoError(t, db.Ping())
+	t.Cleanup(func() { db.Close() })
+	return &Storage{DB: db}
+}
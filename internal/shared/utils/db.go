@@ -1,9 +1,23 @@
 package utils
 
 import (
+	sharedErrors "backend/internal/shared/errors"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	uniqueViolationCode      = "23505"
+	serializationFailureCode = "40001"
+	deadlockDetectedCode     = "40P01"
+	// maxTxAttempts ограничивает число попыток транзакции в RetryTx
+	maxTxAttempts = 5
+	txRetryDelay  = 10 * time.Millisecond
 )
 
 type exexSQLFunc func(ctx context.Context, query string, args ...any) (sql.Result, error)
@@ -32,6 +46,37 @@ func OpExec(
 	return nil
 }
 
+// OpExecVersion — OpExec для UPDATE с условием на версию строки. Если при заданной версии
+// ни одна строка не обновилась, existsQuery отличает устаревшую версию от отсутствующей записи.
+// Нулевая версия означает обновление без проверки.
+func OpExecVersion(
+	ctx context.Context,
+	db interface {
+		ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+		QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	},
+	op string,
+	query string,
+	mainErr error,
+	version uint64,
+	existsQuery string,
+	existsArgs []any,
+	params ...any,
+) error {
+	err := OpExec(ctx, db.ExecContext, op, query, mainErr, params...)
+	if version == 0 || !errors.Is(err, mainErr) {
+		return err
+	}
+	exists, existsErr := ExistsQueryWrapper(ctx, db, existsQuery, existsArgs...)
+	if existsErr != nil {
+		return fmt.Errorf("%s: %w", op, existsErr)
+	}
+	if exists {
+		return fmt.Errorf("%s: %w", op, sharedErrors.ErrVersionMismatch)
+	}
+	return err
+}
+
 // ExistsQueryWrapper выполняет EXISTS SQL запрос и возвращает результат.
 // Пробрасывает ошибки без изменения для последующего wrapping в доменном коде.
 func ExistsQueryWrapper(
@@ -54,3 +99,67 @@ func ExistsQueryWrapper(
 	}
 	return exists, nil
 }
+
+// IsUniqueViolation сообщает, вызвана ли ошибка нарушением уникального ограничения.
+func IsUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
+
+// ToInt64Slice приводит идентификаторы к []int64 для передачи в ANY($n) и UNNEST.
+func ToInt64Slice(ids []uint64) []int64 {
+	result := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, int64(id))
+	}
+	return result
+}
+
+// IsSerializationFailure сообщает, что транзакцию откатил конфликт сериализации или взаимная блокировка
+// и её можно безопасно повторить.
+func IsSerializationFailure(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && (pgErr.Code == serializationFailureCode || pgErr.Code == deadlockDetectedCode)
+}
+
+type txBeginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// RetryTx выполняет fn в транзакции и повторяет её с нарастающей паузой,
+// пока конфликт сериализации не исчезнет или не закончатся попытки.
+func RetryTx(ctx context.Context, db txBeginner, opts *sql.TxOptions, fn func(tx *sql.Tx) error) error {
+	var err error
+	for attempt := range maxTxAttempts {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(attempt) * txRetryDelay):
+			}
+		}
+		err = runTx(ctx, db, opts, fn)
+		if !IsSerializationFailure(err) {
+			return err
+		}
+	}
+	return err
+}
+
+func runTx(ctx context.Context, db txBeginner, opts *sql.TxOptions, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// LockTx берёт advisory-блокировку по ключу до конца транзакции, например "cards:column:12".
+func LockTx(ctx context.Context, tx *sql.Tx, key string) error {
+	_, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", key)
+	return err
+}
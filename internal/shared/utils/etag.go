@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+var ErrInvalidIfMatch = errors.New("invalid If-Match header")
+
+// ETag — сильный тег версии записи вида "3"
+func ETag(version uint64) string {
+	return strconv.Quote(strconv.FormatUint(version, 10))
+}
+
+// SetETag отдаёт версию записи в заголовке ETag
+func SetETag(c *fiber.Ctx, version uint64) {
+	c.Set(fiber.HeaderETag, ETag(version))
+}
+
+// IfMatchVersion разбирает заголовок If-Match с одним тегом версии.
+// 0 — заголовка нет или передан "*": обновление без проверки версии.
+func IfMatchVersion(c *fiber.Ctx) (uint64, error) {
+	value := strings.TrimSpace(c.Get(fiber.HeaderIfMatch))
+	if value == "" || value == "*" {
+		return 0, nil
+	}
+	value = strings.TrimPrefix(value, "W/")
+	tag, err := strconv.Unquote(value)
+	if err != nil {
+		return 0, ErrInvalidIfMatch
+	}
+	version, err := strconv.ParseUint(tag, 10, 64)
+	if err != nil || version == 0 {
+		return 0, ErrInvalidIfMatch
+	}
+	return version, nil
+}
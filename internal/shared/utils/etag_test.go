@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IfMatchVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected uint64
+		err      error
+	}{
+		{name: "no header", header: "", expected: 0},
+		{name: "any version", header: "*", expected: 0},
+		{name: "strong tag", header: `"3"`, expected: 3},
+		{name: "weak tag", header: `W/"12"`, expected: 12},
+		{name: "unquoted", header: "3", err: ErrInvalidIfMatch},
+		{name: "zero version", header: `"0"`, err: ErrInvalidIfMatch},
+		{name: "tag list", header: `"1", "2"`, err: ErrInvalidIfMatch},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			app := fiber.New()
+			app.Put("/", func(c *fiber.Ctx) error {
+				version, err := IfMatchVersion(c)
+				assert.ErrorIs(t, err, tc.err)
+				assert.Equal(t, tc.expected, version)
+				SetETag(c, version)
+				return c.SendStatus(fiber.StatusNoContent)
+			})
+			req := httptest.NewRequest(fiber.MethodPut, "/", nil)
+			if tc.header != "" {
+				req.Header.Set(fiber.HeaderIfMatch, tc.header)
+			}
+			resp, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, ETag(tc.expected), resp.Header.Get(fiber.HeaderETag))
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"backend/internal/shared/rank"
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// Ключи advisory-блокировок порядка: все, кто меняет rank, берут одну и ту же блокировку.
+func CardColumnLockKey(columnID uint64) string {
+	return fmt.Sprintf("cards:column:%d", columnID)
+}
+
+func BoardColumnsLockKey(boardID string) string {
+	return "board_columns:board:" + boardID
+}
+
+// SpreadRanks раздаёт живым строкам table, у которых scope = value, короткие равноудалённые ключи rank
+// в прежнем порядке (rank, id). table и scope — имена из кода, а не из запроса.
+func SpreadRanks(ctx context.Context, tx *sql.Tx, table, scope string, value any) error {
+	rows, err := tx.QueryContext(
+		ctx,
+		fmt.Sprintf("SELECT id FROM %s WHERE %s = $1 AND deleted_at IS NULL ORDER BY rank, id FOR UPDATE", table, scope),
+		value,
+	)
+	if err != nil {
+		return err
+	}
+	ids := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE %[1]s SET rank = data.rank
+		FROM UNNEST($1::BIGINT[], $2::TEXT[]) AS data(id, rank)
+		WHERE %[1]s.id = data.id
+	`, table)
+	_, err = tx.ExecContext(ctx, query, ids, rank.Spread(len(ids)))
+	return err
+}
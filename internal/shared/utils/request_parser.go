@@ -1,7 +1,10 @@
 package utils
 
 import (
+	"encoding/json"
+	"errors"
 	"reflect"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -9,8 +12,12 @@ import (
 const (
 	UserIDKey   = "userID"
 	FieldUserID = "UserID"
+
+	MIMEApplicationMergePatchJSON = "application/merge-patch+json"
 )
 
+var ErrUnsupportedPatchType = errors.New("unsupported patch content type")
+
 func ParseBody[T any](c *fiber.Ctx) (*T, error) {
 	var body T
 	if err := c.BodyParser(&body); err != nil {
@@ -34,3 +41,40 @@ func setUserID(body any, c *fiber.Ctx) {
 		field.SetUint(userID)
 	}
 }
+
+func ParseQuery[T any](c *fiber.Ctx) (*T, error) {
+	var query T
+	if err := c.QueryParser(&query); err != nil {
+		return nil, err
+	}
+
+	setUserID(&query, c)
+
+	return &query, nil
+}
+
+// ParsePatch применяет тело PATCH-запроса как JSON Merge Patch к текущему состоянию current,
+// представленному в виде запроса на обновление. Результат нужно валидировать целиком.
+func ParsePatch[T any](c *fiber.Ctx, current *T) (*T, error) {
+	contentType := strings.ToLower(strings.TrimSpace(strings.Split(c.Get(fiber.HeaderContentType), ";")[0]))
+	if contentType != MIMEApplicationMergePatchJSON && contentType != fiber.MIMEApplicationJSON {
+		return nil, ErrUnsupportedPatchType
+	}
+
+	doc, err := json.Marshal(current)
+	if err != nil {
+		return nil, err
+	}
+	merged, err := MergePatch(doc, c.Body())
+	if err != nil {
+		return nil, err
+	}
+	var body T
+	if err := json.Unmarshal(merged, &body); err != nil {
+		return nil, ErrInvalidPatch
+	}
+
+	setUserID(&body, c)
+
+	return &body, nil
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "errors"
+
+var (
+	ErrSprintNotFound      = errors.New("sprint not found")
+	ErrSprintAlreadyExists = errors.New("sprint already exists")
+	ErrSprintClosed        = errors.New("sprint is closed")
+	ErrSprintNotActive     = errors.New("sprint is not active")
+	ErrSprintNotPlanned    = errors.New("sprint is not planned")
+	ErrActiveSprintExists  = errors.New("board already has an active sprint")
+	ErrInvalidSprintDates  = errors.New("sprint end date is before start date")
+	ErrInvalidCarryOver    = errors.New("invalid carry over sprint")
+	ErrInvalidBurndownUnit = errors.New("invalid burndown unit")
+	ErrCardNotFound        = errors.New("card not found")
+	ErrCardInAnotherSprint = errors.New("card already belongs to another open sprint")
+)
@@ -0,0 +1,315 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+type SprintGetter interface {
+	Get(ctx context.Context, sprint *Sprint) (*Sprint, error)
+	GetList(ctx context.Context, boardID string) ([]*Sprint, error)
+	GetSnapshots(ctx context.Context, sprintID uint64) ([]*SprintSnapshot, error)
+	CountBoardCards(ctx context.Context, boardID string, cardIDs []uint64) (uint64, error)
+	CardsInOtherOpenSprint(ctx context.Context, sprintID uint64, cardIDs []uint64) (bool, error)
+	ExistsActive(ctx context.Context, boardID string) (bool, error)
+}
+
+type SprintCreator interface {
+	Create(ctx context.Context, sprint *Sprint) error
+	AddCards(ctx context.Context, sprintID uint64, cardIDs []uint64) error
+}
+
+type SprintUpdater interface {
+	Update(ctx context.Context, sprint *Sprint) error
+	Start(ctx context.Context, sprint *Sprint) error
+	Close(ctx context.Context, cmd *SprintCloseCommand) error
+	RecordSnapshots(ctx context.Context, sprintID *uint64) error
+}
+
+type SprintDeleter interface {
+	Delete(ctx context.Context, sprint *Sprint) error
+	RemoveCard(ctx context.Context, sprintID, cardID uint64) error
+}
+
+type SprintRepo interface {
+	SprintGetter
+	SprintCreator
+	SprintUpdater
+	SprintDeleter
+}
+
+type SprintService struct {
+	repo SprintRepo
+}
+
+func NewSprintService(repo SprintRepo) *SprintService {
+	return &SprintService{
+		repo: repo,
+	}
+}
+
+func (s *SprintService) GetList(ctx context.Context, boardID string) ([]*Sprint, error) {
+	const op = "sprint.service.GetList"
+	sprints, err := s.repo.GetList(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return sprints, nil
+}
+
+func (s *SprintService) Get(ctx context.Context, req *Sprint) (*Sprint, error) {
+	const op = "sprint.service.Get"
+	sprint, err := s.repo.Get(ctx, &Sprint{
+		ID:      req.ID,
+		BoardID: req.BoardID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return sprint, nil
+}
+
+func (s *SprintService) Create(ctx context.Context, req *Sprint) error {
+	const op = "sprint.service.Create"
+	if req.EndDate.Before(req.StartDate) {
+		return fmt.Errorf("%s: %w", op, ErrInvalidSprintDates)
+	}
+	sprint := &Sprint{
+		BoardID:   req.BoardID,
+		Name:      req.Name,
+		Goal:      req.Goal,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Status:    SprintStatusPlanned,
+	}
+	if err := s.repo.Create(ctx, sprint); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *SprintService) Update(ctx context.Context, req *Sprint) error {
+	const op = "sprint.service.Update"
+	if req.EndDate.Before(req.StartDate) {
+		return fmt.Errorf("%s: %w", op, ErrInvalidSprintDates)
+	}
+	current, err := s.repo.Get(ctx, &Sprint{ID: req.ID, BoardID: req.BoardID})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if current.Status == SprintStatusClosed {
+		return fmt.Errorf("%s: %w", op, ErrSprintClosed)
+	}
+	sprint := &Sprint{
+		ID:        req.ID,
+		BoardID:   req.BoardID,
+		Name:      req.Name,
+		Goal:      req.Goal,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	}
+	if err := s.repo.Update(ctx, sprint); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *SprintService) Delete(ctx context.Context, req *Sprint) error {
+	const op = "sprint.service.Delete"
+	if err := s.repo.Delete(ctx, &Sprint{ID: req.ID, BoardID: req.BoardID}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *SprintService) Start(ctx context.Context, req *Sprint) error {
+	const op = "sprint.service.Start"
+	sprint, err := s.repo.Get(ctx, &Sprint{ID: req.ID, BoardID: req.BoardID})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if sprint.Status != SprintStatusPlanned {
+		return fmt.Errorf("%s: %w", op, ErrSprintNotPlanned)
+	}
+	hasActive, err := s.repo.ExistsActive(ctx, sprint.BoardID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if hasActive {
+		return fmt.Errorf("%s: %w", op, ErrActiveSprintExists)
+	}
+	if err := s.repo.Start(ctx, sprint); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.repo.RecordSnapshots(ctx, &sprint.ID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *SprintService) Close(ctx context.Context, req *SprintCloseCommand) error {
+	const op = "sprint.service.Close"
+	sprint, err := s.repo.Get(ctx, &Sprint{ID: req.SprintID, BoardID: req.BoardID})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if sprint.Status != SprintStatusActive {
+		return fmt.Errorf("%s: %w", op, ErrSprintNotActive)
+	}
+	if req.CarryOverSprintID != nil {
+		if *req.CarryOverSprintID == sprint.ID {
+			return fmt.Errorf("%s: %w", op, ErrInvalidCarryOver)
+		}
+		target, err := s.repo.Get(ctx, &Sprint{ID: *req.CarryOverSprintID, BoardID: req.BoardID})
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, ErrInvalidCarryOver)
+		}
+		if target.Status == SprintStatusClosed {
+			return fmt.Errorf("%s: %w", op, ErrInvalidCarryOver)
+		}
+	}
+	if err := s.repo.Close(ctx, &SprintCloseCommand{
+		SprintID:          sprint.ID,
+		BoardID:           sprint.BoardID,
+		CarryOverSprintID: req.CarryOverSprintID,
+	}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *SprintService) AddCards(ctx context.Context, req *SprintCardsCommand) error {
+	const op = "sprint.service.AddCards"
+	sprint, err := s.repo.Get(ctx, &Sprint{ID: req.SprintID, BoardID: req.BoardID})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if sprint.Status == SprintStatusClosed {
+		return fmt.Errorf("%s: %w", op, ErrSprintClosed)
+	}
+	cardIDs := slices.Clone(req.CardIDs)
+	slices.Sort(cardIDs)
+	cardIDs = slices.Compact(cardIDs)
+
+	count, err := s.repo.CountBoardCards(ctx, sprint.BoardID, cardIDs)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if count != uint64(len(cardIDs)) {
+		return fmt.Errorf("%s: %w", op, ErrCardNotFound)
+	}
+	taken, err := s.repo.CardsInOtherOpenSprint(ctx, sprint.ID, cardIDs)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if taken {
+		return fmt.Errorf("%s: %w", op, ErrCardInAnotherSprint)
+	}
+	if err := s.repo.AddCards(ctx, sprint.ID, cardIDs); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return s.recordIfActive(ctx, op, sprint)
+}
+
+func (s *SprintService) RemoveCard(ctx context.Context, req *SprintCardsCommand) error {
+	const op = "sprint.service.RemoveCard"
+	sprint, err := s.repo.Get(ctx, &Sprint{ID: req.SprintID, BoardID: req.BoardID})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if sprint.Status == SprintStatusClosed {
+		return fmt.Errorf("%s: %w", op, ErrSprintClosed)
+	}
+	for _, cardID := range req.CardIDs {
+		if err := s.repo.RemoveCard(ctx, sprint.ID, cardID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return s.recordIfActive(ctx, op, sprint)
+}
+
+func (s *SprintService) GetBurndown(ctx context.Context, req *Sprint, unit string) (*Burndown, error) {
+	const op = "sprint.service.GetBurndown"
+	if unit == "" {
+		unit = BurndownUnitCount
+	}
+	if unit != BurndownUnitCount && unit != BurndownUnitEstimate {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidBurndownUnit)
+	}
+	sprint, err := s.repo.Get(ctx, &Sprint{ID: req.ID, BoardID: req.BoardID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	snapshots, err := s.repo.GetSnapshots(ctx, sprint.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return BuildBurndown(sprint, snapshots, unit), nil
+}
+
+// RecordSnapshots сохраняет дневной срез объёма и выполнения для всех активных спринтов.
+func (s *SprintService) RecordSnapshots(ctx context.Context) error {
+	const op = "sprint.service.RecordSnapshots"
+	if err := s.repo.RecordSnapshots(ctx, nil); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *SprintService) recordIfActive(ctx context.Context, op string, sprint *Sprint) error {
+	if sprint.Status != SprintStatusActive {
+		return nil
+	}
+	if err := s.repo.RecordSnapshots(ctx, &sprint.ID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// BuildBurndown строит график сгорания по дням спринта на основе сохранённых срезов.
+// Дни без среза (например, ещё не наступившие) возвращаются с пустым Remaining.
+func BuildBurndown(sprint *Sprint, snapshots []*SprintSnapshot, unit string) *Burndown {
+	byDate := make(map[string]*SprintSnapshot, len(snapshots))
+	for _, snapshot := range snapshots {
+		byDate[snapshot.Date.Format(time.DateOnly)] = snapshot
+	}
+
+	var scope uint64
+	if len(snapshots) > 0 {
+		first := slices.MinFunc(snapshots, func(a, b *SprintSnapshot) int {
+			return a.Date.Compare(b.Date)
+		})
+		scope, _ = snapshotValues(first, unit)
+	}
+
+	days := int(sprint.EndDate.Sub(sprint.StartDate).Hours()/24) + 1
+	burndown := &Burndown{
+		SprintID: sprint.ID,
+		Unit:     unit,
+		Scope:    scope,
+		Points:   make([]*BurndownPoint, 0, days),
+	}
+	for i := range days {
+		date := sprint.StartDate.AddDate(0, 0, i)
+		point := &BurndownPoint{Date: date}
+		if days > 1 {
+			point.Ideal = float64(scope) * float64(days-1-i) / float64(days-1)
+		}
+		if snapshot, ok := byDate[date.Format(time.DateOnly)]; ok {
+			total, completed := snapshotValues(snapshot, unit)
+			remaining := total - min(completed, total)
+			point.Remaining = &remaining
+		}
+		burndown.Points = append(burndown.Points, point)
+	}
+	return burndown
+}
+
+func snapshotValues(snapshot *SprintSnapshot, unit string) (total, completed uint64) {
+	if unit == BurndownUnitEstimate {
+		return snapshot.TotalEstimate, snapshot.CompletedEstimate
+	}
+	return snapshot.TotalCards, snapshot.CompletedCards
+}
@@ -0,0 +1,63 @@
+package domain
+
+import "time"
+
+const (
+	SprintStatusPlanned = "planned"
+	SprintStatusActive  = "active"
+	SprintStatusClosed  = "closed"
+)
+
+const (
+	BurndownUnitCount    = "count"
+	BurndownUnitEstimate = "estimate"
+)
+
+type Sprint struct {
+	ID        uint64
+	BoardID   string
+	Name      string
+	Goal      string
+	StartDate time.Time
+	EndDate   time.Time
+	Status    string
+	CardIDs   []uint64
+	ClosedAt  *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+type SprintCardsCommand struct {
+	SprintID uint64
+	BoardID  string
+	CardIDs  []uint64
+}
+
+type SprintCloseCommand struct {
+	SprintID          uint64
+	BoardID           string
+	CarryOverSprintID *uint64
+}
+
+type SprintSnapshot struct {
+	SprintID          uint64
+	Date              time.Time
+	TotalCards        uint64
+	CompletedCards    uint64
+	TotalEstimate     uint64
+	CompletedEstimate uint64
+}
+
+type BurndownPoint struct {
+	Date      time.Time
+	Remaining *uint64
+	Ideal     float64
+}
+
+type Burndown struct {
+	SprintID uint64
+	Unit     string
+	Scope    uint64
+	Points   []*BurndownPoint
+}
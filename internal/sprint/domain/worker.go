@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type SnapshotRecorder interface {
+	RecordSnapshots(ctx context.Context) error
+}
+
+// SnapshotWorker периодически записывает срезы активных спринтов,
+// из которых строится burndown.
+type SnapshotWorker struct {
+	recorder SnapshotRecorder
+	interval time.Duration
+}
+
+func NewSnapshotWorker(recorder SnapshotRecorder, interval time.Duration) *SnapshotWorker {
+	return &SnapshotWorker{
+		recorder: recorder,
+		interval: interval,
+	}
+}
+
+func (w *SnapshotWorker) Run(ctx context.Context) {
+	const op = "sprint.worker.Run"
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		if err := w.recorder.RecordSnapshots(ctx); err != nil {
+			slog.Error("record sprint snapshots", slog.String("op", op), slog.Any("err", err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,336 @@
+package repository
+
+import (
+	"backend/internal/shared/utils"
+	"backend/internal/sprint/domain"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+const (
+	existsActiveSprintQuery = "SELECT EXISTS(SELECT 1 FROM sprints WHERE board_id = $1 AND status = 'active' AND deleted_at IS NULL)"
+	cardsInOtherSprintQuery = `
+		SELECT EXISTS(
+			SELECT 1 FROM sprint_cards sc
+			JOIN sprints s ON s.id = sc.sprint_id
+			WHERE sc.card_id = ANY($1) AND sc.sprint_id <> $2
+				AND s.status <> 'closed' AND s.deleted_at IS NULL
+		)
+	`
+	// recordSnapshotsQuery сохраняет срез за текущий день; $1 = NULL означает все активные спринты.
+	recordSnapshotsQuery = `
+		INSERT INTO sprint_snapshots (
+			sprint_id, snapshot_date, total_cards, completed_cards, total_estimate, completed_estimate
+		)
+		SELECT
+			s.id,
+			CURRENT_DATE,
+			COUNT(c.id),
+			COUNT(c.id) FILTER (WHERE bc.category = 'done'),
+			COALESCE(SUM((c.properties->>'estimate')::INTEGER), 0),
+			COALESCE(SUM((c.properties->>'estimate')::INTEGER) FILTER (WHERE bc.category = 'done'), 0)
+		FROM sprints s
+		LEFT JOIN sprint_cards sc ON sc.sprint_id = s.id
+		LEFT JOIN cards c ON c.id = sc.card_id AND c.deleted_at IS NULL
+		LEFT JOIN board_columns bc ON bc.id = c.column_id
+		WHERE s.status = 'active' AND s.deleted_at IS NULL
+			AND ($1::INTEGER IS NULL OR s.id = $1)
+		GROUP BY s.id
+		ON CONFLICT (sprint_id, snapshot_date) DO UPDATE SET
+			total_cards = EXCLUDED.total_cards,
+			completed_cards = EXCLUDED.completed_cards,
+			total_estimate = EXCLUDED.total_estimate,
+			completed_estimate = EXCLUDED.completed_estimate,
+			updated_at = NOW()
+	`
+)
+
+type Storage interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+	Begin() (*sql.Tx, error)
+
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+	GetDB() *sql.DB
+	Close() error
+}
+
+type SprintRepository struct {
+	storage Storage
+}
+
+func NewSprintRepository(storage Storage) *SprintRepository {
+	return &SprintRepository{
+		storage: storage,
+	}
+}
+
+func (r *SprintRepository) Get(ctx context.Context, sprint *domain.Sprint) (*domain.Sprint, error) {
+	const op = "sprint.repository.Get"
+	query := `
+		SELECT id, board_id, name, COALESCE(goal, ''), start_date, end_date, status, closed_at, created_at, updated_at
+		FROM sprints WHERE id = $1 AND board_id = $2 AND deleted_at IS NULL
+	`
+	data := &domain.Sprint{}
+	row := r.storage.QueryRowContext(ctx, query, sprint.ID, sprint.BoardID)
+	if err := scanSprint(row, data); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, domain.ErrSprintNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := r.storage.QueryContext(
+		ctx,
+		`SELECT sc.card_id FROM sprint_cards sc
+		JOIN cards c ON c.id = sc.card_id AND c.deleted_at IS NULL
+		WHERE sc.sprint_id = $1 ORDER BY sc.card_id`,
+		data.ID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	data.CardIDs = []uint64{}
+	for rows.Next() {
+		var cardID uint64
+		if err := rows.Scan(&cardID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		data.CardIDs = append(data.CardIDs, cardID)
+	}
+	return data, nil
+}
+
+func (r *SprintRepository) GetList(ctx context.Context, boardID string) ([]*domain.Sprint, error) {
+	const op = "sprint.repository.GetList"
+	query := `
+		SELECT id, board_id, name, COALESCE(goal, ''), start_date, end_date, status, closed_at, created_at, updated_at
+		FROM sprints WHERE board_id = $1 AND deleted_at IS NULL
+		ORDER BY start_date DESC, id DESC
+	`
+	rows, err := r.storage.QueryContext(ctx, query, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	sprints := []*domain.Sprint{}
+	for rows.Next() {
+		sprint := &domain.Sprint{}
+		if err := scanSprint(rows, sprint); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		sprints = append(sprints, sprint)
+	}
+	return sprints, nil
+}
+
+func (r *SprintRepository) GetSnapshots(ctx context.Context, sprintID uint64) ([]*domain.SprintSnapshot, error) {
+	const op = "sprint.repository.GetSnapshots"
+	query := `
+		SELECT sprint_id, snapshot_date, total_cards, completed_cards, total_estimate, completed_estimate
+		FROM sprint_snapshots WHERE sprint_id = $1
+		ORDER BY snapshot_date
+	`
+	rows, err := r.storage.QueryContext(ctx, query, sprintID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	snapshots := []*domain.SprintSnapshot{}
+	for rows.Next() {
+		snapshot := &domain.SprintSnapshot{}
+		if err := rows.Scan(
+			&snapshot.SprintID,
+			&snapshot.Date,
+			&snapshot.TotalCards,
+			&snapshot.CompletedCards,
+			&snapshot.TotalEstimate,
+			&snapshot.CompletedEstimate,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+func (r *SprintRepository) CountBoardCards(ctx context.Context, boardID string, cardIDs []uint64) (uint64, error) {
+	const op = "sprint.repository.CountBoardCards"
+	var count uint64
+	query := "SELECT COUNT(*) FROM cards WHERE board_id = $1 AND id = ANY($2) AND deleted_at IS NULL"
+	row := r.storage.QueryRowContext(ctx, query, boardID, utils.ToInt64Slice(cardIDs))
+	if err := row.Scan(&count); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return count, nil
+}
+
+func (r *SprintRepository) CardsInOtherOpenSprint(ctx context.Context, sprintID uint64, cardIDs []uint64) (bool, error) {
+	const op = "sprint.repository.CardsInOtherOpenSprint"
+	exists, err := utils.ExistsQueryWrapper(ctx, r.storage, cardsInOtherSprintQuery, utils.ToInt64Slice(cardIDs), sprintID)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return exists, nil
+}
+
+func (r *SprintRepository) ExistsActive(ctx context.Context, boardID string) (bool, error) {
+	const op = "sprint.repository.ExistsActive"
+	exists, err := utils.ExistsQueryWrapper(ctx, r.storage, existsActiveSprintQuery, boardID)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return exists, nil
+}
+
+func (r *SprintRepository) Create(ctx context.Context, sprint *domain.Sprint) error {
+	const op = "sprint.repository.Create"
+	query := `
+		INSERT INTO sprints (board_id, name, goal, start_date, end_date, status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	return utils.OpExec(
+		ctx,
+		r.storage.ExecContext,
+		op,
+		query,
+		domain.ErrSprintAlreadyExists,
+		sprint.BoardID,
+		sprint.Name,
+		sprint.Goal,
+		sprint.StartDate,
+		sprint.EndDate,
+		sprint.Status,
+	)
+}
+
+func (r *SprintRepository) AddCards(ctx context.Context, sprintID uint64, cardIDs []uint64) error {
+	const op = "sprint.repository.AddCards"
+	query := `
+		INSERT INTO sprint_cards (sprint_id, card_id)
+		SELECT $1, UNNEST($2::INTEGER[])
+		ON CONFLICT (sprint_id, card_id) DO NOTHING
+	`
+	if _, err := r.storage.ExecContext(ctx, query, sprintID, utils.ToInt64Slice(cardIDs)); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (r *SprintRepository) Update(ctx context.Context, sprint *domain.Sprint) error {
+	const op = "sprint.repository.Update"
+	query := `
+		UPDATE sprints
+		SET name = $1, goal = $2, start_date = $3, end_date = $4, updated_at = NOW()
+		WHERE id = $5 AND board_id = $6 AND status <> 'closed' AND deleted_at IS NULL
+	`
+	return utils.OpExec(
+		ctx,
+		r.storage.ExecContext,
+		op,
+		query,
+		domain.ErrSprintNotFound,
+		sprint.Name,
+		sprint.Goal,
+		sprint.StartDate,
+		sprint.EndDate,
+		sprint.ID,
+		sprint.BoardID,
+	)
+}
+
+func (r *SprintRepository) Start(ctx context.Context, sprint *domain.Sprint) error {
+	const op = "sprint.repository.Start"
+	query := `
+		UPDATE sprints SET status = 'active', updated_at = NOW()
+		WHERE id = $1 AND board_id = $2 AND status = 'planned' AND deleted_at IS NULL
+	`
+	err := utils.OpExec(ctx, r.storage.ExecContext, op, query, domain.ErrSprintNotPlanned, sprint.ID, sprint.BoardID)
+	if err != nil && utils.IsUniqueViolation(err) {
+		return fmt.Errorf("%s: %w", op, domain.ErrActiveSprintExists)
+	}
+	return err
+}
+
+func (r *SprintRepository) Close(ctx context.Context, cmd *domain.SprintCloseCommand) error {
+	const op = "sprint.repository.Close"
+	tx, err := r.storage.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelRepeatableRead,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, recordSnapshotsQuery, cmd.SprintID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if cmd.CarryOverSprintID != nil {
+		query := `
+			INSERT INTO sprint_cards (sprint_id, card_id)
+			SELECT $1, sc.card_id
+			FROM sprint_cards sc
+			JOIN cards c ON c.id = sc.card_id AND c.deleted_at IS NULL
+			JOIN board_columns bc ON bc.id = c.column_id
+			WHERE sc.sprint_id = $2 AND bc.category <> 'done'
+			ON CONFLICT (sprint_id, card_id) DO NOTHING
+		`
+		if _, err := tx.ExecContext(ctx, query, *cmd.CarryOverSprintID, cmd.SprintID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	query := `
+		UPDATE sprints SET status = 'closed', closed_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND board_id = $2 AND status = 'active' AND deleted_at IS NULL
+	`
+	if err := utils.OpExec(ctx, tx.ExecContext, op, query, domain.ErrSprintNotActive, cmd.SprintID, cmd.BoardID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *SprintRepository) RecordSnapshots(ctx context.Context, sprintID *uint64) error {
+	const op = "sprint.repository.RecordSnapshots"
+	if _, err := r.storage.ExecContext(ctx, recordSnapshotsQuery, sprintID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (r *SprintRepository) Delete(ctx context.Context, sprint *domain.Sprint) error {
+	const op = "sprint.repository.Delete"
+	query := "UPDATE sprints SET deleted_at = NOW() WHERE id = $1 AND board_id = $2 AND deleted_at IS NULL"
+	return utils.OpExec(ctx, r.storage.ExecContext, op, query, domain.ErrSprintNotFound, sprint.ID, sprint.BoardID)
+}
+
+func (r *SprintRepository) RemoveCard(ctx context.Context, sprintID, cardID uint64) error {
+	const op = "sprint.repository.RemoveCard"
+	query := "DELETE FROM sprint_cards WHERE sprint_id = $1 AND card_id = $2"
+	return utils.OpExec(ctx, r.storage.ExecContext, op, query, domain.ErrCardNotFound, sprintID, cardID)
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSprint(row scanner, sprint *domain.Sprint) error {
+	return row.Scan(
+		&sprint.ID,
+		&sprint.BoardID,
+		&sprint.Name,
+		&sprint.Goal,
+		&sprint.StartDate,
+		&sprint.EndDate,
+		&sprint.Status,
+		&sprint.ClosedAt,
+		&sprint.CreatedAt,
+		&sprint.UpdatedAt,
+	)
+}
@@ -0,0 +1,319 @@
+package transport
+
+import (
+	"backend/internal/shared/ports/http"
+	"backend/internal/shared/utils"
+	"backend/internal/sprint/domain"
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	BoardIDKey  = "id"
+	SprintIDKey = "sprint_id"
+	CardIDKey   = "card_id"
+	UnitKey     = "unit"
+)
+
+const (
+	CreatedMessage = "created"
+	UpdatedMessage = "updated"
+	StartedMessage = "started"
+	ClosedMessage  = "closed"
+)
+
+type SprintService interface {
+	GetList(ctx context.Context, boardID string) ([]*domain.Sprint, error)
+	Get(ctx context.Context, req *domain.Sprint) (*domain.Sprint, error)
+	Create(ctx context.Context, req *domain.Sprint) error
+	Update(ctx context.Context, req *domain.Sprint) error
+	Delete(ctx context.Context, req *domain.Sprint) error
+	Start(ctx context.Context, req *domain.Sprint) error
+	Close(ctx context.Context, req *domain.SprintCloseCommand) error
+	AddCards(ctx context.Context, req *domain.SprintCardsCommand) error
+	RemoveCard(ctx context.Context, req *domain.SprintCardsCommand) error
+	GetBurndown(ctx context.Context, req *domain.Sprint, unit string) (*domain.Burndown, error)
+}
+
+type SprintHandler struct {
+	validator    http.Validator
+	lang         http.LangMessage
+	service      SprintService
+	sprintMapper *SprintMapper
+}
+
+func NewSprintHandler(validator http.Validator, lang http.LangMessage, service SprintService) *SprintHandler {
+	return &SprintHandler{
+		validator:    validator,
+		lang:         lang,
+		service:      service,
+		sprintMapper: &SprintMapper{},
+	}
+}
+
+func (h *SprintHandler) GetList(c *fiber.Ctx) error {
+	const op = "sprint.transport.handler.GetList"
+	sprints, err := h.service.GetList(c.Context(), c.Params(BoardIDKey))
+	if err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.JSON(h.sprintMapper.ToSprintListResponse(sprints))
+}
+
+func (h *SprintHandler) Get(c *fiber.Ctx) error {
+	const op = "sprint.transport.handler.Get"
+	sprintID, err := strconv.ParseUint(c.Params(SprintIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid sprint ID"})
+	}
+	sprint, err := h.service.Get(c.Context(), &domain.Sprint{
+		ID:      sprintID,
+		BoardID: c.Params(BoardIDKey),
+	})
+	if err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.JSON(h.sprintMapper.ToSprintResponse(sprint))
+}
+
+func (h *SprintHandler) Create(c *fiber.Ctx) error {
+	const op = "sprint.transport.handler.Create"
+	body, err := utils.ParseBody[SprintRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	body.BoardID = c.Params(BoardIDKey)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	if err := h.service.Create(c.Context(), h.sprintMapper.ToSprint(body)); err != nil {
+		return h.serviceError(c, op, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(
+		fiber.Map{
+			"message": h.lang.GetResponseMessage(c.Context(), CreatedMessage),
+		},
+	)
+}
+
+func (h *SprintHandler) Update(c *fiber.Ctx) error {
+	const op = "sprint.transport.handler.Update"
+	body, err := utils.ParseBody[SprintRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	sprintID, err := strconv.ParseUint(c.Params(SprintIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid sprint ID"})
+	}
+	body.ID = sprintID
+	body.BoardID = c.Params(BoardIDKey)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	if err := h.service.Update(c.Context(), h.sprintMapper.ToSprint(body)); err != nil {
+		return h.serviceError(c, op, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		fiber.Map{
+			"message": h.lang.GetResponseMessage(c.Context(), UpdatedMessage),
+		},
+	)
+}
+
+func (h *SprintHandler) Delete(c *fiber.Ctx) error {
+	const op = "sprint.transport.handler.Delete"
+	sprintID, err := strconv.ParseUint(c.Params(SprintIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid sprint ID"})
+	}
+	if err := h.service.Delete(c.Context(), &domain.Sprint{
+		ID:      sprintID,
+		BoardID: c.Params(BoardIDKey),
+	}); err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *SprintHandler) Start(c *fiber.Ctx) error {
+	const op = "sprint.transport.handler.Start"
+	sprintID, err := strconv.ParseUint(c.Params(SprintIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid sprint ID"})
+	}
+	if err := h.service.Start(c.Context(), &domain.Sprint{
+		ID:      sprintID,
+		BoardID: c.Params(BoardIDKey),
+	}); err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(
+		fiber.Map{
+			"message": h.lang.GetResponseMessage(c.Context(), StartedMessage),
+		},
+	)
+}
+
+func (h *SprintHandler) Close(c *fiber.Ctx) error {
+	const op = "sprint.transport.handler.Close"
+	body, err := utils.ParseBody[SprintCloseRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	sprintID, err := strconv.ParseUint(c.Params(SprintIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid sprint ID"})
+	}
+	body.SprintID = sprintID
+	body.BoardID = c.Params(BoardIDKey)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	if err := h.service.Close(c.Context(), h.sprintMapper.ToSprintCloseCommand(body)); err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(
+		fiber.Map{
+			"message": h.lang.GetResponseMessage(c.Context(), ClosedMessage),
+		},
+	)
+}
+
+func (h *SprintHandler) AddCards(c *fiber.Ctx) error {
+	const op = "sprint.transport.handler.AddCards"
+	body, err := utils.ParseBody[SprintCardsRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	sprintID, err := strconv.ParseUint(c.Params(SprintIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid sprint ID"})
+	}
+	body.SprintID = sprintID
+	body.BoardID = c.Params(BoardIDKey)
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	if err := h.service.AddCards(c.Context(), h.sprintMapper.ToSprintCardsCommand(body)); err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(
+		fiber.Map{
+			"message": h.lang.GetResponseMessage(c.Context(), UpdatedMessage),
+		},
+	)
+}
+
+func (h *SprintHandler) RemoveCard(c *fiber.Ctx) error {
+	const op = "sprint.transport.handler.RemoveCard"
+	sprintID, err := strconv.ParseUint(c.Params(SprintIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid sprint ID"})
+	}
+	cardID, err := strconv.ParseUint(c.Params(CardIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid card ID"})
+	}
+	if err := h.service.RemoveCard(c.Context(), &domain.SprintCardsCommand{
+		SprintID: sprintID,
+		BoardID:  c.Params(BoardIDKey),
+		CardIDs:  []uint64{cardID},
+	}); err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *SprintHandler) Burndown(c *fiber.Ctx) error {
+	const op = "sprint.transport.handler.Burndown"
+	sprintID, err := strconv.ParseUint(c.Params(SprintIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid sprint ID"})
+	}
+	burndown, err := h.service.GetBurndown(c.Context(), &domain.Sprint{
+		ID:      sprintID,
+		BoardID: c.Params(BoardIDKey),
+	}, c.Query(UnitKey))
+	if err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.JSON(h.sprintMapper.ToBurndownResponse(burndown))
+}
+
+func (h *SprintHandler) serviceError(c *fiber.Ctx, op string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrSprintNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Sprint not found"})
+	case errors.Is(err, domain.ErrCardNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Card not found"})
+	}
+	for _, target := range []error{
+		domain.ErrInvalidSprintDates,
+		domain.ErrInvalidCarryOver,
+		domain.ErrInvalidBurndownUnit,
+	} {
+		if errors.Is(err, target) {
+			return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{"errors": target.Error()})
+		}
+	}
+	for _, target := range []error{
+		domain.ErrSprintClosed,
+		domain.ErrSprintNotActive,
+		domain.ErrSprintNotPlanned,
+		domain.ErrActiveSprintExists,
+		domain.ErrCardInAnotherSprint,
+	} {
+		if errors.Is(err, target) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": target.Error()})
+		}
+	}
+	slog.Error(
+		"service error",
+		slog.String("operation", op),
+		slog.Any("errors", err),
+	)
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+}
@@ -0,0 +1,101 @@
+package transport
+
+import (
+	"backend/internal/sprint/domain"
+	"time"
+)
+
+type SprintMapper struct{}
+
+func (m *SprintMapper) ToSprint(req *SprintRequest) *domain.Sprint {
+	if req == nil {
+		return nil
+	}
+
+	return &domain.Sprint{
+		ID:        req.ID,
+		BoardID:   req.BoardID,
+		Name:      req.Name,
+		Goal:      req.Goal,
+		StartDate: parseDate(req.StartDate),
+		EndDate:   parseDate(req.EndDate),
+	}
+}
+
+func (m *SprintMapper) ToSprintCardsCommand(req *SprintCardsRequest) *domain.SprintCardsCommand {
+	if req == nil {
+		return nil
+	}
+
+	return &domain.SprintCardsCommand{
+		SprintID: req.SprintID,
+		BoardID:  req.BoardID,
+		CardIDs:  req.CardIDs,
+	}
+}
+
+func (m *SprintMapper) ToSprintCloseCommand(req *SprintCloseRequest) *domain.SprintCloseCommand {
+	if req == nil {
+		return nil
+	}
+
+	return &domain.SprintCloseCommand{
+		SprintID:          req.SprintID,
+		BoardID:           req.BoardID,
+		CarryOverSprintID: req.CarryOverSprintID,
+	}
+}
+
+func (m *SprintMapper) ToSprintResponse(sprint *domain.Sprint) *SprintResponse {
+	if sprint == nil {
+		return nil
+	}
+
+	return &SprintResponse{
+		ID:        sprint.ID,
+		BoardID:   sprint.BoardID,
+		Name:      sprint.Name,
+		Goal:      sprint.Goal,
+		StartDate: sprint.StartDate.Format(time.DateOnly),
+		EndDate:   sprint.EndDate.Format(time.DateOnly),
+		Status:    sprint.Status,
+		CardIDs:   sprint.CardIDs,
+		ClosedAt:  sprint.ClosedAt,
+		CreatedAt: sprint.CreatedAt,
+		UpdatedAt: sprint.UpdatedAt,
+	}
+}
+
+func (m *SprintMapper) ToSprintListResponse(sprints []*domain.Sprint) []*SprintResponse {
+	mapped := make([]*SprintResponse, 0, len(sprints))
+	for _, sprint := range sprints {
+		mapped = append(mapped, m.ToSprintResponse(sprint))
+	}
+	return mapped
+}
+
+func (m *SprintMapper) ToBurndownResponse(burndown *domain.Burndown) *BurndownResponse {
+	if burndown == nil {
+		return nil
+	}
+
+	response := &BurndownResponse{
+		SprintID: burndown.SprintID,
+		Unit:     burndown.Unit,
+		Scope:    burndown.Scope,
+		Points:   make([]*BurndownPointResponse, 0, len(burndown.Points)),
+	}
+	for _, point := range burndown.Points {
+		response.Points = append(response.Points, &BurndownPointResponse{
+			Date:      point.Date.Format(time.DateOnly),
+			Remaining: point.Remaining,
+			Ideal:     point.Ideal,
+		})
+	}
+	return response
+}
+
+func parseDate(value string) time.Time {
+	date, _ := time.Parse(time.DateOnly, value)
+	return date
+}
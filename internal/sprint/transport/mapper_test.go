@@ -0,0 +1,195 @@
+package transport
+
+import (
+	"backend/internal/sprint/domain"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ToSprint(t *testing.T) {
+	mapper := SprintMapper{}
+	tests := []struct {
+		name     string
+		req      *SprintRequest
+		expected *domain.Sprint
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "valid data",
+			req: &SprintRequest{
+				ID:        1,
+				BoardID:   "e102c99e-651c-44e1-bff1-c4a22e3134ce",
+				Name:      "Sprint 1",
+				Goal:      "Ship it",
+				StartDate: "2025-01-06",
+				EndDate:   "2025-01-19",
+			},
+			expected: &domain.Sprint{
+				ID:        1,
+				BoardID:   "e102c99e-651c-44e1-bff1-c4a22e3134ce",
+				Name:      "Sprint 1",
+				Goal:      "Ship it",
+				StartDate: time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2025, 1, 19, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "invalid dates",
+			req: &SprintRequest{
+				BoardID:   "e102c99e-651c-44e1-bff1-c4a22e3134ce",
+				Name:      "Sprint 1",
+				StartDate: "06.01.2025",
+				EndDate:   "",
+			},
+			expected: &domain.Sprint{
+				BoardID: "e102c99e-651c-44e1-bff1-c4a22e3134ce",
+				Name:    "Sprint 1",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			actual := mapper.ToSprint(tc.req)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func Test_ToSprintCloseCommand(t *testing.T) {
+	mapper := SprintMapper{}
+	carryOver := uint64(2)
+	tests := []struct {
+		name     string
+		req      *SprintCloseRequest
+		expected *domain.SprintCloseCommand
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "without carry over",
+			req: &SprintCloseRequest{
+				SprintID: 1,
+				BoardID:  "e102c99e-651c-44e1-bff1-c4a22e3134ce",
+			},
+			expected: &domain.SprintCloseCommand{
+				SprintID: 1,
+				BoardID:  "e102c99e-651c-44e1-bff1-c4a22e3134ce",
+			},
+		},
+		{
+			name: "with carry over",
+			req: &SprintCloseRequest{
+				SprintID:          1,
+				BoardID:           "e102c99e-651c-44e1-bff1-c4a22e3134ce",
+				CarryOverSprintID: &carryOver,
+			},
+			expected: &domain.SprintCloseCommand{
+				SprintID:          1,
+				BoardID:           "e102c99e-651c-44e1-bff1-c4a22e3134ce",
+				CarryOverSprintID: &carryOver,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			actual := mapper.ToSprintCloseCommand(tc.req)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func Test_ToSprintCardsCommand(t *testing.T) {
+	mapper := SprintMapper{}
+	tests := []struct {
+		name     string
+		req      *SprintCardsRequest
+		expected *domain.SprintCardsCommand
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "valid data",
+			req: &SprintCardsRequest{
+				SprintID: 1,
+				BoardID:  "e102c99e-651c-44e1-bff1-c4a22e3134ce",
+				CardIDs:  []uint64{1, 2, 3},
+			},
+			expected: &domain.SprintCardsCommand{
+				SprintID: 1,
+				BoardID:  "e102c99e-651c-44e1-bff1-c4a22e3134ce",
+				CardIDs:  []uint64{1, 2, 3},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			actual := mapper.ToSprintCardsCommand(tc.req)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func Test_ToBurndownResponse(t *testing.T) {
+	mapper := SprintMapper{}
+	start := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	remaining := uint64(3)
+	tests := []struct {
+		name     string
+		req      *domain.Burndown
+		expected *BurndownResponse
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "valid data",
+			req: &domain.Burndown{
+				SprintID: 1,
+				Unit:     domain.BurndownUnitCount,
+				Scope:    4,
+				Points: []*domain.BurndownPoint{
+					{Date: start, Remaining: &remaining, Ideal: 4},
+					{Date: start.AddDate(0, 0, 1), Ideal: 0},
+				},
+			},
+			expected: &BurndownResponse{
+				SprintID: 1,
+				Unit:     "count",
+				Scope:    4,
+				Points: []*BurndownPointResponse{
+					{Date: "2025-01-06", Remaining: &remaining, Ideal: 4},
+					{Date: "2025-01-07", Ideal: 0},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			actual := mapper.ToBurndownResponse(tc.req)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package transport
+
+type SprintRequest struct {
+	ID        uint64
+	BoardID   string `json:"board_id" validate:"required,uuid"`
+	Name      string `json:"name" validate:"required,min=2,max=255"`
+	Goal      string `json:"goal" validate:"omitempty,max=1000"`
+	StartDate string `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate   string `json:"end_date" validate:"required,datetime=2006-01-02"`
+}
+
+type SprintCardsRequest struct {
+	SprintID uint64
+	BoardID  string   `json:"board_id" validate:"required,uuid"`
+	CardIDs  []uint64 `json:"card_ids" validate:"required,min=1,max=200,dive,min=1"`
+}
+
+type SprintCloseRequest struct {
+	SprintID          uint64
+	BoardID           string  `json:"board_id" validate:"required,uuid"`
+	CarryOverSprintID *uint64 `json:"carry_over_sprint_id,omitempty" validate:"omitnil,min=1"`
+}
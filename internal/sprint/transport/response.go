@@ -0,0 +1,30 @@
+package transport
+
+import "time"
+
+type SprintResponse struct {
+	ID        uint64     `json:"id"`
+	BoardID   string     `json:"board_id"`
+	Name      string     `json:"name"`
+	Goal      string     `json:"goal"`
+	StartDate string     `json:"start_date"`
+	EndDate   string     `json:"end_date"`
+	Status    string     `json:"status"`
+	CardIDs   []uint64   `json:"card_ids,omitempty"`
+	ClosedAt  *time.Time `json:"closed_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+type BurndownResponse struct {
+	SprintID uint64                   `json:"sprint_id"`
+	Unit     string                   `json:"unit"`
+	Scope    uint64                   `json:"scope"`
+	Points   []*BurndownPointResponse `json:"points"`
+}
+
+type BurndownPointResponse struct {
+	Date      string  `json:"date"`
+	Remaining *uint64 `json:"remaining"`
+	Ideal     float64 `json:"ideal"`
+}
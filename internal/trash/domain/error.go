@@ -0,0 +1,11 @@
+package domain
+
+import "errors"
+
+var (
+	ErrItemNotFound    = errors.New("trash item not found")
+	ErrInvalidItemType = errors.New("invalid trash item type")
+	ErrParentDeleted   = errors.New("parent item is in trash, restore it first")
+	ErrBoardKeyTaken   = errors.New("board key is already taken")
+	ErrColumnArchived  = errors.New("column is archived, restore it from the archive first")
+)
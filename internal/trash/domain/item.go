@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// Типы записей корзины
+const (
+	ItemBoard  = "board"
+	ItemColumn = "column"
+	ItemCard   = "card"
+)
+
+// listLimit — сколько последних удалённых записей показывает корзина
+const listLimit = 100
+
+// Item — удалённая запись, которую можно восстановить: доска, колонка живой доски
+// или карточка живой колонки. Потомки, удалённые вместе с родителем, в список не попадают
+// и возвращаются вместе с ним.
+type Item struct {
+	Type      string
+	ID        string
+	BoardID   string
+	Title     string
+	DeletedAt time.Time
+}
+
+type RestoreCommand struct {
+	UserID uint64
+	Type   string
+	ID     string
+}
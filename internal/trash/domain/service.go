@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+type TrashRepo interface {
+	GetList(ctx context.Context, userID uint64, limit int) ([]*Item, error)
+	RestoreBoard(ctx context.Context, userID uint64, boardID string) error
+	RestoreColumn(ctx context.Context, userID uint64, columnID uint64) error
+	RestoreCard(ctx context.Context, userID uint64, cardID uint64) error
+}
+
+type TrashService struct {
+	repo TrashRepo
+}
+
+func NewTrashService(repo TrashRepo) *TrashService {
+	return &TrashService{
+		repo: repo,
+	}
+}
+
+func (s *TrashService) GetList(ctx context.Context, userID uint64) ([]*Item, error) {
+	const op = "trash.service.GetList"
+	items, err := s.repo.GetList(ctx, userID, listLimit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return items, nil
+}
+
+// Restore возвращает запись вместе с потомками, удалёнными одновременно с ней.
+// Колонка и карточка встают на прежнее место, а если оно занято — в конец.
+func (s *TrashService) Restore(ctx context.Context, cmd *RestoreCommand) error {
+	const op = "trash.service.Restore"
+	var err error
+	switch cmd.Type {
+	case ItemBoard:
+		err = s.repo.RestoreBoard(ctx, cmd.UserID, cmd.ID)
+	case ItemColumn, ItemCard:
+		id, parseErr := strconv.ParseUint(cmd.ID, 10, 64)
+		if parseErr != nil || id == 0 {
+			return fmt.Errorf("%s: %w", op, ErrItemNotFound)
+		}
+		if cmd.Type == ItemColumn {
+			err = s.repo.RestoreColumn(ctx, cmd.UserID, id)
+		} else {
+			err = s.repo.RestoreCard(ctx, cmd.UserID, id)
+		}
+	default:
+		return fmt.Errorf("%s: %w", op, ErrInvalidItemType)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
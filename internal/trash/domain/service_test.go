@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubTrashRepo запоминает, какое восстановление вызвал сервис
+type stubTrashRepo struct {
+	TrashRepo
+	restored string
+}
+
+func (r *stubTrashRepo) RestoreBoard(ctx context.Context, userID uint64, boardID string) error {
+	r.restored = ItemBoard + ":" + boardID
+	return nil
+}
+
+func (r *stubTrashRepo) RestoreColumn(ctx context.Context, userID uint64, columnID uint64) error {
+	r.restored = ItemColumn
+	return nil
+}
+
+func (r *stubTrashRepo) RestoreCard(ctx context.Context, userID uint64, cardID uint64) error {
+	r.restored = ItemCard
+	return nil
+}
+
+func Test_Restore(t *testing.T) {
+	tests := []struct {
+		name     string
+		cmd      *RestoreCommand
+		restored string
+		err      error
+	}{
+		{name: "board", cmd: &RestoreCommand{Type: ItemBoard, ID: "uuid"}, restored: "board:uuid"},
+		{name: "column", cmd: &RestoreCommand{Type: ItemColumn, ID: "7"}, restored: ItemColumn},
+		{name: "card", cmd: &RestoreCommand{Type: ItemCard, ID: "42"}, restored: ItemCard},
+		{name: "invalid card id", cmd: &RestoreCommand{Type: ItemCard, ID: "abc"}, err: ErrItemNotFound},
+		{name: "zero column id", cmd: &RestoreCommand{Type: ItemColumn, ID: "0"}, err: ErrItemNotFound},
+		{name: "unknown type", cmd: &RestoreCommand{Type: "comment", ID: "1"}, err: ErrInvalidItemType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &stubTrashRepo{}
+			err := NewTrashService(repo).Restore(context.Background(), tt.cmd)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.restored, repo.restored)
+		})
+	}
+}
@@ -0,0 +1,293 @@
+package repository
+
+import (
+	"backend/internal/shared/rank"
+	"backend/internal/shared/utils"
+	"backend/internal/trash/domain"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Потомки восстанавливаются по совпадению deleted_at с родителем: каскадное удаление
+// ставит всем записям одну отметку времени транзакции
+const (
+	selectTrashListQuery = `
+		SELECT 'board', b.id::TEXT, b.id, b.name, b.deleted_at
+		FROM boards b
+		WHERE b.user_id = $1 AND b.deleted_at IS NOT NULL
+		UNION ALL
+		SELECT 'column', bc.id::TEXT, bc.board_id, bc.name, bc.deleted_at
+		FROM board_columns bc
+		JOIN boards b ON b.id = bc.board_id AND b.deleted_at IS NULL
+		WHERE b.user_id = $1 AND bc.deleted_at IS NOT NULL
+		UNION ALL
+		SELECT 'card', c.id::TEXT, c.board_id, c.text, c.deleted_at
+		FROM cards c
+		JOIN boards b ON b.id = c.board_id AND b.deleted_at IS NULL
+		JOIN board_columns bc ON bc.id = c.column_id AND bc.deleted_at IS NULL
+		WHERE b.user_id = $1 AND c.deleted_at IS NOT NULL
+		ORDER BY 5 DESC, 1, 2
+		LIMIT $2
+	`
+	// id сравнивается как текст, чтобы произвольная строка в адресе давала «не найдено», а не ошибку приведения
+	selectDeletedBoardQuery = `
+		SELECT id, key, deleted_at FROM boards
+		WHERE id::TEXT = $1 AND user_id = $2 AND deleted_at IS NOT NULL
+		FOR UPDATE
+	`
+	selectDeletedColumnQuery = `
+		SELECT bc.board_id, bc.deleted_at, b.deleted_at IS NOT NULL
+		FROM board_columns bc
+		JOIN boards b ON b.id = bc.board_id
+		WHERE bc.id = $1 AND b.user_id = $2 AND bc.deleted_at IS NOT NULL
+	`
+	// selectDeletedCardQuery проверяет и родительскую карточку: подзадачу нельзя вернуть, пока родитель в корзине
+	selectDeletedCardQuery = `
+		SELECT c.board_id, c.column_id, c.deleted_at,
+			b.deleted_at IS NOT NULL OR bc.deleted_at IS NOT NULL OR parent.deleted_at IS NOT NULL,
+			bc.archived_at IS NOT NULL
+		FROM cards c
+		JOIN boards b ON b.id = c.board_id
+		JOIN board_columns bc ON bc.id = c.column_id
+		LEFT JOIN cards parent ON parent.id = c.parent_card_id
+		WHERE c.id = $1 AND b.user_id = $2 AND c.deleted_at IS NOT NULL
+	`
+	existsLiveBoardKeyQuery = `
+		SELECT EXISTS (SELECT 1 FROM boards WHERE user_id = $1 AND key = $2 AND id <> $3 AND deleted_at IS NULL)
+	`
+	restoreBoardQuery = "UPDATE boards SET deleted_at = NULL, version = version + 1, updated_at = NOW() WHERE id = $1"
+	// restore*CommentsQuery выполняются до карточек: пока карточка в корзине, её отметка отличает комментарии поддерева
+	restoreBoardCommentsQuery = `
+		UPDATE comments SET deleted_at = NULL, version = version + 1
+		WHERE deleted_at = $2 AND card_id IN (SELECT id FROM cards WHERE board_id = $1 AND deleted_at = $2)
+	`
+	restoreColumnCommentsQuery = columnSubtreeQuery + `
+		UPDATE comments SET deleted_at = NULL, version = version + 1
+		WHERE deleted_at = $2 AND card_id IN (SELECT id FROM subtree)
+	`
+	restoreCardCommentsQuery = "UPDATE comments SET deleted_at = NULL, version = version + 1 WHERE card_id = $1 AND deleted_at = $2"
+	restoreBoardCardsQuery   = "UPDATE cards SET deleted_at = NULL, version = version + 1 WHERE board_id = $1 AND deleted_at = $2"
+	restoreBoardColumnsQuery = "UPDATE board_columns SET deleted_at = NULL, version = version + 1 WHERE board_id = $1 AND deleted_at = $2"
+	// columnSubtreeQuery — карточки колонки $1 и их подзадачи из других колонок, удалённые вместе с ней;
+	// подзадачи в колонках, которые с тех пор удалены, остаются в корзине
+	columnSubtreeQuery = `
+		WITH RECURSIVE subtree(id) AS (
+			SELECT id FROM cards WHERE column_id = $1 AND deleted_at = $2
+			UNION
+			SELECT c.id FROM cards c
+			JOIN subtree ON c.parent_card_id = subtree.id
+			JOIN board_columns bc ON bc.id = c.column_id AND bc.deleted_at IS NULL
+			WHERE c.deleted_at = $2
+		)
+	`
+	restoreColumnCardsQuery = columnSubtreeQuery + `
+		UPDATE cards SET deleted_at = NULL, version = version + 1 WHERE id IN (SELECT id FROM subtree)
+	`
+	// restoreColumnQuery и restoreCardQuery оставляют прежний ключ rank, если его не заняла живая запись,
+	// иначе ставят запись в конец ключом $2
+	restoreColumnQuery = `
+		UPDATE board_columns bc SET
+			deleted_at = NULL,
+			rank = CASE WHEN EXISTS(
+				SELECT 1 FROM board_columns sibling
+				WHERE sibling.board_id = bc.board_id AND sibling.id <> bc.id AND sibling.rank = bc.rank
+					AND sibling.deleted_at IS NULL AND sibling.archived_at IS NULL
+			) THEN $2 ELSE rank END,
+			version = version + 1,
+			updated_at = NOW()
+		WHERE id = $1
+	`
+	restoreCardQuery = `
+		UPDATE cards c SET
+			deleted_at = NULL,
+			rank = CASE WHEN EXISTS(
+				SELECT 1 FROM cards sibling
+				WHERE sibling.column_id = c.column_id AND sibling.id <> c.id AND sibling.rank = c.rank
+					AND sibling.deleted_at IS NULL AND sibling.archived_at IS NULL
+			) THEN $2 ELSE rank END,
+			version = version + 1,
+			updated_at = NOW()
+		WHERE id = $1
+	`
+	selectLastColumnRankQuery = "SELECT COALESCE(MAX(rank), '') FROM board_columns WHERE board_id = $1 AND deleted_at IS NULL"
+	selectLastCardRankQuery   = "SELECT COALESCE(MAX(rank), '') FROM cards WHERE column_id = $1 AND deleted_at IS NULL"
+)
+
+type Storage interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+	Begin() (*sql.Tx, error)
+
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+	GetDB() *sql.DB
+	Close() error
+}
+
+type TrashRepository struct {
+	storage Storage
+}
+
+func NewTrashRepository(storage Storage) *TrashRepository {
+	return &TrashRepository{
+		storage: storage,
+	}
+}
+
+func (r *TrashRepository) GetList(ctx context.Context, userID uint64, limit int) ([]*domain.Item, error) {
+	const op = "trash.repository.GetList"
+	rows, err := r.storage.QueryContext(ctx, selectTrashListQuery, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	items := []*domain.Item{}
+	for rows.Next() {
+		item := &domain.Item{}
+		if err := rows.Scan(&item.Type, &item.ID, &item.BoardID, &item.Title, &item.DeletedAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return items, nil
+}
+
+// RestoreBoard возвращает доску вместе с колонками, карточками и комментариями, удалёнными каскадом
+func (r *TrashRepository) RestoreBoard(ctx context.Context, userID uint64, boardID string) error {
+	const op = "trash.repository.RestoreBoard"
+	return utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		var id, key string
+		var deletedAt time.Time
+		err := tx.QueryRowContext(ctx, selectDeletedBoardQuery, boardID, userID).Scan(&id, &key, &deletedAt)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("%s: %w", op, domain.ErrItemNotFound)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		taken, err := utils.ExistsQueryWrapper(ctx, tx, existsLiveBoardKeyQuery, userID, key, id)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if taken {
+			return fmt.Errorf("%s: %w", op, domain.ErrBoardKeyTaken)
+		}
+		if _, err := tx.ExecContext(ctx, restoreBoardQuery, id); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := execAll(ctx, tx, []string{
+			restoreBoardCommentsQuery,
+			restoreBoardCardsQuery,
+			restoreBoardColumnsQuery,
+		}, id, deletedAt); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
+}
+
+// RestoreColumn возвращает колонку живой доски вместе с карточками и комментариями, удалёнными каскадом
+func (r *TrashRepository) RestoreColumn(ctx context.Context, userID uint64, columnID uint64) error {
+	const op = "trash.repository.RestoreColumn"
+	return utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		var boardID string
+		var deletedAt time.Time
+		var parentDeleted bool
+		err := tx.QueryRowContext(ctx, selectDeletedColumnQuery, columnID, userID).Scan(&boardID, &deletedAt, &parentDeleted)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("%s: %w", op, domain.ErrItemNotFound)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if parentDeleted {
+			return fmt.Errorf("%s: %w", op, domain.ErrParentDeleted)
+		}
+		if err := utils.LockTx(ctx, tx, utils.BoardColumnsLockKey(boardID)); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		key, err := lastRank(ctx, tx, selectLastColumnRankQuery, boardID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := execAll(ctx, tx, []string{
+			restoreColumnCommentsQuery,
+			restoreColumnCardsQuery,
+		}, columnID, deletedAt); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if _, err := tx.ExecContext(ctx, restoreColumnQuery, columnID, key); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
+}
+
+// RestoreCard возвращает карточку живой неархивной колонки вместе с комментариями, удалёнными одновременно с ней.
+// Родительская карточка должна быть уже восстановлена.
+func (r *TrashRepository) RestoreCard(ctx context.Context, userID uint64, cardID uint64) error {
+	const op = "trash.repository.RestoreCard"
+	return utils.RetryTx(ctx, r.storage, nil, func(tx *sql.Tx) error {
+		var boardID string
+		var columnID uint64
+		var deletedAt time.Time
+		var parentDeleted, columnArchived bool
+		err := tx.QueryRowContext(ctx, selectDeletedCardQuery, cardID, userID).Scan(
+			&boardID, &columnID, &deletedAt, &parentDeleted, &columnArchived,
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("%s: %w", op, domain.ErrItemNotFound)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if parentDeleted {
+			return fmt.Errorf("%s: %w", op, domain.ErrParentDeleted)
+		}
+		if columnArchived {
+			return fmt.Errorf("%s: %w", op, domain.ErrColumnArchived)
+		}
+		if err := utils.LockTx(ctx, tx, utils.CardColumnLockKey(columnID)); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		key, err := lastRank(ctx, tx, selectLastCardRankQuery, columnID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if _, err := tx.ExecContext(ctx, restoreCardCommentsQuery, cardID, deletedAt); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if _, err := tx.ExecContext(ctx, restoreCardQuery, cardID, key); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
+}
+
+func execAll(ctx context.Context, tx *sql.Tx, queries []string, args ...any) error {
+	for _, query := range queries {
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lastRank подбирает ключ за последней живой записью родителя parentID
+func lastRank(ctx context.Context, tx *sql.Tx, query string, parentID any) (string, error) {
+	var last string
+	if err := tx.QueryRowContext(ctx, query, parentID).Scan(&last); err != nil {
+		return "", err
+	}
+	return rank.Between(last, "")
+}
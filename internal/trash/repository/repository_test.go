@@ -0,0 +1,160 @@
+package repository
+
+import (
+	boardDomain "backend/internal/board/domain"
+	boardRepository "backend/internal/board/repository"
+	"backend/internal/shared/testdb"
+	"backend/internal/trash/domain"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestBoard создаёт доску с двумя колонками; в первой две карточки, у первой карточки комментарий
+func createTestBoard(t *testing.T, storage *testdb.Storage) (userID uint64, boardID string, columnIDs, cardIDs []uint64) {
+	t.Helper()
+	email := fmt.Sprintf("trash-repository-%d@test.local", time.Now().UnixNano())
+	require.NoError(t, storage.QueryRow(
+		"INSERT INTO users (name, email, password, refresh_token) VALUES ('test', $1, '', '') RETURNING id", email,
+	).Scan(&userID))
+	require.NoError(t, storage.QueryRow(
+		"INSERT INTO boards (name, user_id, key) VALUES ('test', $1, 'TST') RETURNING id", userID,
+	).Scan(&boardID))
+	for i := range 2 {
+		var columnID uint64
+		require.NoError(t, storage.QueryRow(
+			"INSERT INTO board_columns (board_id, name, rank) VALUES ($1, $2, $3) RETURNING id",
+			boardID, fmt.Sprintf("column %d", i), fmt.Sprintf("%d", i+1),
+		).Scan(&columnID))
+		columnIDs = append(columnIDs, columnID)
+	}
+	for i := range 2 {
+		var cardID uint64
+		require.NoError(t, storage.QueryRow(
+			"INSERT INTO cards (board_id, column_id, text, rank, number) VALUES ($1, $2, 'card', $3, $4) RETURNING id",
+			boardID, columnIDs[0], fmt.Sprintf("%d", i+1), i+1,
+		).Scan(&cardID))
+		cardIDs = append(cardIDs, cardID)
+	}
+	_, err := storage.Exec("INSERT INTO comments (card_id, user_id, text) VALUES ($1, $2, 'note')", cardIDs[0], userID)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		storage.Exec("DELETE FROM comments WHERE user_id = $1", userID)
+		storage.Exec("DELETE FROM cards WHERE board_id = $1", boardID)
+		storage.Exec("DELETE FROM board_columns WHERE board_id = $1", boardID)
+		storage.Exec("DELETE FROM boards WHERE id = $1", boardID)
+		storage.Exec("DELETE FROM users WHERE id = $1", userID)
+	})
+	return userID, boardID, columnIDs, cardIDs
+}
+
+func countLive(t *testing.T, storage *testdb.Storage, query string, args ...any) int {
+	t.Helper()
+	var count int
+	require.NoError(t, storage.QueryRow(query, args...).Scan(&count))
+	return count
+}
+
+func Test_CascadeRoundTrip(t *testing.T) {
+	storage := testdb.Open(t)
+	repo := NewTrashRepository(storage)
+	boards := boardRepository.NewBoardRepository(storage)
+	userID, boardID, columnIDs, cardIDs := createTestBoard(t, storage)
+	ctx := context.Background()
+
+	liveCards := "SELECT COUNT(*) FROM cards WHERE board_id = $1 AND deleted_at IS NULL"
+	liveComments := "SELECT COUNT(*) FROM comments WHERE card_id = $1 AND deleted_at IS NULL"
+
+	require.NoError(t, boards.DeleteColumnCascade(ctx, &boardDomain.BoardColumn{ID: columnIDs[0], BoardID: boardID}))
+	assert.Equal(t, 0, countLive(t, storage, liveCards, boardID))
+	assert.Equal(t, 0, countLive(t, storage, liveComments, cardIDs[0]))
+
+	items, err := repo.GetList(ctx, userID, 10)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, domain.ItemColumn, items[0].Type)
+
+	require.NoError(t, boards.DeleteCascade(ctx, boardID))
+	assert.ErrorIs(t, repo.RestoreColumn(ctx, userID, columnIDs[0]), domain.ErrParentDeleted)
+
+	require.NoError(t, repo.RestoreBoard(ctx, userID, boardID))
+	// колонка удалена раньше доски и возвращается отдельно
+	assert.Equal(t, 0, countLive(t, storage, liveCards, boardID))
+	require.NoError(t, repo.RestoreColumn(ctx, userID, columnIDs[0]))
+	assert.Equal(t, 2, countLive(t, storage, liveCards, boardID))
+	assert.Equal(t, 1, countLive(t, storage, liveComments, cardIDs[0]))
+	assert.ErrorIs(t, repo.RestoreColumn(ctx, userID, columnIDs[0]), domain.ErrItemNotFound)
+}
+
+func Test_RestoreCardPosition(t *testing.T) {
+	storage := testdb.Open(t)
+	repo := NewTrashRepository(storage)
+	userID, boardID, _, cardIDs := createTestBoard(t, storage)
+	ctx := context.Background()
+	// позиция считается по ключам rank среди живых карточек колонки
+	position := `
+		SELECT n FROM (
+			SELECT id, ROW_NUMBER() OVER (ORDER BY rank, id) AS n
+			FROM cards WHERE column_id = (SELECT column_id FROM cards WHERE id = $1) AND deleted_at IS NULL
+		) ordered
+		WHERE id = $1
+	`
+
+	tests := []struct {
+		name     string
+		occupy   bool
+		position int
+	}{
+		{name: "original position", position: 1},
+		{name: "position taken", occupy: true, position: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := storage.Exec("UPDATE cards SET deleted_at = NOW() WHERE id = $1", cardIDs[0])
+			require.NoError(t, err)
+			if tt.occupy {
+				_, err := storage.Exec(
+					"UPDATE cards SET rank = (SELECT rank FROM cards WHERE id = $1) WHERE id = $2", cardIDs[0], cardIDs[1],
+				)
+				require.NoError(t, err)
+			}
+			require.NoError(t, repo.RestoreCard(ctx, userID, cardIDs[0]))
+			assert.Equal(t, tt.position, countLive(t, storage, position, cardIDs[0]))
+		})
+	}
+	assert.Equal(t, 2, countLive(t, storage, "SELECT COUNT(*) FROM cards WHERE board_id = $1 AND deleted_at IS NULL", boardID))
+}
+
+func Test_ColumnCascadeSubtasks(t *testing.T) {
+	storage := testdb.Open(t)
+	repo := NewTrashRepository(storage)
+	boards := boardRepository.NewBoardRepository(storage)
+	userID, boardID, columnIDs, cardIDs := createTestBoard(t, storage)
+	ctx := context.Background()
+
+	// подзадача первой карточки лежит во второй колонке
+	var childID uint64
+	require.NoError(t, storage.QueryRow(
+		"INSERT INTO cards (board_id, column_id, parent_card_id, text, rank, number) VALUES ($1, $2, $3, 'child', '1', 3) RETURNING id",
+		boardID, columnIDs[1], cardIDs[0],
+	).Scan(&childID))
+	liveChild := "SELECT COUNT(*) FROM cards WHERE id = $1 AND deleted_at IS NULL"
+
+	require.NoError(t, boards.DeleteColumnCascade(ctx, &boardDomain.BoardColumn{ID: columnIDs[0], BoardID: boardID}))
+	assert.Equal(t, 0, countLive(t, storage, liveChild, childID))
+	assert.ErrorIs(t, repo.RestoreCard(ctx, userID, childID), domain.ErrParentDeleted)
+
+	require.NoError(t, repo.RestoreColumn(ctx, userID, columnIDs[0]))
+	assert.Equal(t, 1, countLive(t, storage, liveChild, childID))
+
+	_, err := storage.Exec("UPDATE cards SET deleted_at = NOW() WHERE id = $1", cardIDs[1])
+	require.NoError(t, err)
+	_, err = storage.Exec("UPDATE board_columns SET archived_at = NOW() WHERE id = $1", columnIDs[0])
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.RestoreCard(ctx, userID, cardIDs[1]), domain.ErrColumnArchived)
+}
@@ -0,0 +1,97 @@
+package transport
+
+import (
+	"backend/internal/shared/utils"
+	"backend/internal/trash/domain"
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	ItemTypeKey = "type"
+	ItemIDKey   = "id"
+)
+
+const RestoredMessage = "restored"
+
+type LangMessage interface {
+	GetResponseMessage(ctx context.Context, key string) string
+}
+
+type TrashService interface {
+	GetList(ctx context.Context, userID uint64) ([]*domain.Item, error)
+	Restore(ctx context.Context, cmd *domain.RestoreCommand) error
+}
+
+type TrashHandler struct {
+	lang        LangMessage
+	service     TrashService
+	trashMapper *TrashMapper
+}
+
+func NewTrashHandler(lang LangMessage, service TrashService) *TrashHandler {
+	return &TrashHandler{
+		lang:        lang,
+		service:     service,
+		trashMapper: &TrashMapper{},
+	}
+}
+
+// GetTrashList возвращает последние удалённые доски, колонки и карточки пользователя
+func (h *TrashHandler) GetTrashList(c *fiber.Ctx) error {
+	const op = "trash.transport.handler.GetTrashList"
+	userID, ok := c.Locals(utils.UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"errors": "Unauthorized"})
+	}
+
+	items, err := h.service.GetList(c.Context(), userID)
+	if err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.JSON(h.trashMapper.ToItemListResponse(items))
+}
+
+// RestoreTrashItem возвращает запись из корзины вместе с потомками, удалёнными каскадом
+func (h *TrashHandler) RestoreTrashItem(c *fiber.Ctx) error {
+	const op = "trash.transport.handler.RestoreTrashItem"
+	userID, ok := c.Locals(utils.UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"errors": "Unauthorized"})
+	}
+
+	if err := h.service.Restore(c.Context(), h.trashMapper.ToRestoreCommand(&RestoreRequest{
+		UserID: userID,
+		Type:   c.Params(ItemTypeKey),
+		ID:     c.Params(ItemIDKey),
+	})); err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.JSON(fiber.Map{
+		"message": h.lang.GetResponseMessage(c.Context(), RestoredMessage),
+	})
+}
+
+func (h *TrashHandler) serviceError(c *fiber.Ctx, op string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrItemNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": domain.ErrItemNotFound.Error()})
+	case errors.Is(err, domain.ErrInvalidItemType):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": domain.ErrInvalidItemType.Error()})
+	case errors.Is(err, domain.ErrParentDeleted):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrParentDeleted.Error()})
+	case errors.Is(err, domain.ErrBoardKeyTaken):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrBoardKeyTaken.Error()})
+	case errors.Is(err, domain.ErrColumnArchived):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"errors": domain.ErrColumnArchived.Error()})
+	}
+	slog.Error(
+		"service error",
+		slog.String("operation", op),
+		slog.Any("errors", err),
+	)
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+}
@@ -0,0 +1,33 @@
+package transport
+
+import (
+	"backend/internal/trash/domain"
+)
+
+type TrashMapper struct{}
+
+func (m *TrashMapper) ToRestoreCommand(req *RestoreRequest) *domain.RestoreCommand {
+	if req == nil {
+		return nil
+	}
+
+	return &domain.RestoreCommand{
+		UserID: req.UserID,
+		Type:   req.Type,
+		ID:     req.ID,
+	}
+}
+
+func (m *TrashMapper) ToItemListResponse(items []*domain.Item) *ItemListResponse {
+	data := make([]*ItemResponse, 0, len(items))
+	for _, item := range items {
+		data = append(data, &ItemResponse{
+			Type:      item.Type,
+			ID:        item.ID,
+			BoardID:   item.BoardID,
+			Title:     item.Title,
+			DeletedAt: item.DeletedAt,
+		})
+	}
+	return &ItemListResponse{Data: data}
+}
@@ -0,0 +1,81 @@
+package transport
+
+import (
+	"backend/internal/trash/domain"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ToRestoreCommand(t *testing.T) {
+	mapper := TrashMapper{}
+	tests := []struct {
+		name     string
+		req      *RestoreRequest
+		expected *domain.RestoreCommand
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "card",
+			req: &RestoreRequest{
+				UserID: 1,
+				Type:   domain.ItemCard,
+				ID:     "42",
+			},
+			expected: &domain.RestoreCommand{
+				UserID: 1,
+				Type:   domain.ItemCard,
+				ID:     "42",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			actual := mapper.ToRestoreCommand(tc.req)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func Test_ToItemListResponse(t *testing.T) {
+	mapper := TrashMapper{}
+	deletedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		items    []*domain.Item
+		expected *ItemListResponse
+	}{
+		{
+			name:     "empty",
+			items:    nil,
+			expected: &ItemListResponse{Data: []*ItemResponse{}},
+		},
+		{
+			name: "board and column",
+			items: []*domain.Item{
+				{Type: domain.ItemBoard, ID: "b1", BoardID: "b1", Title: "Web", DeletedAt: deletedAt},
+				{Type: domain.ItemColumn, ID: "7", BoardID: "b2", Title: "Done", DeletedAt: deletedAt},
+			},
+			expected: &ItemListResponse{Data: []*ItemResponse{
+				{Type: domain.ItemBoard, ID: "b1", BoardID: "b1", Title: "Web", DeletedAt: deletedAt},
+				{Type: domain.ItemColumn, ID: "7", BoardID: "b2", Title: "Done", DeletedAt: deletedAt},
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			actual := mapper.ToItemListResponse(tc.items)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
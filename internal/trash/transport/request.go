@@ -0,0 +1,8 @@
+package transport
+
+// RestoreRequest собирается из адреса /trash/:type/:id/restore; формат id проверяет домен по типу записи
+type RestoreRequest struct {
+	UserID uint64
+	Type   string
+	ID     string
+}
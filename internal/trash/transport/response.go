@@ -0,0 +1,15 @@
+package transport
+
+import "time"
+
+type ItemResponse struct {
+	Type      string    `json:"type"`
+	ID        string    `json:"id"`
+	BoardID   string    `json:"board_id"`
+	Title     string    `json:"title"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+type ItemListResponse struct {
+	Data []*ItemResponse `json:"data"`
+}
@@ -5,6 +5,7 @@ import (
 	"backend/internal/shared/utils"
 	"backend/internal/user/domain"
 	"context"
+	"errors"
 	"log/slog"
 
 	"github.com/gofiber/fiber/v2"
@@ -60,6 +61,33 @@ func (h *UserHandler) Update(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
 	}
 
+	return h.save(c, op, body)
+}
+
+// Patch применяет JSON Merge Patch к профилю текущего пользователя
+func (h *UserHandler) Patch(c *fiber.Ctx) error {
+	const op = "user.transport.user_handler.Patch"
+	userID, ok := c.Locals(UserIDKey).(uint64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"errors": "Unauthorized"})
+	}
+	current, err := h.userService.Current(c.Context(), userID)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"errors": "Unauthorized"})
+	}
+
+	body, err := utils.ParsePatch(c, h.mapperUser.ToUserRequest(current))
+	if err != nil {
+		if errors.Is(err, utils.ErrUnsupportedPatchType) {
+			return c.Status(fiber.StatusUnsupportedMediaType).JSON(fiber.Map{"errors": err.Error()})
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+
+	return h.save(c, op, body)
+}
+
+func (h *UserHandler) save(c *fiber.Ctx, op string, body *UserRequest) error {
 	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
 		if err != nil {
 			slog.Error("validator error",
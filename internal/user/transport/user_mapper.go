@@ -17,6 +17,18 @@ func (m *UserMapper) ToUserDomain(req *UserRequest) *domain.User {
 	}
 }
 
+// ToUserRequest не переносит пароль: он меняется только если явно передан в патче
+func (m *UserMapper) ToUserRequest(user *domain.User) *UserRequest {
+	if user == nil {
+		return nil
+	}
+
+	return &UserRequest{
+		Name:  user.Name,
+		Email: user.Email,
+	}
+}
+
 func (m *UserMapper) ToUserResponse(user *domain.User) *UserResponse {
 	if user == nil {
 		return nil
@@ -0,0 +1,11 @@
+package domain
+
+import (
+	"errors"
+)
+
+var (
+	ErrViewNotFound            = errors.New("view not found")
+	ErrBoardNotFound           = errors.New("board not found")
+	ErrSharedViewRequiresBoard = errors.New("shared view must belong to a board")
+)
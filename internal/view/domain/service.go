@@ -0,0 +1,147 @@
+package domain
+
+import (
+	cardDomain "backend/internal/card/domain"
+	queryFilter "backend/internal/shared/filter"
+	"context"
+	"fmt"
+	"time"
+)
+
+type ViewGetter interface {
+	Get(ctx context.Context, view *View) (*View, error)
+	GetList(ctx context.Context, filter *ViewListFilter) ([]*View, error)
+	BoardAccessible(ctx context.Context, boardID string, userID uint64) (bool, error)
+}
+
+type ViewCreator interface {
+	Create(ctx context.Context, view *View) error
+}
+
+type ViewUpdater interface {
+	Update(ctx context.Context, view *View) error
+}
+
+type ViewDeleter interface {
+	Delete(ctx context.Context, view *View) error
+}
+
+type ViewRepo interface {
+	ViewGetter
+	ViewCreator
+	ViewUpdater
+	ViewDeleter
+}
+
+type ViewService struct {
+	repo ViewRepo
+}
+
+func NewViewService(repo ViewRepo) *ViewService {
+	return &ViewService{
+		repo: repo,
+	}
+}
+
+func (s *ViewService) GetList(ctx context.Context, filter *ViewListFilter) ([]*View, error) {
+	const op = "view.service.GetList"
+	views, err := s.repo.GetList(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return views, nil
+}
+
+// Get возвращает собственное представление пользователя или общее представление доступной ему доски
+func (s *ViewService) Get(ctx context.Context, req *View) (*View, error) {
+	const op = "view.service.Get"
+	view, err := s.repo.Get(ctx, &View{
+		ID:     req.ID,
+		UserID: req.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return view, nil
+}
+
+func (s *ViewService) Create(ctx context.Context, req *View) error {
+	const op = "view.service.Create"
+	view := s.normalize(req)
+	if err := s.check(ctx, view); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.repo.Create(ctx, view); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *ViewService) Update(ctx context.Context, req *View) error {
+	const op = "view.service.Update"
+	view := s.normalize(req)
+	view.ID = req.ID
+	if err := s.check(ctx, view); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.repo.Update(ctx, view); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *ViewService) Delete(ctx context.Context, req *View) error {
+	const op = "view.service.Delete"
+	if err := s.repo.Delete(ctx, &View{
+		ID:     req.ID,
+		UserID: req.UserID,
+	}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *ViewService) normalize(req *View) *View {
+	view := &View{
+		UserID:    req.UserID,
+		BoardID:   req.BoardID,
+		Name:      req.Name,
+		Query:     req.Query,
+		SortBy:    req.SortBy,
+		SortOrder: req.SortOrder,
+		Shared:    req.Shared,
+		Layout:    req.Layout,
+	}
+	if view.SortBy == "" {
+		view.SortBy = cardDomain.SortByPosition
+	}
+	if view.SortOrder == "" {
+		view.SortOrder = cardDomain.SortOrderAsc
+	}
+	return view
+}
+
+// check проверяет фильтр и доступ к доске до сохранения
+func (s *ViewService) check(ctx context.Context, view *View) error {
+	query, err := queryFilter.Parse(view.Query)
+	if err != nil {
+		return err
+	}
+	if err := queryFilter.Validate(query, cardDomain.CardFilterFields, time.Now()); err != nil {
+		return err
+	}
+	if view.BoardID == nil {
+		if view.Shared {
+			return ErrSharedViewRequiresBoard
+		}
+		return nil
+	}
+	accessible, err := s.repo.BoardAccessible(ctx, *view.BoardID, view.UserID)
+	if err != nil {
+		return err
+	}
+	if !accessible {
+		return ErrBoardNotFound
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// View — сохранённый фильтр пользователя: глобальный (BoardID = nil) или для конкретной доски.
+// Query записывается на языке фильтров карточек.
+//
+// Участников у досок нет — доступ к доске есть только у её владельца, поэтому представления
+// видит только владелец доски. Shared лишь помечает представление как общее для доски;
+// показывать его другим пользователям можно будет, когда у досок появятся участники.
+type View struct {
+	ID        uint64
+	UserID    uint64
+	BoardID   *string
+	Name      string
+	Query     string
+	SortBy    string
+	SortOrder string
+	Shared    bool
+	Layout    ViewLayout
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ViewLayout struct {
+	CollapsedColumns   []uint64 `json:"collapsed_columns,omitempty"`
+	CollapsedSwimlanes []string `json:"collapsed_swimlanes,omitempty"`
+}
+
+type ViewListFilter struct {
+	UserID  uint64
+	BoardID *string
+}
+
+func (l *ViewLayout) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("ViewLayout.Scan: expected []byte, got %T", value)
+	}
+
+	if len(bytes) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(bytes, l)
+}
+
+func (l ViewLayout) Value() (driver.Value, error) {
+	if len(l.CollapsedColumns) == 0 && len(l.CollapsedSwimlanes) == 0 {
+		return "{}", nil
+	}
+	return json.Marshal(l)
+}
@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"backend/internal/shared/utils"
+	"backend/internal/view/domain"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+const (
+	existsAccessibleBoardQuery = "SELECT EXISTS(SELECT 1 FROM boards WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL)"
+	// selectViewQuery отбирает представления, видимые пользователю $1:
+	// собственные и общие представления его досок. Пока у досок нет участников,
+	// создать представление доски может только её владелец, так что обе ветки дают его собственные.
+	selectViewQuery = `
+		SELECT v.id, v.user_id, v.board_id, v.name, v.query, v.sort_by, v.sort_order, v.shared, v.layout,
+			v.created_at, v.updated_at
+		FROM views v
+		LEFT JOIN boards b ON b.id = v.board_id AND b.deleted_at IS NULL
+		WHERE v.deleted_at IS NULL
+			AND (v.user_id = $1 OR (v.shared AND b.user_id = $1))
+	`
+)
+
+type Storage interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+	Begin() (*sql.Tx, error)
+
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+	GetDB() *sql.DB
+	Close() error
+}
+
+type ViewRepository struct {
+	storage Storage
+}
+
+func NewViewRepository(storage Storage) *ViewRepository {
+	return &ViewRepository{
+		storage: storage,
+	}
+}
+
+func (r *ViewRepository) Get(ctx context.Context, view *domain.View) (*domain.View, error) {
+	const op = "view.repository.Get"
+	data := &domain.View{}
+	row := r.storage.QueryRowContext(ctx, selectViewQuery+" AND v.id = $2", view.UserID, view.ID)
+	if err := scanView(row, data); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, domain.ErrViewNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return data, nil
+}
+
+// GetList при указанной доске возвращает её представления и глобальные представления пользователя
+func (r *ViewRepository) GetList(ctx context.Context, filter *domain.ViewListFilter) ([]*domain.View, error) {
+	const op = "view.repository.GetList"
+	query := selectViewQuery + `
+			AND ($2::UUID IS NULL OR v.board_id = $2 OR v.board_id IS NULL)
+		ORDER BY v.board_id NULLS FIRST, v.name, v.id
+	`
+	rows, err := r.storage.QueryContext(ctx, query, filter.UserID, filter.BoardID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	views := []*domain.View{}
+	for rows.Next() {
+		view := &domain.View{}
+		if err := scanView(rows, view); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		views = append(views, view)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return views, nil
+}
+
+func (r *ViewRepository) Create(ctx context.Context, view *domain.View) error {
+	const op = "view.repository.Create"
+	query := `
+		INSERT INTO views (user_id, board_id, name, query, sort_by, sort_order, shared, layout)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	return utils.OpExec(
+		ctx,
+		r.storage.ExecContext,
+		op,
+		query,
+		domain.ErrViewNotFound,
+		view.UserID,
+		view.BoardID,
+		view.Name,
+		view.Query,
+		view.SortBy,
+		view.SortOrder,
+		view.Shared,
+		view.Layout,
+	)
+}
+
+// Update и Delete доступны только владельцу представления
+func (r *ViewRepository) Update(ctx context.Context, view *domain.View) error {
+	const op = "view.repository.Update"
+	query := `
+		UPDATE views
+		SET board_id = $1, name = $2, query = $3, sort_by = $4, sort_order = $5, shared = $6, layout = $7,
+			updated_at = NOW()
+		WHERE id = $8 AND user_id = $9 AND deleted_at IS NULL
+	`
+	return utils.OpExec(
+		ctx,
+		r.storage.ExecContext,
+		op,
+		query,
+		domain.ErrViewNotFound,
+		view.BoardID,
+		view.Name,
+		view.Query,
+		view.SortBy,
+		view.SortOrder,
+		view.Shared,
+		view.Layout,
+		view.ID,
+		view.UserID,
+	)
+}
+
+func (r *ViewRepository) Delete(ctx context.Context, view *domain.View) error {
+	const op = "view.repository.Delete"
+	query := "UPDATE views SET deleted_at = NOW() WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL"
+	return utils.OpExec(
+		ctx,
+		r.storage.ExecContext,
+		op,
+		query,
+		domain.ErrViewNotFound,
+		view.ID,
+		view.UserID,
+	)
+}
+
+func (r *ViewRepository) BoardAccessible(ctx context.Context, boardID string, userID uint64) (bool, error) {
+	const op = "view.repository.BoardAccessible"
+	exists, err := utils.ExistsQueryWrapper(
+		ctx,
+		r.storage,
+		existsAccessibleBoardQuery,
+		boardID,
+		userID,
+	)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return exists, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanView(row scanner, view *domain.View) error {
+	var boardID sql.NullString
+	if err := row.Scan(
+		&view.ID,
+		&view.UserID,
+		&boardID,
+		&view.Name,
+		&view.Query,
+		&view.SortBy,
+		&view.SortOrder,
+		&view.Shared,
+		&view.Layout,
+		&view.CreatedAt,
+		&view.UpdatedAt,
+	); err != nil {
+		return err
+	}
+	if boardID.Valid {
+		view.BoardID = &boardID.String
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package transport
+
+import (
+	queryFilter "backend/internal/shared/filter"
+	"backend/internal/shared/ports/http"
+	"backend/internal/shared/utils"
+	"backend/internal/view/domain"
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	ViewIDKey = "view_id"
+	UserIDKey = "userID"
+	QueryKey  = "query"
+)
+
+const (
+	CreatedMessage = "created"
+	UpdatedMessage = "updated"
+)
+
+type LangMessage interface {
+	GetResponseMessage(ctx context.Context, key string) string
+	GetErrorMessage(ctx context.Context, key string, params map[string]string) string
+}
+
+type ViewService interface {
+	GetList(ctx context.Context, filter *domain.ViewListFilter) ([]*domain.View, error)
+	Get(ctx context.Context, req *domain.View) (*domain.View, error)
+	Create(ctx context.Context, req *domain.View) error
+	Update(ctx context.Context, req *domain.View) error
+	Delete(ctx context.Context, req *domain.View) error
+}
+
+type ViewHandler struct {
+	validator  http.Validator
+	lang       LangMessage
+	service    ViewService
+	viewMapper *ViewMapper
+}
+
+func NewViewHandler(validator http.Validator, lang LangMessage, service ViewService) *ViewHandler {
+	return &ViewHandler{
+		validator:  validator,
+		lang:       lang,
+		service:    service,
+		viewMapper: &ViewMapper{},
+	}
+}
+
+func (h *ViewHandler) GetList(c *fiber.Ctx) error {
+	const op = "view.transport.handler.GetList"
+	query, err := utils.ParseQuery[ViewListRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid query parameters"})
+	}
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, query); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	views, err := h.service.GetList(c.Context(), h.viewMapper.ToViewListFilter(query))
+	if err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.JSON(h.viewMapper.ToViewListResponse(views))
+}
+
+func (h *ViewHandler) Get(c *fiber.Ctx) error {
+	const op = "view.transport.handler.Get"
+	view, err := h.viewFromParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid view ID"})
+	}
+
+	result, err := h.service.Get(c.Context(), view)
+	if err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.JSON(h.viewMapper.ToViewResponse(result))
+}
+
+func (h *ViewHandler) Create(c *fiber.Ctx) error {
+	const op = "view.transport.handler.Create"
+	body, err := utils.ParseBody[ViewRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	if err := h.service.Create(c.Context(), h.viewMapper.ToView(body)); err != nil {
+		return h.serviceError(c, op, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(
+		fiber.Map{
+			"message": h.lang.GetResponseMessage(c.Context(), CreatedMessage),
+		},
+	)
+}
+
+func (h *ViewHandler) Update(c *fiber.Ctx) error {
+	const op = "view.transport.handler.Update"
+	body, err := utils.ParseBody[ViewRequest](c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid request body"})
+	}
+	viewID, err := strconv.ParseUint(c.Params(ViewIDKey), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid view ID"})
+	}
+	body.ID = viewID
+
+	if validationErrors, statusCode, err := h.validator.ValidateStruct(c, body); validationErrors != nil {
+		if err != nil {
+			slog.Error("validator error",
+				slog.String("op", op),
+				slog.Any("err", err),
+			)
+			return c.Status(statusCode).JSON(fiber.Map{"errors": "Validation error"})
+		}
+		return c.Status(statusCode).JSON(fiber.Map{"errors": validationErrors})
+	}
+
+	if err := h.service.Update(c.Context(), h.viewMapper.ToView(body)); err != nil {
+		return h.serviceError(c, op, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		fiber.Map{
+			"message": h.lang.GetResponseMessage(c.Context(), UpdatedMessage),
+		},
+	)
+}
+
+func (h *ViewHandler) Delete(c *fiber.Ctx) error {
+	const op = "view.transport.handler.Delete"
+	view, err := h.viewFromParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"errors": "Invalid view ID"})
+	}
+
+	if err := h.service.Delete(c.Context(), view); err != nil {
+		return h.serviceError(c, op, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *ViewHandler) viewFromParams(c *fiber.Ctx) (*domain.View, error) {
+	viewID, err := strconv.ParseUint(c.Params(ViewIDKey), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	userID, ok := c.Locals(UserIDKey).(uint64)
+	if !ok {
+		return nil, errors.New("missing user ID")
+	}
+	return &domain.View{
+		ID:     viewID,
+		UserID: userID,
+	}, nil
+}
+
+func (h *ViewHandler) serviceError(c *fiber.Ctx, op string, err error) error {
+	var syntaxErr *queryFilter.SyntaxError
+	switch {
+	case errors.Is(err, domain.ErrViewNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "View not found"})
+	case errors.Is(err, domain.ErrBoardNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"errors": "Board not found"})
+	case errors.Is(err, domain.ErrSharedViewRequiresBoard):
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{"errors": domain.ErrSharedViewRequiresBoard.Error()})
+	case errors.As(err, &syntaxErr):
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+			"errors": fiber.Map{
+				QueryKey: h.lang.GetErrorMessage(c.Context(), syntaxErr.Key(), syntaxErr.MessageParams()),
+			},
+			"position": syntaxErr.Pos,
+		})
+	}
+	slog.Error(
+		"service error",
+		slog.String("operation", op),
+		slog.Any("errors", err),
+	)
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"errors": "Server error"})
+}
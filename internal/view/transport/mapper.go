@@ -0,0 +1,80 @@
+package transport
+
+import (
+	"backend/internal/view/domain"
+)
+
+type ViewMapper struct{}
+
+func (m *ViewMapper) ToView(req *ViewRequest) *domain.View {
+	if req == nil {
+		return nil
+	}
+
+	return &domain.View{
+		ID:        req.ID,
+		UserID:    req.UserID,
+		BoardID:   req.BoardID,
+		Name:      req.Name,
+		Query:     req.Query,
+		SortBy:    req.SortBy,
+		SortOrder: req.SortOrder,
+		Shared:    req.Shared,
+		Layout: domain.ViewLayout{
+			CollapsedColumns:   req.Layout.CollapsedColumns,
+			CollapsedSwimlanes: req.Layout.CollapsedSwimlanes,
+		},
+	}
+}
+
+func (m *ViewMapper) ToViewListFilter(req *ViewListRequest) *domain.ViewListFilter {
+	if req == nil {
+		return nil
+	}
+
+	filter := &domain.ViewListFilter{
+		UserID: req.UserID,
+	}
+	if req.BoardID != "" {
+		filter.BoardID = &req.BoardID
+	}
+	return filter
+}
+
+func (m *ViewMapper) ToViewResponse(view *domain.View) *ViewResponse {
+	if view == nil {
+		return nil
+	}
+
+	response := &ViewResponse{
+		ID:        view.ID,
+		UserID:    view.UserID,
+		BoardID:   view.BoardID,
+		Name:      view.Name,
+		Query:     view.Query,
+		SortBy:    view.SortBy,
+		SortOrder: view.SortOrder,
+		Shared:    view.Shared,
+		Layout: ViewLayoutResponse{
+			CollapsedColumns:   view.Layout.CollapsedColumns,
+			CollapsedSwimlanes: view.Layout.CollapsedSwimlanes,
+		},
+		CreatedAt: view.CreatedAt,
+		UpdatedAt: view.UpdatedAt,
+	}
+	if response.Layout.CollapsedColumns == nil {
+		response.Layout.CollapsedColumns = []uint64{}
+	}
+	if response.Layout.CollapsedSwimlanes == nil {
+		response.Layout.CollapsedSwimlanes = []string{}
+	}
+	return response
+}
+
+func (m *ViewMapper) ToViewListResponse(views []*domain.View) []*ViewResponse {
+	response := make([]*ViewResponse, 0, len(views))
+	for _, view := range views {
+		response = append(response, m.ToViewResponse(view))
+	}
+	return response
+}
@@ -0,0 +1,158 @@
+package transport
+
+import (
+	"backend/internal/view/domain"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ToView(t *testing.T) {
+	mapper := ViewMapper{}
+	boardID := "93a49b99-a029-4a18-bbbc-c10d91a8c267"
+	tests := []struct {
+		name     string
+		req      *ViewRequest
+		expected *domain.View
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "global view",
+			req: &ViewRequest{
+				UserID: 1,
+				Name:   "My bugs",
+				Query:  "tag:bug",
+			},
+			expected: &domain.View{
+				UserID: 1,
+				Name:   "My bugs",
+				Query:  "tag:bug",
+			},
+		},
+		{
+			name: "shared board view with layout",
+			req: &ViewRequest{
+				ID:        5,
+				UserID:    1,
+				BoardID:   &boardID,
+				Name:      "Review",
+				Query:     `column:"In Review"`,
+				SortBy:    "updated_at",
+				SortOrder: "desc",
+				Shared:    true,
+				Layout: ViewLayoutRequest{
+					CollapsedColumns:   []uint64{1, 2},
+					CollapsedSwimlanes: []string{"bug"},
+				},
+			},
+			expected: &domain.View{
+				ID:        5,
+				UserID:    1,
+				BoardID:   &boardID,
+				Name:      "Review",
+				Query:     `column:"In Review"`,
+				SortBy:    "updated_at",
+				SortOrder: "desc",
+				Shared:    true,
+				Layout: domain.ViewLayout{
+					CollapsedColumns:   []uint64{1, 2},
+					CollapsedSwimlanes: []string{"bug"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToView(tc.req))
+		})
+	}
+}
+
+func Test_ToViewListFilter(t *testing.T) {
+	mapper := ViewMapper{}
+	boardID := "93a49b99-a029-4a18-bbbc-c10d91a8c267"
+	tests := []struct {
+		name     string
+		req      *ViewListRequest
+		expected *domain.ViewListFilter
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name:     "without board",
+			req:      &ViewListRequest{UserID: 1},
+			expected: &domain.ViewListFilter{UserID: 1},
+		},
+		{
+			name:     "with board",
+			req:      &ViewListRequest{UserID: 1, BoardID: boardID},
+			expected: &domain.ViewListFilter{UserID: 1, BoardID: &boardID},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToViewListFilter(tc.req))
+		})
+	}
+}
+
+func Test_ToViewResponse(t *testing.T) {
+	mapper := ViewMapper{}
+	now := time.Now()
+	tests := []struct {
+		name     string
+		req      *domain.View
+		expected *ViewResponse
+	}{
+		{
+			name:     "nil pointer",
+			req:      nil,
+			expected: nil,
+		},
+		{
+			name: "empty layout",
+			req: &domain.View{
+				ID:        1,
+				UserID:    2,
+				Name:      "All",
+				SortBy:    "position",
+				SortOrder: "asc",
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+			expected: &ViewResponse{
+				ID:        1,
+				UserID:    2,
+				Name:      "All",
+				SortBy:    "position",
+				SortOrder: "asc",
+				Layout: ViewLayoutResponse{
+					CollapsedColumns:   []uint64{},
+					CollapsedSwimlanes: []string{},
+				},
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("case(%s)", tc.name)
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mapper.ToViewResponse(tc.req))
+		})
+	}
+}
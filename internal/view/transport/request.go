@@ -0,0 +1,23 @@
+package transport
+
+type ViewRequest struct {
+	ID        uint64
+	UserID    uint64            `validate:"required,min=1"`
+	BoardID   *string           `json:"board_id,omitempty" validate:"omitnil,uuid"`
+	Name      string            `json:"name" validate:"required,min=1,max=100"`
+	Query     string            `json:"query" validate:"max=500"`
+	SortBy    string            `json:"sort_by,omitempty" validate:"omitempty,oneof=position created_at updated_at estimate"`
+	SortOrder string            `json:"sort_order,omitempty" validate:"omitempty,oneof=asc desc"`
+	Shared    bool              `json:"shared"`
+	Layout    ViewLayoutRequest `json:"layout"`
+}
+
+type ViewLayoutRequest struct {
+	CollapsedColumns   []uint64 `json:"collapsed_columns,omitempty" validate:"max=100,dive,min=1"`
+	CollapsedSwimlanes []string `json:"collapsed_swimlanes,omitempty" validate:"max=100,dive,min=1,max=255"`
+}
+
+type ViewListRequest struct {
+	UserID  uint64 `validate:"required,min=1"`
+	BoardID string `query:"board_id" validate:"omitempty,uuid"`
+}
@@ -0,0 +1,22 @@
+package transport
+
+import "time"
+
+type ViewResponse struct {
+	ID        uint64             `json:"id"`
+	UserID    uint64             `json:"user_id"`
+	BoardID   *string            `json:"board_id"`
+	Name      string             `json:"name"`
+	Query     string             `json:"query"`
+	SortBy    string             `json:"sort_by"`
+	SortOrder string             `json:"sort_order"`
+	Shared    bool               `json:"shared"`
+	Layout    ViewLayoutResponse `json:"layout"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+type ViewLayoutResponse struct {
+	CollapsedColumns   []uint64 `json:"collapsed_columns"`
+	CollapsedSwimlanes []string `json:"collapsed_swimlanes"`
+}